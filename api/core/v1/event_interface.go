@@ -8,6 +8,7 @@ package v1
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/golang/protobuf/proto"
 )
@@ -29,6 +30,23 @@ const (
 	MetaResponseStatus  = "x-msg-response-status"
 	MetaResponseErrCode = "x-msg-response-errcode"
 	MetaPathConstructor = "x-msg-path-constructor"
+	MetaIfVersion       = "x-msg-if-version" // apply only if entity version equals, or entity exists if IfVersionAny.
+	MetaTxID            = "x-msg-tx-id"
+	MetaTxPhase         = "x-msg-tx-phase"
+	MetaIdempotencyKey  = "x-msg-idempotency-key" // event id of request, applied once.
+	MetaExpressionID    = "x-msg-expr-id"
+)
+
+// IfVersionAny is the version precondition matching any version of entity existing.
+const IfVersionAny = "*"
+
+// TxPhase is the phase of two-phase transaction.
+type TxPhase string
+
+const (
+	TxPrepare TxPhase = "prepare"
+	TxCommit  TxPhase = "commit"
+	TxAbort   TxPhase = "abort"
 )
 
 type PathConstructor string
@@ -46,6 +64,7 @@ const (
 	ETEntity   EventType = "core.event.Entity"
 	ETSystem   EventType = "core.event.System"
 	ETCallback EventType = "core.event.Callback"
+	ETFanIn    EventType = "core.event.FanIn" // members of fan-in expression changed.
 )
 
 type SystemOp string
@@ -73,6 +92,7 @@ type Event interface {
 	Entity() string
 	SetEntity(entityID string) Event
 	SetTTL(td int) Event
+	TTL() int
 	Attributes() map[string]string
 
 	RawData() []byte
@@ -119,6 +139,12 @@ func (e *ProtoEvent) SetTTL(ttl int) Event {
 	return e
 }
 
+// TTL returns hops of events computed into the event, zero if absent.
+func (e *ProtoEvent) TTL() int {
+	ttl, _ := strconv.Atoi(e.Metadata[MetaTTL])
+	return ttl
+}
+
 func (e *ProtoEvent) RawData() []byte {
 	return e.GetRawData()
 }
@@ -0,0 +1,162 @@
+package v1
+
+import (
+	context "context"
+	io "io"
+	http "net/http"
+
+	go_restful "github.com/emicklei/go-restful"
+	errors "github.com/tkeel-io/kit/errors"
+	result "github.com/tkeel-io/kit/result"
+	transportHTTP "github.com/tkeel-io/kit/transport/http"
+	grpc "google.golang.org/grpc"
+)
+
+// Migration streams records in NDJSON, not supported by protoc-gen-go-http,
+// the handlers adapt http request and response to streams of MigrationServer.
+
+const MIMENDJSON = "application/x-ndjson"
+
+const importChunkSize = 32 * 1024
+
+type MigrationHTTPServer interface {
+	ExportEntities(*ExportEntitiesRequest, Migration_ExportEntitiesServer) error
+	ImportEntities(Migration_ImportEntitiesServer) error
+}
+
+type MigrationHTTPHandler struct {
+	srv MigrationHTTPServer
+}
+
+func newMigrationHTTPHandler(s MigrationHTTPServer) *MigrationHTTPHandler {
+	return &MigrationHTTPHandler{srv: s}
+}
+
+// ndjsonWriter respond records as NDJSON, header written with the first record,
+// so that errors occurred before any record written can be responded.
+type ndjsonWriter struct {
+	resp    *go_restful.Response
+	written bool
+}
+
+func (w *ndjsonWriter) Write(p []byte) (int, error) {
+	if !w.written {
+		w.written = true
+		w.resp.AddHeader("Content-Type", MIMENDJSON)
+		w.resp.WriteHeader(http.StatusOK)
+	}
+	return w.resp.Write(p) //nolint
+}
+
+// exportEntitiesHTTPStream write records sent into http response.
+type exportEntitiesHTTPStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	writer *ndjsonWriter
+}
+
+func (x *exportEntitiesHTTPStream) Context() context.Context {
+	return x.ctx
+}
+
+func (x *exportEntitiesHTTPStream) Send(m *ExportEntitiesResponse) error {
+	_, err := x.writer.Write(m.Record)
+	return err //nolint
+}
+
+// importEntitiesHTTPStream receive records from http request body in chunks.
+type importEntitiesHTTPStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	body io.Reader
+	buf  []byte
+	out  *ImportEntitiesResponse
+}
+
+func (x *importEntitiesHTTPStream) Context() context.Context {
+	return x.ctx
+}
+
+func (x *importEntitiesHTTPStream) Recv() (*ImportEntitiesRequest, error) {
+	for {
+		n, err := x.body.Read(x.buf)
+		if n > 0 {
+			records := make([]byte, n)
+			copy(records, x.buf[:n])
+			return &ImportEntitiesRequest{Records: records}, nil
+		} else if err != nil {
+			return nil, err //nolint
+		}
+	}
+}
+
+func (x *importEntitiesHTTPStream) SendAndClose(m *ImportEntitiesResponse) error {
+	x.out = m
+	return nil
+}
+
+func (h *MigrationHTTPHandler) ExportEntities(req *go_restful.Request, resp *go_restful.Response) {
+	in := ExportEntitiesRequest{}
+	if err := transportHTTP.GetBody(req, &in); err != nil {
+		resp.WriteHeaderAndJson(http.StatusBadRequest,
+			result.Set(errors.InternalError.Reason, err.Error(), nil), "application/json")
+		return
+	}
+
+	stream := &exportEntitiesHTTPStream{
+		ctx:    transportHTTP.ContextWithHeader(req.Request.Context(), req.Request.Header),
+		writer: &ndjsonWriter{resp: resp},
+	}
+	err := h.srv.ExportEntities(&in, stream)
+	switch {
+	case err != nil && !stream.writer.written:
+		tErr := errors.FromError(err)
+		httpCode := errors.GRPCToHTTPStatusCode(tErr.GRPCStatus().Code())
+		resp.WriteHeaderAndJson(httpCode,
+			result.Set(tErr.Reason, tErr.Message, nil), "application/json")
+	case err == nil && !stream.writer.written:
+		// no entity matched.
+		stream.writer.Write(nil) //nolint
+	}
+}
+
+func (h *MigrationHTTPHandler) ImportEntities(req *go_restful.Request, resp *go_restful.Response) {
+	stream := &importEntitiesHTTPStream{
+		ctx:  transportHTTP.ContextWithHeader(req.Request.Context(), req.Request.Header),
+		body: req.Request.Body,
+		buf:  make([]byte, importChunkSize),
+	}
+	if err := h.srv.ImportEntities(stream); err != nil {
+		// records imported before failure responded.
+		tErr := errors.FromError(err)
+		httpCode := errors.GRPCToHTTPStatusCode(tErr.GRPCStatus().Code())
+		resp.WriteHeaderAndJson(httpCode,
+			result.Set(tErr.Reason, tErr.Message, stream.out), "application/json")
+		return
+	}
+
+	resp.WriteHeaderAndJson(http.StatusOK,
+		result.Set(errors.Success.Reason, "", stream.out), "application/json")
+}
+
+func RegisterMigrationHTTPServer(container *go_restful.Container, srv MigrationHTTPServer) {
+	var ws *go_restful.WebService
+	for _, v := range container.RegisteredWebServices() {
+		if v.RootPath() == "/v1" {
+			ws = v
+			break
+		}
+	}
+	if ws == nil {
+		ws = new(go_restful.WebService)
+		ws.ApiVersion("/v1")
+		ws.Path("/v1").Produces(go_restful.MIME_JSON)
+		container.Add(ws)
+	}
+
+	handler := newMigrationHTTPHandler(srv)
+	ws.Route(ws.POST("/entities/export").
+		To(handler.ExportEntities))
+	ws.Route(ws.POST("/entities/import").
+		To(handler.ImportEntities))
+}
@@ -31,9 +31,12 @@ import (
 	opsv1 "github.com/tkeel-io/core/api/ops/v1"
 	"github.com/tkeel-io/core/pkg/config"
 	"github.com/tkeel-io/core/pkg/dispatch"
+	"github.com/tkeel-io/core/pkg/function"
+	"github.com/tkeel-io/core/pkg/history"
 	logf "github.com/tkeel-io/core/pkg/logfield"
 	apim "github.com/tkeel-io/core/pkg/manager"
 	metrics "github.com/tkeel-io/core/pkg/metrics"
+	"github.com/tkeel-io/core/pkg/migration"
 	"github.com/tkeel-io/core/pkg/placement"
 	"github.com/tkeel-io/core/pkg/repository"
 	"github.com/tkeel-io/core/pkg/repository/dao"
@@ -45,6 +48,7 @@ import (
 	_ "github.com/tkeel-io/core/pkg/resource/rawdata/builder"
 	_ "github.com/tkeel-io/core/pkg/resource/rawdata/noop"
 	"github.com/tkeel-io/core/pkg/resource/search"
+	"github.com/tkeel-io/core/pkg/resource/store"
 	_ "github.com/tkeel-io/core/pkg/resource/store/dapr"
 	_ "github.com/tkeel-io/core/pkg/resource/store/memory"
 	_ "github.com/tkeel-io/core/pkg/resource/store/noop"
@@ -53,6 +57,7 @@ import (
 	_ "github.com/tkeel-io/core/pkg/resource/tseries/influxdb"
 	_ "github.com/tkeel-io/core/pkg/resource/tseries/noop"
 	"github.com/tkeel-io/core/pkg/runtime"
+	"github.com/tkeel-io/core/pkg/scheduler"
 	"github.com/tkeel-io/core/pkg/service"
 	"github.com/tkeel-io/core/pkg/types"
 	"github.com/tkeel-io/core/pkg/util"
@@ -123,7 +128,7 @@ func main() {
 
 	{
 		// Subcommand register here.
-		cmd.AddCommand()
+		cmd.AddCommand(newExportCmd(), newImportCmd())
 	}
 
 	cobra.OnInitialize(func() {
@@ -231,13 +236,48 @@ func core(cmd *cobra.Command, args []string) {
 		log.Fatal(err)
 	}
 
-	if err = nodeInstance.Start(runtime.NodeConf{Sources: config.Get().Server.Sources}); nil != err {
+	// load modules of user functions before expressions and mappers loaded.
+	functions := function.Init(context.Background(), function.Config{
+		MaxMemoryPages: config.Get().Runtime.Functions.MaxMemoryPages,
+		Timeout:        time.Duration(config.Get().Runtime.Functions.Timeout) * time.Millisecond,
+		MaxModuleSize:  config.Get().Runtime.Functions.MaxModuleSize,
+	})
+	functions.Start(context.Background(), coreRepo)
+
+	entityHistory := newHistory()
+	if err = nodeInstance.Start(runtime.NodeConf{
+		History:          entityHistory,
+		Sources:          config.Get().Server.Sources,
+		SnapshotInterval: time.Duration(config.Get().Runtime.SnapshotInterval) * time.Second,
+		EntityLimit: runtime.CacheLimit{
+			MaxEntries: config.Get().Runtime.Entities.MaxEntries,
+			MaxBytes:   config.Get().Runtime.Entities.MaxBytes,
+		},
+		CacheLimit: runtime.CacheLimit{
+			MaxEntries: config.Get().Runtime.Cache.MaxEntries,
+			MaxBytes:   config.Get().Runtime.Cache.MaxBytes,
+		},
+		Workers:   config.Get().Runtime.Workers,
+		QueueSize: config.Get().Runtime.QueueSize,
+		Retention: runtime.Retention{
+			MaxKeys:  config.Get().Runtime.Retention.MaxKeys,
+			MaxAge:   time.Duration(config.Get().Runtime.Retention.MaxAge) * time.Second,
+			MaxBytes: config.Get().Runtime.Retention.MaxBytes,
+		},
+		Handlers: handlerConfs(config.Get().Runtime.Handlers),
+		MaxTTL:   config.Get().Runtime.MaxTTL,
+	}); nil != err {
 		log.Fatal(err)
 	}
 	_gopsSrv.SetNode(nodeInstance)
 
+	// start scheduler, fire schedules of entities placed on this node.
+	if err = scheduler.New(context.Background(), coreRepo, _dispatcher).Start(); nil != err {
+		log.Fatal(err)
+	}
+
 	// initialize core services.
-	initialzeService(_apiManager, search.GlobalService)
+	initialzeService(_apiManager, search.GlobalService, entityHistory, migration.New(coreRepo, search.GlobalService), coreRepo, functions)
 	stop := make(chan os.Signal, 1)
 	signal.Notify(stop, syscall.SIGTERM, os.Interrupt)
 	<-stop
@@ -247,13 +287,28 @@ func core(cmd *cobra.Command, args []string) {
 	}
 }
 
-func initialzeService(apiManager apim.APIManager, searchClient corev1.SearchHTTPServer) {
+func initialzeService(apiManager apim.APIManager, searchClient corev1.SearchHTTPServer, entityHistory history.History, migrator *migration.Migrator,
+	coreRepo repository.IRepository, functions *function.Registry) {
 	// initialize entity service.
 	_entitySrv.Init(apiManager, searchClient)
+	// initialize history service.
+	_historySrv.Init(entityHistory)
+	// initialize dead letter service.
+	_deadLetterSrv.Init(apiManager)
+	// initialize schedule service.
+	_scheduleSrv.Init(apiManager)
+	// initialize relationship service.
+	_relationshipSrv.Init(apiManager)
+	// initialize dry run service.
+	_dryRunSrv.Init(apiManager)
 	// initialize subscription service.
 	_subscriptionSrv.Init(apiManager)
 	// initialize topic service.
 	_topicSrv.Init(apiManager)
+	// initialize migration service.
+	_migrationSrv.Init(migrator)
+	// initialize function service.
+	_functionSrv.Init(coreRepo, functions)
 	// initialize search service.
 	_searchSrv.Init(searchClient)
 	// initialize proxy service.
@@ -267,8 +322,15 @@ var (
 	_topicSrv        *service.TopicService
 	_proxySrv        *service.ProxyService
 	_entitySrv       *service.EntityService
+	_historySrv      *service.HistoryService
+	_deadLetterSrv   *service.DeadLetterService
+	_scheduleSrv     *service.ScheduleService
+	_relationshipSrv *service.RelationshipService
+	_dryRunSrv       *service.DryRunService
 	_searchSrv       *service.SearchService
 	_subscriptionSrv *service.SubscriptionService
+	_migrationSrv    *service.MigrationService
+	_functionSrv     *service.FunctionService
 	_rawdataSrv      *service.RawdataService
 	_metricsSrv      *service.MetricsService
 	_gopsSrv         *service.GOPSService
@@ -283,6 +345,39 @@ func serviceRegisterToCoreV1(ctx context.Context, httpSrv *http.Server, grpcSrv
 	}
 	corev1.RegisterEntityHTTPServer(httpSrv.Container, _entitySrv)
 	corev1.RegisterEntityServer(grpcSrv.GetServe(), _entitySrv)
+	corev1.RegisterTransactionHTTPServer(httpSrv.Container, _entitySrv)
+	corev1.RegisterTransactionServer(grpcSrv.GetServe(), _entitySrv)
+	corev1.RegisterBulkHTTPServer(httpSrv.Container, _entitySrv)
+	corev1.RegisterBulkServer(grpcSrv.GetServe(), _entitySrv)
+	corev1.RegisterExpressionGraphHTTPServer(httpSrv.Container, _entitySrv)
+	corev1.RegisterExpressionGraphServer(grpcSrv.GetServe(), _entitySrv)
+	corev1.RegisterExpressionPolicyHTTPServer(httpSrv.Container, _entitySrv)
+	corev1.RegisterExpressionPolicyServer(grpcSrv.GetServe(), _entitySrv)
+
+	// register history service.
+	_historySrv = service.NewHistoryService()
+	corev1.RegisterHistoryHTTPServer(httpSrv.Container, _historySrv)
+	corev1.RegisterHistoryServer(grpcSrv.GetServe(), _historySrv)
+
+	// register dead letter service.
+	_deadLetterSrv = service.NewDeadLetterService()
+	corev1.RegisterDeadLetterHTTPServer(httpSrv.Container, _deadLetterSrv)
+	corev1.RegisterDeadLetterServer(grpcSrv.GetServe(), _deadLetterSrv)
+
+	// register schedule service.
+	_scheduleSrv = service.NewScheduleService()
+	corev1.RegisterScheduleHTTPServer(httpSrv.Container, _scheduleSrv)
+	corev1.RegisterScheduleServer(grpcSrv.GetServe(), _scheduleSrv)
+
+	// register relationship service.
+	_relationshipSrv = service.NewRelationshipService()
+	corev1.RegisterRelationshipHTTPServer(httpSrv.Container, _relationshipSrv)
+	corev1.RegisterRelationshipServer(grpcSrv.GetServe(), _relationshipSrv)
+
+	// register dry run service.
+	_dryRunSrv = service.NewDryRunService()
+	corev1.RegisterDryRunHTTPServer(httpSrv.Container, _dryRunSrv)
+	corev1.RegisterDryRunServer(grpcSrv.GetServe(), _dryRunSrv)
 
 	// register subscription service.
 	if _subscriptionSrv, err = service.NewSubscriptionService(ctx); nil != err {
@@ -291,6 +386,16 @@ func serviceRegisterToCoreV1(ctx context.Context, httpSrv *http.Server, grpcSrv
 	corev1.RegisterSubscriptionHTTPServer(httpSrv.Container, _subscriptionSrv)
 	corev1.RegisterSubscriptionServer(grpcSrv.GetServe(), _subscriptionSrv)
 
+	// register migration service.
+	_migrationSrv = service.NewMigrationService()
+	corev1.RegisterMigrationHTTPServer(httpSrv.Container, _migrationSrv)
+	corev1.RegisterMigrationServer(grpcSrv.GetServe(), _migrationSrv)
+
+	// register function service.
+	_functionSrv = service.NewFunctionService()
+	corev1.RegisterFunctionHTTPServer(httpSrv.Container, _functionSrv)
+	corev1.RegisterFunctionServer(grpcSrv.GetServe(), _functionSrv)
+
 	// register topic service.
 	if _topicSrv, err = service.NewTopicService(ctx); nil != err {
 		log.Fatal(err)
@@ -351,6 +456,29 @@ func newResourceManager(coreRepo repository.IRepository) types.ResourceManager {
 	return types.NewResources(search.GlobalService, tsdbClient, rawdataClient, coreRepo)
 }
 
+func newHistory() history.History {
+	cfg := config.Get().History
+	if !cfg.Enabled {
+		return nil
+	}
+
+	storeCfg := cfg.Store
+	if storeCfg.Name == "" {
+		storeCfg = config.Get().Components.Store
+	}
+
+	log.L().Info("create entity history", logf.String("store", storeCfg.Name))
+	return history.New(store.NewStore(resource.ParseFrom(storeCfg)), cfg.Checkpoint, cfg.MaxRecords)
+}
+
+func handlerConfs(cfgs []config.HandlerConfig) []runtime.HandlerConf {
+	confs := make([]runtime.HandlerConf, 0, len(cfgs))
+	for _, cfg := range cfgs {
+		confs = append(confs, runtime.HandlerConf{Name: cfg.Name, Enabled: cfg.Enabled, Order: cfg.Order})
+	}
+	return confs
+}
+
 func loadDispatcher(ctx context.Context) error {
 	log.L().Info("load dispatcher...")
 	dispatcher := dispatch.New(ctx)
@@ -359,6 +487,13 @@ func loadDispatcher(ctx context.Context) error {
 		return errors.Wrap(err, "start dispatcher")
 	}
 
+	// scale runtimes by downstreams reloaded, runtimes rebalanced when placement changed.
+	config.OnChanged(func(cfg config.Configuration) {
+		if err := dispatcher.UpdateDownstreams(ctx, cfg.Dispatcher.Downstreams); nil != err {
+			log.L().Error("update dispatcher downstreams", logf.Error(err), logf.ID(cfg.Dispatcher.ID))
+		}
+	})
+
 	log.L().Info("dispatcher loaded")
 	_dispatcher = dispatcher
 	return nil
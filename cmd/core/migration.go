@@ -0,0 +1,134 @@
+/*
+Copyright 2021 The tKeel Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/tkeel-io/core/pkg/config"
+	"github.com/tkeel-io/core/pkg/migration"
+	"github.com/tkeel-io/core/pkg/repository"
+	"github.com/tkeel-io/core/pkg/repository/dao"
+	"github.com/tkeel-io/core/pkg/resource/search"
+	"github.com/tkeel-io/kit/log"
+)
+
+const _migrationCmdExample = `export entities of owner, or entities matched by query:
+core export -c config.yml --owner admin -o admin.ndjson
+core export -c config.yml --query device --source abcd
+
+import entities exported, entities already exist are skipped:
+core import -c config.yml -f admin.ndjson
+`
+
+func newExportCmd() *cobra.Command {
+	var filter migration.Filter
+	var output string
+	cmd := &cobra.Command{
+		Use:     "export",
+		Short:   "Export entities into NDJSON",
+		Example: _migrationCmdExample,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if filter.Owner == "" && filter.Query == "" {
+				return errors.New("owner or query required")
+			}
+
+			migrator, err := newMigrator(cmd.Context())
+			if nil != err {
+				return err
+			}
+
+			var w io.Writer = os.Stdout
+			if output != "" && output != "-" {
+				file, err := os.Create(output)
+				if nil != err {
+					return errors.Wrap(err, "create output file")
+				}
+				defer file.Close()
+				w = file
+			}
+
+			stats, err := migrator.Export(cmd.Context(), &filter, w)
+			if nil != err {
+				return errors.Wrap(err, "export entities")
+			}
+			fmt.Fprintf(os.Stderr, "exported %d entities, %d expressions, %d subscriptions, %d relationships\n",
+				stats.Entities, stats.Expressions, stats.Subscriptions, stats.Relationships)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&filter.Owner, "owner", "", "owner of entities exported.")
+	cmd.Flags().StringVar(&filter.Source, "source", "", "source of entities exported.")
+	cmd.Flags().StringVar(&filter.Query, "query", "", "search query of entities exported.")
+	cmd.Flags().StringVarP(&output, "output", "o", "-", "output file, stdout if '-'.")
+	return cmd
+}
+
+func newImportCmd() *cobra.Command {
+	var input string
+	cmd := &cobra.Command{
+		Use:     "import",
+		Short:   "Import entities from NDJSON exported",
+		Example: _migrationCmdExample,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			migrator, err := newMigrator(cmd.Context())
+			if nil != err {
+				return err
+			}
+
+			var r io.Reader = os.Stdin
+			if input != "" && input != "-" {
+				file, err := os.Open(input)
+				if nil != err {
+					return errors.Wrap(err, "open input file")
+				}
+				defer file.Close()
+				r = file
+			}
+
+			stats, err := migrator.Import(cmd.Context(), r)
+			fmt.Fprintf(os.Stderr, "imported %d entities, %d expressions, %d subscriptions, %d relationships, skipped %d\n",
+				stats.Entities, stats.Expressions, stats.Subscriptions, stats.Relationships, stats.Skipped)
+			return errors.Wrap(err, "import entities")
+		},
+	}
+
+	cmd.Flags().StringVarP(&input, "file", "f", "-", "input file, stdin if '-'.")
+	return cmd
+}
+
+// newMigrator load configuration and connect repository and search engine of core.
+func newMigrator(ctx context.Context) (*migration.Migrator, error) {
+	config.Init(_cfgFile)
+	log.InfoStatusEvent(os.Stderr, "configuration loaded")
+
+	if err := search.Init(config.Get().Components.SearchEngine); nil != err {
+		return nil, errors.Wrap(err, "initialize search engine")
+	}
+
+	coreDao, err := dao.New(ctx, config.Get().Components.Store, config.Get().Components.Etcd)
+	if nil != err {
+		return nil, errors.Wrap(err, "initialize repository")
+	}
+	return migration.New(repository.New(coreDao), search.GlobalService), nil
+}
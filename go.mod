@@ -39,18 +39,19 @@ require (
 
 require (
 	github.com/ClickHouse/clickhouse-go/v2 v2.0.14
+	github.com/antlr/antlr4/runtime/Go/antlr v0.0.0-20211026222012-6af4c774c47b
 	github.com/evanphx/json-patch v4.9.0+incompatible
 	github.com/google/uuid v1.3.0
 	github.com/jmoiron/sqlx v1.3.5
 	github.com/json-iterator/go v1.1.12
 	github.com/prometheus/client_golang v1.11.0
+	github.com/tetratelabs/wazero v1.2.1
 	github.com/tkeel-io/kit v0.0.0-20220516081405-657ecd52268a
 	github.com/valyala/fastrand v1.1.0
 )
 
 require (
 	github.com/DataDog/zstd v1.4.6-0.20210211175136-c6db21d202f4 // indirect
-	github.com/antlr/antlr4/runtime/Go/antlr v0.0.0-20211026222012-6af4c774c47b // indirect
 	github.com/beorn7/perks v1.0.1 // indirect
 	github.com/cenkalti/backoff/v4 v4.1.1 // indirect
 	github.com/cespare/xxhash/v2 v2.1.1 // indirect
@@ -46,6 +46,8 @@ type Configuration struct {
 	Discovery  Discovery      `yaml:"discovery" mapstructure:"discovery"`
 	Components Components     `yaml:"components" mapstructure:"components"`
 	Dispatcher DispatchConfig `yaml:"dispatcher" mapstructure:"dispatcher"`
+	Runtime    RuntimeConfig  `yaml:"runtime" mapstructure:"runtime"`
+	History    HistoryConfig  `yaml:"history" mapstructure:"history"`
 }
 
 type Server struct {
@@ -56,6 +58,66 @@ type Server struct {
 	Sources  []string `yaml:"sources" mapstructure:"sources"`
 }
 
+type RuntimeConfig struct {
+	// SnapshotInterval seconds between runtime snapshots, disabled if zero.
+	SnapshotInterval int64 `yaml:"snapshot_interval" mapstructure:"snapshot_interval"`
+	// Entities budget of entities owned by each runtime.
+	Entities CacheConfig `yaml:"entities" mapstructure:"entities"`
+	// Cache budget of entities cached from other runtimes.
+	Cache CacheConfig `yaml:"cache" mapstructure:"cache"`
+	// Workers goroutines handling events of each runtime.
+	Workers int `yaml:"workers" mapstructure:"workers"`
+	// QueueSize messages queued in each runtime, consuming blocks while queue is full.
+	QueueSize int `yaml:"queue_size" mapstructure:"queue_size"`
+	// Retention default retention of entity telemetry and raw data,
+	// overridden by the retention defined in entity configs.
+	Retention RetentionConfig `yaml:"retention" mapstructure:"retention"`
+	// Handlers plugins enabled in the pipeline of runtimes, plugins must be registered.
+	Handlers []HandlerConfig `yaml:"handlers" mapstructure:"handlers"`
+	// Functions limits of WebAssembly modules registering user functions.
+	Functions FunctionConfig `yaml:"functions" mapstructure:"functions"`
+	// MaxTTL hops of events computed by expressions from other events, exceeding dropped.
+	MaxTTL int `yaml:"max_ttl" mapstructure:"max_ttl"`
+}
+
+// FunctionConfig limits memory pages of 64KiB, milliseconds of each call and bytes of module binaries.
+type FunctionConfig struct {
+	MaxMemoryPages uint32 `yaml:"max_memory_pages" mapstructure:"max_memory_pages"`
+	Timeout        int64  `yaml:"timeout" mapstructure:"timeout"`
+	MaxModuleSize  int    `yaml:"max_module_size" mapstructure:"max_module_size"`
+}
+
+// HandlerConfig enable a handler plugin, plugins run in the order of order.
+type HandlerConfig struct {
+	Name    string `yaml:"name" mapstructure:"name"`
+	Enabled bool   `yaml:"enabled" mapstructure:"enabled"`
+	Order   int    `yaml:"order" mapstructure:"order"`
+}
+
+// RetentionConfig limits keys, age in seconds and bytes of entity telemetry and raw data,
+// the default retention of runtime used if all zero.
+type RetentionConfig struct {
+	MaxKeys  int   `yaml:"max_keys" mapstructure:"max_keys"`
+	MaxAge   int64 `yaml:"max_age" mapstructure:"max_age"`
+	MaxBytes int   `yaml:"max_bytes" mapstructure:"max_bytes"`
+}
+
+// CacheConfig limits entries and bytes of cache, unlimited if zero.
+type CacheConfig struct {
+	MaxEntries int   `yaml:"max_entries" mapstructure:"max_entries"`
+	MaxBytes   int64 `yaml:"max_bytes" mapstructure:"max_bytes"`
+}
+
+type HistoryConfig struct {
+	Enabled bool `yaml:"enabled" mapstructure:"enabled"`
+	// Checkpoint records between full states of entity recorded.
+	Checkpoint int `yaml:"checkpoint" mapstructure:"checkpoint"`
+	// MaxRecords records retained of each entity.
+	MaxRecords int `yaml:"max_records" mapstructure:"max_records"`
+	// Store stores history records, components.store used if not specified.
+	Store Metadata `yaml:"store" mapstructure:"store"`
+}
+
 type Proxy struct {
 	HTTPPort int `yaml:"http_port" mapstructure:"http_port"`
 	GRPCPort int `yaml:"grpc_port" mapstructure:"grpc_port"`
@@ -122,7 +184,7 @@ func Init(cfgFile string) {
 	}
 
 	if err := viper.ReadInConfig(); nil != err {
-		if _, ok := err.(viper.ConfigFileNotFoundError); ok || errors.As(err, &fs.ErrNotExist) { //nolint
+		if _, ok := err.(viper.ConfigFileNotFoundError); ok || errors.Is(err, fs.ErrNotExist) { //nolint
 			// Config file not found.
 			defer writeDefault(cfgFile)
 		} else {
@@ -134,6 +196,10 @@ func Init(cfgFile string) {
 	// set command line configuration.
 	viper.Unmarshal(&_config)
 
+	viper.OnConfigChange(func(ev fsnotify.Event) {
+		onConfigChanged(ev)
+		notify(Get())
+	})
 	viper.WatchConfig()
 }
 
@@ -169,6 +235,23 @@ func init() {
 	viper.SetDefault("discovery.dial_timeout", _defaultDiscovery.DialTimeout)
 	viper.SetDefault("components.etcd.endpoints", _defaultEtcdConfig.Endpoints)
 	viper.SetDefault("components.etcd.dial_timeout", _defaultEtcdConfig.DialTimeout)
+	viper.SetDefault("runtime.snapshot_interval", _defaultRuntimeConfig.SnapshotInterval)
+	viper.SetDefault("runtime.entities.max_entries", _defaultRuntimeConfig.Entities.MaxEntries)
+	viper.SetDefault("runtime.entities.max_bytes", _defaultRuntimeConfig.Entities.MaxBytes)
+	viper.SetDefault("runtime.cache.max_entries", _defaultRuntimeConfig.Cache.MaxEntries)
+	viper.SetDefault("runtime.cache.max_bytes", _defaultRuntimeConfig.Cache.MaxBytes)
+	viper.SetDefault("runtime.workers", _defaultRuntimeConfig.Workers)
+	viper.SetDefault("runtime.queue_size", _defaultRuntimeConfig.QueueSize)
+	viper.SetDefault("runtime.retention.max_keys", _defaultRuntimeConfig.Retention.MaxKeys)
+	viper.SetDefault("runtime.retention.max_age", _defaultRuntimeConfig.Retention.MaxAge)
+	viper.SetDefault("runtime.retention.max_bytes", _defaultRuntimeConfig.Retention.MaxBytes)
+	viper.SetDefault("runtime.functions.max_memory_pages", _defaultRuntimeConfig.Functions.MaxMemoryPages)
+	viper.SetDefault("runtime.functions.timeout", _defaultRuntimeConfig.Functions.Timeout)
+	viper.SetDefault("runtime.functions.max_module_size", _defaultRuntimeConfig.Functions.MaxModuleSize)
+	viper.SetDefault("runtime.max_ttl", _defaultRuntimeConfig.MaxTTL)
+	viper.SetDefault("history.enabled", _defaultHistoryConfig.Enabled)
+	viper.SetDefault("history.checkpoint", _defaultHistoryConfig.Checkpoint)
+	viper.SetDefault("history.max_records", _defaultHistoryConfig.MaxRecords)
 
 	viper.SetEnvPrefix(_corePrefix)
 	viper.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
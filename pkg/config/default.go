@@ -30,6 +30,32 @@ var (
 		DialTimeout: 3,
 		Endpoints:   []string{"http://localhost:2379"},
 	}
+	_defaultRuntimeConfig = RuntimeConfig{
+		SnapshotInterval: 300,
+		Entities: CacheConfig{
+			MaxEntries: 100000,
+		},
+		Cache: CacheConfig{
+			MaxEntries: 50000,
+		},
+		Workers:   8,
+		QueueSize: 1024,
+		Retention: RetentionConfig{
+			MaxKeys:  1000,
+			MaxBytes: 512 * 1024,
+		},
+		Functions: FunctionConfig{
+			MaxMemoryPages: 256,
+			Timeout:        100,
+			MaxModuleSize:  1024 * 1024,
+		},
+		MaxTTL: 16,
+	}
+	_defaultHistoryConfig = HistoryConfig{
+		Enabled:    false,
+		Checkpoint: 20,
+		MaxRecords: 1000,
+	}
 	_defaultDiscovery = Discovery{
 		HeartTime:   3,
 		DialTimeout: 3,
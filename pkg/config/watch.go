@@ -0,0 +1,25 @@
+package config
+
+import "sync"
+
+var _handlers []ChangedHandler
+var _lock sync.Mutex
+
+// ChangedHandler called with configuration reloaded after configuration file changed.
+type ChangedHandler func(Configuration)
+
+// OnChanged register handler called after configuration file changed.
+func OnChanged(handler ChangedHandler) {
+	_lock.Lock()
+	_handlers = append(_handlers, handler)
+	_lock.Unlock()
+}
+
+func notify(cfg Configuration) {
+	_lock.Lock()
+	handlers := _handlers
+	_lock.Unlock()
+	for _, handler := range handlers {
+		handler(cfg)
+	}
+}
@@ -3,10 +3,12 @@ package dispatch
 import (
 	"context"
 	"net/http"
+	"sync"
 
 	"github.com/pkg/errors"
 	v1 "github.com/tkeel-io/core/api/core/v1"
 	"github.com/tkeel-io/core/pkg/config"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
 	logf "github.com/tkeel-io/core/pkg/logfield"
 	"github.com/tkeel-io/core/pkg/placement"
 	"github.com/tkeel-io/core/pkg/resource/pubsub"
@@ -16,17 +18,33 @@ import (
 	"github.com/tkeel-io/kit/log"
 )
 
-func New(ctx context.Context) *dispatcher { //nolint
+type Option func(*dispatcher)
+
+// WithDownstreamFactory create downstreams by factory, kafka downstreams created by default.
+func WithDownstreamFactory(factory func(string) (Downstream, error)) Option {
+	return func(d *dispatcher) {
+		d.newDownstream = factory
+	}
+}
+
+func New(ctx context.Context, opts ...Option) *dispatcher { //nolint
 	ctx, cancel := context.WithCancel(ctx)
-	return &dispatcher{
-		id:          util.UUID("dispatcher"),
-		ctx:         ctx,
-		cancel:      cancel,
-		transmitter: transport.New(transport.TransTypeHTTP),
-		upstreams:   make(map[string]pubsub.Pubsub),
-		downstreams: make(map[string]*xkafka.Pubsub),
-		logstreams:  nil,
+	d := &dispatcher{
+		id:            util.UUID("dispatcher"),
+		ctx:           ctx,
+		cancel:        cancel,
+		transmitter:   transport.New(transport.TransTypeHTTP),
+		upstreams:     make(map[string]pubsub.Pubsub),
+		downstreams:   make(map[string]Downstream),
+		streamURLs:    make(map[string]string),
+		newDownstream: newKafkaDownstream,
+		logstreams:    nil,
+	}
+
+	for _, opt := range opts {
+		opt(d)
 	}
+	return d
 }
 
 type dispatcher struct {
@@ -35,8 +53,20 @@ type dispatcher struct {
 	cancel      context.CancelFunc
 	transmitter transport.Transmitter
 	upstreams   map[string]pubsub.Pubsub
-	downstreams map[string]*xkafka.Pubsub
-	logstreams  *xkafka.Pubsub
+	downstreams map[string]Downstream
+	// map[url]downstreamID, urls of downstreams created.
+	streamURLs    map[string]string
+	newDownstream func(string) (Downstream, error)
+	logstreams    *xkafka.Pubsub
+	lock          sync.RWMutex
+}
+
+func newKafkaDownstream(urlText string) (Downstream, error) {
+	streamIns, err := xkafka.NewKafkaPubsub(urlText)
+	if nil != err {
+		return nil, errors.Wrap(err, "create kafka downstream")
+	}
+	return streamIns, nil
 }
 
 func (d *dispatcher) DispatchToLog(ctx context.Context, ev []byte) error {
@@ -99,7 +129,17 @@ func (d *dispatcher) dispatch(ctx context.Context, ev v1.Event) error {
 		info := placement.Global().Select(eid)
 		partitionID = info.ID
 	}
-	err := d.downstreams[partitionID].Send(ctx, ev)
+
+	d.lock.RLock()
+	downstream, has := d.downstreams[partitionID]
+	d.lock.RUnlock()
+	if !has {
+		log.L().Error("dispatch event, downstream not exists",
+			logf.ID(ev.ID()), logf.Eid(eid), logf.String("partition", partitionID))
+		return errors.Wrap(xerrors.ErrRuntimeNotExists, "dispatch event")
+	}
+
+	err := downstream.Send(ctx, ev)
 	return errors.Wrap(err, "dispatch event")
 }
 
@@ -120,13 +160,56 @@ func (d *dispatcher) initUpstream(ctx context.Context, streams []string) error {
 }
 
 func (d *dispatcher) initDownstream(ctx context.Context, streams []string) error {
+	return d.UpdateDownstreams(ctx, streams)
+}
+
+// UpdateDownstreams append downstreams created from streams into placement, and remove
+// downstreams not in streams, runtimes rebalanced by placement changed handlers.
+func (d *dispatcher) UpdateDownstreams(ctx context.Context, streams []string) error {
+	expected := make(map[string]bool, len(streams))
 	for _, stream := range streams {
-		streamIns, err := xkafka.NewKafkaPubsub(stream)
+		expected[stream] = true
+		d.lock.RLock()
+		_, has := d.streamURLs[stream]
+		d.lock.RUnlock()
+		if has {
+			continue
+		}
+
+		streamIns, err := d.newDownstream(stream)
 		if nil != err {
 			return errors.Wrap(err, "create sink instance")
 		}
+
+		d.lock.Lock()
+		d.streamURLs[stream] = streamIns.ID()
 		d.downstreams[streamIns.ID()] = streamIns
+		d.lock.Unlock()
+		log.L().Info("append downstream", logf.ID(streamIns.ID()))
 		placement.Global().Append(placement.Info{ID: streamIns.ID()})
 	}
+
+	d.lock.RLock()
+	removed := make(map[string]string)
+	for stream, id := range d.streamURLs {
+		if !expected[stream] {
+			removed[stream] = id
+		}
+	}
+	d.lock.RUnlock()
+
+	for stream, id := range removed {
+		// entities remapped before downstream closed, events in flight forwarded by runtimes.
+		log.L().Info("remove downstream", logf.ID(id))
+		placement.Global().Remove(placement.Info{ID: id})
+		d.lock.Lock()
+		streamIns := d.downstreams[id]
+		delete(d.streamURLs, stream)
+		delete(d.downstreams, id)
+		d.lock.Unlock()
+		if err := streamIns.Close(); nil != err {
+			log.L().Warn("close downstream", logf.ID(id), logf.Reason(err.Error()))
+		}
+	}
 	return nil
 }
@@ -10,3 +10,10 @@ type Dispatcher interface {
 	DispatchToLog(context.Context, []byte) error
 	Dispatch(context.Context, v1.Event) error
 }
+
+// Downstream is a queue which events of entities placed on dispatched to.
+type Downstream interface {
+	ID() string
+	Send(context.Context, v1.Event) error
+	Close() error
+}
@@ -38,6 +38,18 @@ var (
 	ErrConnectionNil            = errors.New("Core.Resource.Connection.Nil")
 	ErrInvalidParam             = errors.New("Core.Params.Invalid")
 	ErrExpressionNotFound       = errors.New("Core.Expression.NotFound")
+	ErrEntityVersionConflict    = errors.New("Core.Entity.Version.Conflict")
+	ErrPatchTestFailed          = errors.New("Core.Entity.Patch.TestFailed")
+	ErrEntityLocked             = errors.New("Core.Entity.Locked")
+	ErrTransactionNotFound      = errors.New("Core.Transaction.NotFound")
+	ErrTransactionAborted       = errors.New("Core.Transaction.Aborted")
+	ErrHistoryNotFound          = errors.New("Core.History.NotFound")
+	ErrHistoryDisabled          = errors.New("Core.History.Disabled")
+	ErrScheduleInvalid          = errors.New("Core.Schedule.Invalid")
+	ErrRelationshipInvalid      = errors.New("Core.Relationship.Invalid")
+	ErrFunctionInvalid          = errors.New("Core.Function.Invalid")
+	ErrExpressionInvalid        = errors.New("Core.Expression.Invalid")
+	ErrExpressionCycle          = errors.New("Core.Expression.Cycle")
 
 	// ErrResourceNotFound errors.
 	ErrResourceNotFound = errors.New("Core.Resource.NotFound")
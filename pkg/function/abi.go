@@ -0,0 +1,305 @@
+package function
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+	"github.com/tetratelabs/wazero/imports/wasi_snapshot_preview1"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"github.com/tkeel-io/core/pkg/repository"
+	"github.com/tkeel-io/tdtl"
+)
+
+/*
+functions exchange values with modules as follows:
+  - int: i32 or i64.
+  - float: f32 or f64.
+  - bool: i32, zero is false.
+  - string: param passed as pointer and length (i32, i32) of bytes written into memory
+    allocated by `alloc(size i32) i32`, result returned as i64, pointer in the high 32 bits
+    and length in the low 32 bits.
+memory allocated for strings is released by `dealloc(ptr i32, size i32)` if exported.
+*/
+
+var wasmTypes = map[string][][]api.ValueType{
+	repository.FunctionTypeInt:    {{api.ValueTypeI32}, {api.ValueTypeI64}},
+	repository.FunctionTypeFloat:  {{api.ValueTypeF32}, {api.ValueTypeF64}},
+	repository.FunctionTypeBool:   {{api.ValueTypeI32}},
+	repository.FunctionTypeString: {{api.ValueTypeI32, api.ValueTypeI32}},
+}
+
+var wasmResultTypes = map[string][]api.ValueType{
+	repository.FunctionTypeInt:    {api.ValueTypeI32, api.ValueTypeI64},
+	repository.FunctionTypeFloat:  {api.ValueTypeF32, api.ValueTypeF64},
+	repository.FunctionTypeBool:   {api.ValueTypeI32},
+	repository.FunctionTypeString: {api.ValueTypeI64},
+}
+
+func invalid(format string, args ...interface{}) error {
+	return errors.Wrapf(xerrors.ErrFunctionInvalid, format, args...)
+}
+
+// compile the module, check imports of the module and signatures of functions declared.
+func (r *Registry) compile(ctx context.Context, fn *repository.Function) (wazero.CompiledModule, error) {
+	switch {
+	case fn.ID == "" || strings.Contains(fn.ID, "/"):
+		return nil, invalid("invalid module id %s", fn.ID)
+	case len(fn.Module) == 0:
+		return nil, invalid("module %s, binary required", fn.ID)
+	case len(fn.Module) > r.cfg.MaxModuleSize:
+		return nil, invalid("module %s, size %d exceeds %d", fn.ID, len(fn.Module), r.cfg.MaxModuleSize)
+	case len(fn.Functions) == 0:
+		return nil, invalid("module %s, functions required", fn.ID)
+	}
+
+	compiled, err := r.runtime.CompileModule(ctx, fn.Module)
+	if nil != err {
+		return nil, invalid("module %s, %s", fn.ID, err.Error())
+	}
+
+	if err = checkModule(compiled, fn); nil != err {
+		compiled.Close(ctx) //nolint
+		return nil, err
+	}
+	return compiled, nil
+}
+
+func checkModule(compiled wazero.CompiledModule, fn *repository.Function) error {
+	// sandboxed, imports nothing but wasi.
+	for _, def := range compiled.ImportedFunctions() {
+		if moduleName, name, _ := def.Import(); moduleName != wasi_snapshot_preview1.ModuleName {
+			return invalid("module %s, import %s.%s not allowed", fn.ID, moduleName, name)
+		}
+	}
+	if len(compiled.ImportedMemories()) > 0 {
+		return invalid("module %s, import memory not allowed", fn.ID)
+	}
+
+	names := make(map[string]bool)
+	exports := compiled.ExportedFunctions()
+	for _, spec := range fn.Functions {
+		if spec.Name == "" || names[spec.Name] {
+			return invalid("module %s, function name %s empty or duplicated", fn.ID, spec.Name)
+		}
+		names[spec.Name] = true
+
+		def, has := exports[exportName(spec)]
+		if !has {
+			return invalid("module %s, function %s not exported", fn.ID, exportName(spec))
+		} else if err := checkSignature(def, spec); nil != err {
+			return invalid("module %s, function %s, %s", fn.ID, spec.Name, err.Error())
+		}
+
+		if usesString(spec) {
+			if _, has = compiled.ExportedMemories()[exportMemory]; !has {
+				return invalid("module %s, memory not exported", fn.ID)
+			}
+			alloc, has := exports[exportAlloc]
+			if !has || !equalTypes(alloc.ParamTypes(), []api.ValueType{api.ValueTypeI32}) ||
+				!equalTypes(alloc.ResultTypes(), []api.ValueType{api.ValueTypeI32}) {
+				return invalid("module %s, alloc(i32) i32 not exported", fn.ID)
+			}
+		}
+	}
+	return nil
+}
+
+func exportName(spec *repository.FunctionSpec) string {
+	if spec.Export != "" {
+		return spec.Export
+	}
+	return spec.Name
+}
+
+func usesString(spec *repository.FunctionSpec) bool {
+	if spec.Result == repository.FunctionTypeString {
+		return true
+	}
+	for _, param := range spec.Params {
+		if param == repository.FunctionTypeString {
+			return true
+		}
+	}
+	return false
+}
+
+func checkSignature(def api.FunctionDefinition, spec *repository.FunctionSpec) error {
+	params := def.ParamTypes()
+	for _, param := range spec.Params {
+		candidates, has := wasmTypes[param]
+		if !has {
+			return errors.Errorf("invalid param type %s", param)
+		}
+
+		matched := false
+		for _, types := range candidates {
+			if len(params) >= len(types) && equalTypes(params[:len(types)], types) {
+				params, matched = params[len(types):], true
+				break
+			}
+		}
+		if !matched {
+			return errors.Errorf("param %s mismatched with %s", param, api.ValueTypeName(firstType(params)))
+		}
+	}
+	if len(params) > 0 {
+		return errors.Errorf("%d params exported, %d declared", len(def.ParamTypes()), len(spec.Params))
+	}
+
+	results := def.ResultTypes()
+	if len(results) != 1 {
+		return errors.Errorf("single result required, %d exported", len(results))
+	}
+	for _, typ := range wasmResultTypes[spec.Result] {
+		if typ == results[0] {
+			return nil
+		}
+	}
+	return errors.Errorf("result %s mismatched with %s", spec.Result, api.ValueTypeName(results[0]))
+}
+
+func firstType(types []api.ValueType) api.ValueType {
+	if len(types) == 0 {
+		return 0
+	}
+	return types[0]
+}
+
+func equalTypes(a, b []api.ValueType) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for index := range a {
+		if a[index] != b[index] {
+			return false
+		}
+	}
+	return true
+}
+
+// allocation is memory allocated in the module for strings.
+type allocation struct {
+	ptr  uint32
+	size uint32
+}
+
+// call the function exported by instance, args converted according to spec.
+func call(ctx context.Context, instance api.Module, spec *repository.FunctionSpec, args []tdtl.Node) (tdtl.Node, error) {
+	if len(args) != len(spec.Params) {
+		return nil, errors.Errorf("%d args required, %d given", len(spec.Params), len(args))
+	}
+
+	var allocs []allocation
+	defer func() { release(ctx, instance, allocs) }()
+
+	fn := instance.ExportedFunction(exportName(spec))
+	types := fn.Definition().ParamTypes()
+	params := make([]uint64, 0, len(types))
+	for index, param := range spec.Params {
+		typ := types[len(params)]
+		switch param {
+		case repository.FunctionTypeInt:
+			val, ok := args[index].To(tdtl.Int).(tdtl.IntNode)
+			if !ok {
+				return nil, errors.Errorf("arg %d, int required", index)
+			}
+			params = append(params, encodeInt(typ, int64(val)))
+		case repository.FunctionTypeFloat:
+			val, ok := args[index].To(tdtl.Float).(tdtl.FloatNode)
+			if !ok {
+				return nil, errors.Errorf("arg %d, float required", index)
+			}
+			params = append(params, encodeFloat(typ, float64(val)))
+		case repository.FunctionTypeBool:
+			val, ok := args[index].To(tdtl.Bool).(tdtl.BoolNode)
+			if !ok {
+				return nil, errors.Errorf("arg %d, bool required", index)
+			}
+			params = append(params, api.EncodeI32(boolInt(bool(val))))
+		case repository.FunctionTypeString:
+			alloc, err := writeString(ctx, instance, args[index].To(tdtl.String).String())
+			if nil != err {
+				return nil, errors.Wrapf(err, "arg %d", index)
+			}
+			allocs = append(allocs, alloc)
+			params = append(params, api.EncodeU32(alloc.ptr), api.EncodeU32(alloc.size))
+		}
+	}
+
+	results, err := fn.Call(ctx, params...)
+	if nil != err {
+		return nil, errors.Wrap(err, "call module")
+	}
+
+	ret := results[0]
+	switch typ := fn.Definition().ResultTypes()[0]; spec.Result {
+	case repository.FunctionTypeInt:
+		if typ == api.ValueTypeI32 {
+			return tdtl.IntNode(api.DecodeI32(ret)), nil
+		}
+		return tdtl.IntNode(int64(ret)), nil
+	case repository.FunctionTypeFloat:
+		if typ == api.ValueTypeF32 {
+			return tdtl.FloatNode(api.DecodeF32(ret)), nil
+		}
+		return tdtl.FloatNode(api.DecodeF64(ret)), nil
+	case repository.FunctionTypeBool:
+		return tdtl.BoolNode(api.DecodeI32(ret) != 0), nil
+	default:
+		alloc := allocation{ptr: uint32(ret >> 32), size: uint32(ret)}
+		bytes, ok := instance.Memory().Read(alloc.ptr, alloc.size)
+		if !ok {
+			return nil, errors.Errorf("result out of memory range")
+		}
+		allocs = append(allocs, alloc)
+		return tdtl.StringNode(bytes), nil
+	}
+}
+
+func encodeInt(typ api.ValueType, val int64) uint64 {
+	if typ == api.ValueTypeI32 {
+		return api.EncodeI32(int32(val))
+	}
+	return api.EncodeI64(val)
+}
+
+func encodeFloat(typ api.ValueType, val float64) uint64 {
+	if typ == api.ValueTypeF32 {
+		return api.EncodeF32(float32(val))
+	}
+	return api.EncodeF64(val)
+}
+
+func boolInt(val bool) int32 {
+	if val {
+		return 1
+	}
+	return 0
+}
+
+func writeString(ctx context.Context, instance api.Module, val string) (allocation, error) {
+	size := uint32(len(val))
+	results, err := instance.ExportedFunction(exportAlloc).Call(ctx, api.EncodeU32(size))
+	if nil != err {
+		return allocation{}, errors.Wrap(err, "alloc memory")
+	}
+
+	alloc := allocation{ptr: api.DecodeU32(results[0]), size: size}
+	if !instance.Memory().Write(alloc.ptr, []byte(val)) {
+		return allocation{}, errors.Errorf("memory allocated out of range")
+	}
+	return alloc, nil
+}
+
+func release(ctx context.Context, instance api.Module, allocs []allocation) {
+	dealloc := instance.ExportedFunction(exportDealloc)
+	if nil == dealloc {
+		return
+	}
+	for _, alloc := range allocs {
+		dealloc.Call(ctx, api.EncodeU32(alloc.ptr), api.EncodeU32(alloc.size)) //nolint
+	}
+}
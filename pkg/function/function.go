@@ -0,0 +1,294 @@
+package function
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+	"github.com/tetratelabs/wazero/imports/wasi_snapshot_preview1"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	logf "github.com/tkeel-io/core/pkg/logfield"
+	"github.com/tkeel-io/core/pkg/mapper/expression"
+	"github.com/tkeel-io/core/pkg/repository"
+	"github.com/tkeel-io/core/pkg/repository/dao"
+	"github.com/tkeel-io/kit/log"
+	"github.com/tkeel-io/tdtl"
+)
+
+const (
+	// exports of module required by functions passing strings.
+	exportMemory = "memory"
+	exportAlloc  = "alloc"
+	// exportDealloc optional export, called with pointer and size of memory allocated after calls.
+	exportDealloc = "dealloc"
+	// exportInitialize start function of reactor modules.
+	exportInitialize = "_initialize"
+)
+
+// Config limits resources of modules.
+type Config struct {
+	// MaxMemoryPages pages of 64KiB a module instance allocates at most.
+	MaxMemoryPages uint32
+	// Timeout bounds each call of functions.
+	Timeout time.Duration
+	// MaxModuleSize bounds bytes of module binaries.
+	MaxModuleSize int
+}
+
+var defaultConfig = Config{
+	MaxMemoryPages: 256,
+	Timeout:        100 * time.Millisecond,
+	MaxModuleSize:  1 << 20,
+}
+
+// Registry compiles WebAssembly modules and registers functions declared by modules,
+// functions are called in a sandbox without access to host, bounded in memory and time.
+type Registry struct {
+	cfg     Config
+	runtime wazero.Runtime
+	lock    sync.RWMutex
+	// map[moduleID]module.
+	modules map[string]*module
+	// map[functionName]binding.
+	bindings map[string]*binding
+}
+
+type module struct {
+	id       string
+	compiled wazero.CompiledModule
+	// instance is not safe for concurrent use, instantiated lazily, dropped after failed calls.
+	instance api.Module
+	lock     sync.Mutex
+}
+
+type binding struct {
+	spec   repository.FunctionSpec
+	module *module
+}
+
+func New(ctx context.Context, cfg Config) *Registry {
+	if cfg.MaxMemoryPages == 0 {
+		cfg.MaxMemoryPages = defaultConfig.MaxMemoryPages
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = defaultConfig.Timeout
+	}
+	if cfg.MaxModuleSize <= 0 {
+		cfg.MaxModuleSize = defaultConfig.MaxModuleSize
+	}
+
+	runtime := wazero.NewRuntimeWithConfig(ctx, wazero.NewRuntimeConfig().
+		WithMemoryLimitPages(cfg.MaxMemoryPages).
+		WithCloseOnContextDone(true))
+	// modules compiled for wasi run without file system, environment and arguments.
+	wasi_snapshot_preview1.MustInstantiate(ctx, runtime)
+
+	return &Registry{
+		cfg:      cfg,
+		runtime:  runtime,
+		modules:  make(map[string]*module),
+		bindings: make(map[string]*binding),
+	}
+}
+
+// Validate compile the module and check functions declared, without registering.
+func (r *Registry) Validate(ctx context.Context, fn *repository.Function) error {
+	compiled, err := r.compile(ctx, fn)
+	if nil != err {
+		return err
+	}
+	defer compiled.Close(ctx) //nolint
+
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	return r.checkConflict(fn)
+}
+
+// Load compile the module and register functions declared, replaces the module loaded before.
+func (r *Registry) Load(ctx context.Context, fn *repository.Function) error {
+	compiled, err := r.compile(ctx, fn)
+	if nil != err {
+		return err
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if err = r.checkConflict(fn); nil != err {
+		compiled.Close(ctx) //nolint
+		return err
+	}
+
+	r.unload(ctx, fn.ID)
+	mod := &module{id: fn.ID, compiled: compiled}
+	r.modules[fn.ID] = mod
+	for _, spec := range fn.Functions {
+		r.bindings[spec.Name] = &binding{spec: *spec, module: mod}
+	}
+
+	log.L().Info("load function module", logf.ID(fn.ID), logf.Value(len(fn.Functions)))
+	return nil
+}
+
+// checkConflict check functions declared not builtin or registered by other modules.
+func (r *Registry) checkConflict(fn *repository.Function) error {
+	for _, spec := range fn.Functions {
+		if expression.IsBuiltin(spec.Name) {
+			return errors.Wrapf(xerrors.ErrFunctionInvalid, "function %s is builtin", spec.Name)
+		}
+		if b, has := r.bindings[spec.Name]; has && b.module.id != fn.ID {
+			return errors.Wrapf(xerrors.ErrFunctionInvalid, "function %s registered by module %s", spec.Name, b.module.id)
+		}
+	}
+	return nil
+}
+
+// Unload unregister functions of the module.
+func (r *Registry) Unload(ctx context.Context, id string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.unload(ctx, id)
+}
+
+func (r *Registry) unload(ctx context.Context, id string) {
+	mod, has := r.modules[id]
+	if !has {
+		return
+	}
+
+	delete(r.modules, id)
+	for name, b := range r.bindings {
+		if b.module == mod {
+			delete(r.bindings, name)
+		}
+	}
+
+	// calls in flight finished before the module closed.
+	mod.lock.Lock()
+	defer mod.lock.Unlock()
+	if nil != mod.instance {
+		mod.instance.Close(ctx) //nolint
+		mod.instance = nil
+	}
+	mod.compiled.Close(ctx) //nolint
+	log.L().Info("unload function module", logf.ID(id))
+}
+
+// Start load modules in repository and watch changes of modules.
+func (r *Registry) Start(ctx context.Context, repo repository.IRepository) {
+	revision := repo.GetLastRevision(ctx)
+	repo.RangeFunction(ctx, revision, func(fns []*repository.Function) {
+		for _, fn := range fns {
+			r.OnChanged(dao.PUT, fn)
+		}
+	})
+
+	go repo.WatchFunction(ctx, revision, r.OnChanged)
+}
+
+// OnChanged load or unload module changed in repository.
+func (r *Registry) OnChanged(et dao.EnventType, fn *repository.Function) {
+	switch et {
+	case dao.PUT:
+		if err := r.Load(context.Background(), fn); nil != err {
+			log.L().Error("load function module", logf.ID(fn.ID), logf.Error(err))
+		}
+	case dao.DELETE:
+		r.Unload(context.Background(), fn.ID)
+	}
+}
+
+// Funcs returns functions registered, for expressions and mappers. functions are resolved
+// when called, so modules updated take effect, functions registered later are not included.
+func (r *Registry) Funcs() map[string]tdtl.ContextFunc {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	funcs := make(map[string]tdtl.ContextFunc, len(r.bindings))
+	for name := range r.bindings {
+		funcs[name] = r.contextFunc(name)
+	}
+	return funcs
+}
+
+func (r *Registry) contextFunc(name string) tdtl.ContextFunc {
+	return func(args ...tdtl.Node) tdtl.Node {
+		ret, err := r.Call(context.Background(), name, args...)
+		if nil != err {
+			log.L().Warn("call function", logf.Name(name), logf.Reason(err.Error()))
+			return tdtl.UNDEFINED_RESULT
+		}
+		return ret
+	}
+}
+
+// Call function registered with args, bounded by timeout of config.
+func (r *Registry) Call(ctx context.Context, name string, args ...tdtl.Node) (tdtl.Node, error) {
+	r.lock.RLock()
+	b, has := r.bindings[name]
+	r.lock.RUnlock()
+	if !has {
+		return nil, errors.Wrapf(xerrors.ErrResourceNotFound, "function %s", name)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, r.cfg.Timeout)
+	defer cancel()
+
+	mod := b.module
+	mod.lock.Lock()
+	defer mod.lock.Unlock()
+	if nil == mod.instance {
+		instance, err := r.runtime.InstantiateModule(ctx, mod.compiled,
+			wazero.NewModuleConfig().WithName("").WithStartFunctions(exportInitialize))
+		if nil != err {
+			return nil, errors.Wrapf(err, "instantiate module %s", mod.id)
+		}
+		mod.instance = instance
+	}
+
+	ret, err := call(ctx, mod.instance, &b.spec, args)
+	if nil != err {
+		// instance may be closed by timeout or left in unknown state by traps.
+		mod.instance.Close(ctx) //nolint
+		mod.instance = nil
+		return nil, errors.Wrapf(err, "call function %s", name)
+	}
+	return ret, nil
+}
+
+// Close release modules and the runtime.
+func (r *Registry) Close(ctx context.Context) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.modules = make(map[string]*module)
+	r.bindings = make(map[string]*binding)
+	return errors.Wrap(r.runtime.Close(ctx), "close function runtime")
+}
+
+var (
+	globalLock sync.RWMutex
+	global     = New(context.Background(), defaultConfig)
+)
+
+// Init replace the global registry with cfg.
+func Init(ctx context.Context, cfg Config) *Registry {
+	registry := New(ctx, cfg)
+	globalLock.Lock()
+	previous := global
+	global = registry
+	globalLock.Unlock()
+	previous.Close(ctx) //nolint
+	return registry
+}
+
+func Global() *Registry {
+	globalLock.RLock()
+	defer globalLock.RUnlock()
+	return global
+}
+
+// Funcs returns functions registered in the global registry.
+func Funcs() map[string]tdtl.ContextFunc {
+	return Global().Funcs()
+}
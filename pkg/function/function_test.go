@@ -0,0 +1,109 @@
+package function
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"github.com/tkeel-io/core/pkg/mapper/expression"
+	"github.com/tkeel-io/core/pkg/repository"
+	"github.com/tkeel-io/core/pkg/repository/dao"
+	"github.com/tkeel-io/tdtl"
+)
+
+func newTestFunction(t *testing.T, specs ...*repository.FunctionSpec) *repository.Function {
+	bytes, err := os.ReadFile("testdata/udf.wasm")
+	assert.Nil(t, err)
+	return &repository.Function{ID: "udf", Module: bytes, Functions: specs}
+}
+
+func TestRegistry_Call(t *testing.T) {
+	ctx := context.Background()
+	registry := New(ctx, Config{MaxMemoryPages: 4, Timeout: 50 * time.Millisecond})
+	defer registry.Close(ctx)
+
+	assert.Nil(t, registry.Load(ctx, newTestFunction(t,
+		&repository.FunctionSpec{Name: "c2f", Params: []string{"float"}, Result: "float"},
+		&repository.FunctionSpec{Name: "add", Params: []string{"int", "int"}, Result: "int"},
+		&repository.FunctionSpec{Name: "crc", Export: "checksum", Params: []string{"string"}, Result: "int"},
+		&repository.FunctionSpec{Name: "echo", Params: []string{"string"}, Result: "string"},
+		&repository.FunctionSpec{Name: "spin", Result: "bool"},
+		&repository.FunctionSpec{Name: "grow", Params: []string{"int"}, Result: "int"},
+	)))
+
+	ret, err := registry.Call(ctx, "c2f", tdtl.New(`100`))
+	assert.Nil(t, err)
+	assert.Equal(t, tdtl.FloatNode(212), ret)
+
+	ret, err = registry.Call(ctx, "add", tdtl.IntNode(1), tdtl.New(`2`))
+	assert.Nil(t, err)
+	assert.Equal(t, tdtl.IntNode(3), ret)
+
+	ret, err = registry.Call(ctx, "crc", tdtl.New(`"abc"`))
+	assert.Nil(t, err)
+	assert.Equal(t, tdtl.IntNode('a'+'b'+'c'), ret)
+
+	ret, err = registry.Call(ctx, "echo", tdtl.StringNode("hello"))
+	assert.Nil(t, err)
+	assert.Equal(t, tdtl.StringNode("hello"), ret)
+
+	_, err = registry.Call(ctx, "add", tdtl.IntNode(1))
+	assert.NotNil(t, err)
+	_, err = registry.Call(ctx, "unknown")
+	assert.ErrorIs(t, err, xerrors.ErrResourceNotFound)
+
+	// cpu and memory bounded, module instantiated again after failures.
+	_, err = registry.Call(ctx, "spin")
+	assert.NotNil(t, err)
+	ret, err = registry.Call(ctx, "grow", tdtl.IntNode(8))
+	assert.Nil(t, err)
+	assert.Equal(t, tdtl.IntNode(-1), ret)
+	ret, err = registry.Call(ctx, "grow", tdtl.IntNode(2))
+	assert.Nil(t, err)
+	assert.Equal(t, tdtl.IntNode(1), ret)
+
+	// functions used in expressions.
+	expr, err := expression.NewExpr(`c2f(device1.temp) + add(1, 2)`, registry.Funcs())
+	assert.Nil(t, err)
+	ret, err = expr.Eval(ctx, map[string]tdtl.Node{"device1.temp": tdtl.New(`20`)})
+	assert.Nil(t, err)
+	assert.Equal(t, "71.000000", ret.String())
+
+	registry.OnChanged(dao.DELETE, &repository.Function{ID: "udf"})
+	_, err = registry.Call(ctx, "c2f", tdtl.New(`100`))
+	assert.ErrorIs(t, err, xerrors.ErrResourceNotFound)
+}
+
+func TestRegistry_Validate(t *testing.T) {
+	ctx := context.Background()
+	registry := New(ctx, Config{})
+	defer registry.Close(ctx)
+
+	assert.Nil(t, registry.Validate(ctx, newTestFunction(t,
+		&repository.FunctionSpec{Name: "c2f", Params: []string{"float"}, Result: "float"})))
+
+	invalids := [][]*repository.FunctionSpec{
+		nil,
+		{{Name: "missing", Params: []string{"int"}, Result: "int"}},
+		{{Name: "c2f", Params: []string{"string"}, Result: "float"}},
+		{{Name: "c2f", Params: []string{"float", "float"}, Result: "float"}},
+		{{Name: "c2f", Params: []string{"float"}, Result: "string"}},
+		{{Name: "add", Params: []string{"int", "int"}, Result: "int"}, {Name: "add", Params: []string{"int", "int"}, Result: "int"}},
+		{{Name: "abs", Export: "c2f", Params: []string{"float"}, Result: "float"}},
+	}
+	for _, specs := range invalids {
+		assert.ErrorIs(t, registry.Validate(ctx, newTestFunction(t, specs...)), xerrors.ErrFunctionInvalid)
+	}
+
+	assert.ErrorIs(t, registry.Validate(ctx, &repository.Function{ID: "udf", Module: []byte("wasm"),
+		Functions: []*repository.FunctionSpec{{Name: "c2f"}}}), xerrors.ErrFunctionInvalid)
+
+	// functions registered by other modules.
+	assert.Nil(t, registry.Load(ctx, newTestFunction(t, &repository.FunctionSpec{Name: "c2f", Params: []string{"float"}, Result: "float"})))
+	other := newTestFunction(t, &repository.FunctionSpec{Name: "c2f", Params: []string{"float"}, Result: "float"})
+	other.ID = "other"
+	assert.ErrorIs(t, registry.Load(ctx, other), xerrors.ErrFunctionInvalid)
+}
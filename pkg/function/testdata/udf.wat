@@ -0,0 +1,73 @@
+;; source of udf.wasm, functions used by tests.
+(module
+  (memory (export "memory") 1)
+  (global $heap (mut i32) (i32.const 1024))
+
+  ;; bump allocator, memory never released.
+  (func (export "alloc") (param $size i32) (result i32)
+    (local $ptr i32)
+    global.get $heap
+    local.set $ptr
+    global.get $heap
+    local.get $size
+    i32.add
+    global.set $heap
+    local.get $ptr)
+
+  (func (export "c2f") (param f64) (result f64)
+    local.get 0
+    f64.const 1.8
+    f64.mul
+    f64.const 32
+    f64.add)
+
+  (func (export "add") (param i64 i64) (result i64)
+    local.get 0
+    local.get 1
+    i64.add)
+
+  ;; sum of bytes.
+  (func (export "checksum") (param $ptr i32) (param $len i32) (result i32)
+    (local $sum i32)
+    block
+      loop
+        local.get $len
+        i32.eqz
+        br_if 1
+        local.get $sum
+        local.get $ptr
+        i32.load8_u
+        i32.add
+        local.set $sum
+        local.get $ptr
+        i32.const 1
+        i32.add
+        local.set $ptr
+        local.get $len
+        i32.const 1
+        i32.sub
+        local.set $len
+        br 0
+      end
+    end
+    local.get $sum)
+
+  ;; returns the string passed, ptr<<32|len.
+  (func (export "echo") (param $ptr i32) (param $len i32) (result i64)
+    local.get $ptr
+    i64.extend_i32_u
+    i64.const 32
+    i64.shl
+    local.get $len
+    i64.extend_i32_u
+    i64.or)
+
+  (func (export "spin") (result i32)
+    loop
+      br 0
+    end
+    i32.const 0)
+
+  (func (export "grow") (param i32) (result i32)
+    local.get 0
+    memory.grow))
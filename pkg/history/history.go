@@ -0,0 +1,262 @@
+package history
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	logf "github.com/tkeel-io/core/pkg/logfield"
+	"github.com/tkeel-io/core/pkg/resource/store"
+	xjson "github.com/tkeel-io/core/pkg/util/json"
+	"github.com/tkeel-io/kit/log"
+	"github.com/tkeel-io/tdtl"
+)
+
+const (
+	HistoryStorePrefix = "CORE.HISTORY"
+
+	DefaultCheckpoint = 20
+	DefaultMaxRecords = 1000
+)
+
+// head track the range of records of entity.
+type head struct {
+	First int64 `json:"first"`
+	Last  int64 `json:"last"`
+}
+
+// history record changes of entities into state store, records of an entity are
+// numbered in sequence, and full state is recorded every checkpoint records,
+// so that states can be rebuilt by replaying changes from the nearest checkpoint.
+type history struct {
+	checkpoint int64
+	maxRecords int64
+	store      store.Store
+}
+
+func New(s store.Store, checkpoint, maxRecords int) History {
+	if checkpoint <= 0 {
+		checkpoint = DefaultCheckpoint
+	}
+	if maxRecords <= 0 {
+		maxRecords = DefaultMaxRecords
+	}
+
+	return &history{
+		store:      s,
+		checkpoint: int64(checkpoint),
+		maxRecords: int64(maxRecords),
+	}
+}
+
+func headKey(entityID string) string {
+	return fmt.Sprintf("%s.%s.HEAD", HistoryStorePrefix, entityID)
+}
+
+func recordKey(entityID string, seq int64) string {
+	return fmt.Sprintf("%s.%s.%d", HistoryStorePrefix, entityID, seq)
+}
+
+func (h *history) Append(ctx context.Context, record *Record, state []byte, checkpoint bool) error {
+	return h.AppendBatch(ctx, []*Entry{{Record: record, State: state, Checkpoint: checkpoint}})
+}
+
+// AppendBatch append records in order, head of each entity loaded and stored once.
+func (h *history) AppendBatch(ctx context.Context, entries []*Entry) error {
+	var entityIDs []string
+	batches := make(map[string][]*Entry)
+	for _, entry := range entries {
+		if entry.appended {
+			continue
+		}
+		entityID := entry.Record.EntityID
+		if _, has := batches[entityID]; !has {
+			entityIDs = append(entityIDs, entityID)
+		}
+		batches[entityID] = append(batches[entityID], entry)
+	}
+
+	for _, entityID := range entityIDs {
+		if err := h.appendEntity(ctx, entityID, batches[entityID]); nil != err {
+			return errors.Wrap(err, "append history")
+		}
+		for _, entry := range batches[entityID] {
+			entry.appended = true
+		}
+	}
+	return nil
+}
+
+func (h *history) appendEntity(ctx context.Context, entityID string, entries []*Entry) error {
+	hd, err := h.head(ctx, entityID)
+	if nil != err {
+		return errors.Wrap(err, "append history")
+	}
+
+	if hd.Last == 0 {
+		hd.First = 1
+	}
+
+	for _, entry := range entries {
+		record := entry.Record
+		hd.Last++
+		record.Seq = hd.Last
+		record.State = nil
+		if entry.Checkpoint || (hd.Last-1)%h.checkpoint == 0 {
+			record.State = entry.State
+		}
+
+		bytes, err := json.Marshal(record)
+		if nil != err {
+			return errors.Wrap(err, "encode history record")
+		} else if err = h.store.Set(ctx, recordKey(entityID, record.Seq), bytes); nil != err {
+			return errors.Wrap(err, "store history record")
+		}
+	}
+
+	// drop the oldest blocks of records if over limit, keep first record a checkpoint.
+	for hd.Last-hd.First+1 > h.maxRecords+h.checkpoint {
+		for seq := hd.First; seq < hd.First+h.checkpoint; seq++ {
+			if err = h.store.Del(ctx, recordKey(entityID, seq)); nil != err {
+				log.L().Warn("drop history record", logf.Eid(entityID),
+					logf.Int64("seq", seq), logf.Reason(err.Error()))
+			}
+		}
+		hd.First += h.checkpoint
+	}
+
+	return errors.Wrap(h.setHead(ctx, entityID, hd), "append history")
+}
+
+func (h *history) List(ctx context.Context, entityID string, offset, limit int) ([]*Record, int64, error) {
+	hd, err := h.head(ctx, entityID)
+	if nil != err {
+		return nil, 0, errors.Wrap(err, "list history")
+	} else if hd.Last == 0 {
+		return []*Record{}, 0, nil
+	}
+
+	records := make([]*Record, 0, limit)
+	for seq := hd.Last - int64(offset); seq >= hd.First && len(records) < limit; seq-- {
+		record, err := h.record(ctx, entityID, seq)
+		if nil != err {
+			return nil, 0, errors.Wrap(err, "list history")
+		}
+		record.State = nil
+		records = append(records, record)
+	}
+	return records, hd.Last - hd.First + 1, nil
+}
+
+func (h *history) Get(ctx context.Context, entityID string, asOf AsOf) ([]byte, *Record, error) {
+	hd, err := h.head(ctx, entityID)
+	if nil != err {
+		return nil, nil, errors.Wrap(err, "get history")
+	} else if hd.Last == 0 {
+		return nil, nil, xerrors.ErrHistoryNotFound
+	}
+
+	// search the last record at or before asOf, records are ordered by version and timestamp.
+	var target *Record
+	low, high := hd.First, hd.Last
+	for low <= high {
+		mid := (low + high) / 2
+		record, err := h.record(ctx, entityID, mid)
+		if nil != err {
+			return nil, nil, errors.Wrap(err, "get history")
+		}
+
+		if before(record, asOf) {
+			target, low = record, mid+1
+		} else {
+			high = mid - 1
+		}
+	}
+
+	if nil == target {
+		return nil, nil, xerrors.ErrHistoryNotFound
+	}
+
+	// replay records from the nearest checkpoint.
+	replays := []*Record{target}
+	for seq := target.Seq; replays[0].State == nil; {
+		if seq--; seq < hd.First {
+			return nil, nil, errors.Wrap(xerrors.ErrHistoryNotFound, "checkpoint not found")
+		}
+
+		record, err := h.record(ctx, entityID, seq)
+		if nil != err {
+			return nil, nil, errors.Wrap(err, "get history")
+		}
+		replays = append([]*Record{record}, replays...)
+	}
+
+	state := tdtl.New([]byte(replays[0].State))
+	for _, record := range replays[1:] {
+		apply(state, record.Patches)
+	}
+
+	target.State = nil
+	return state.Raw(), target, errors.Wrap(state.Error(), "replay history")
+}
+
+func before(record *Record, asOf AsOf) bool {
+	if asOf.Version > 0 {
+		return record.Version <= asOf.Version
+	}
+	return record.Timestamp <= asOf.Timestamp
+}
+
+// apply replay changes on state.
+func apply(state *tdtl.Collect, patches []Patch) {
+	for _, patch := range patches {
+		switch xjson.NewPatchOp(patch.Op) {
+		case xjson.OpAdd:
+			state.Append(patch.Path, tdtl.New([]byte(patch.Value)))
+		case xjson.OpRemove:
+			state.Del(patch.Path)
+		case xjson.OpReplace:
+			state.Set(patch.Path, tdtl.New([]byte(patch.Value)))
+		default:
+		}
+	}
+}
+
+func (h *history) head(ctx context.Context, entityID string) (*head, error) {
+	var hd head
+	item, err := h.store.Get(ctx, headKey(entityID))
+	if notFound(err) || (nil == err && len(item.Value) == 0) {
+		return &hd, nil
+	} else if nil != err {
+		return nil, errors.Wrap(err, "load history head")
+	}
+
+	err = json.Unmarshal(item.Value, &hd)
+	return &hd, errors.Wrap(err, "decode history head")
+}
+
+func (h *history) setHead(ctx context.Context, entityID string, hd *head) error {
+	bytes, err := json.Marshal(hd)
+	if nil != err {
+		return errors.Wrap(err, "encode history head")
+	}
+	return errors.Wrap(h.store.Set(ctx, headKey(entityID), bytes), "store history head")
+}
+
+func (h *history) record(ctx context.Context, entityID string, seq int64) (*Record, error) {
+	item, err := h.store.Get(ctx, recordKey(entityID, seq))
+	if notFound(err) || (nil == err && len(item.Value) == 0) {
+		return nil, xerrors.ErrHistoryNotFound
+	} else if nil != err {
+		return nil, errors.Wrap(err, "load history record")
+	}
+
+	var record Record
+	err = json.Unmarshal(item.Value, &record)
+	return &record, errors.Wrap(err, "decode history record")
+}
+
+func notFound(err error) bool {
+	return errors.Is(err, xerrors.ErrResourceNotFound) || errors.Is(err, xerrors.ErrEntityNotFound)
+}
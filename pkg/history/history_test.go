@@ -0,0 +1,171 @@
+package history
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"github.com/tkeel-io/core/pkg/resource"
+	"github.com/tkeel-io/core/pkg/resource/store"
+	_ "github.com/tkeel-io/core/pkg/resource/store/memory"
+	"github.com/tkeel-io/tdtl"
+)
+
+func newHistory(checkpoint, maxRecords int) History {
+	return New(store.NewStore(resource.Metadata{Name: "memory"}), checkpoint, maxRecords)
+}
+
+// appendN append n records, record i sets properties.temp to i at version i and timestamp i*1000.
+func appendN(t *testing.T, h History, from, n int) {
+	state := tdtl.New(`{"id":"device123","properties":{"temp":0}}`)
+	for i := from; i < from+n; i++ {
+		value := []byte(fmt.Sprintf("%d", i))
+		state.Set("properties.temp", tdtl.New(value))
+		state.Set("version", tdtl.New(value))
+		err := h.Append(context.Background(), &Record{
+			EntityID:  "device123",
+			Version:   int64(i),
+			Timestamp: int64(i * 1000),
+			EventID:   fmt.Sprintf("ev-%d", i),
+			Patches:   []Patch{{Op: "replace", Path: "properties.temp", Value: value}},
+		}, state.Copy().Raw(), false)
+		assert.Nil(t, err)
+	}
+}
+
+func TestHistory_List(t *testing.T) {
+	h := newHistory(3, 10)
+	records, total, err := h.List(context.Background(), "device123", 0, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), total)
+	assert.Len(t, records, 0)
+
+	appendN(t, h, 1, 5)
+	records, total, err = h.List(context.Background(), "device123", 1, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(5), total)
+	assert.Len(t, records, 3)
+	assert.Equal(t, int64(4), records[0].Version)
+	assert.Equal(t, int64(2), records[2].Version)
+	assert.Equal(t, "ev-4", records[0].EventID)
+	assert.Nil(t, records[0].State)
+}
+
+func TestHistory_Get(t *testing.T) {
+	h := newHistory(3, 10)
+	appendN(t, h, 1, 8)
+
+	tests := []struct {
+		name    string
+		asOf    AsOf
+		version int64
+		temp    string
+		err     error
+	}{
+		{"checkpoint", AsOf{Version: 4}, 4, "4", nil},
+		{"replay", AsOf{Version: 6}, 6, "6", nil},
+		{"latest", AsOf{Version: 100}, 8, "8", nil},
+		{"timestamp", AsOf{Timestamp: 5500}, 5, "5", nil},
+		{"before first", AsOf{Timestamp: 10}, 0, "", xerrors.ErrHistoryNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state, record, err := h.Get(context.Background(), "device123", tt.asOf)
+			if nil != tt.err {
+				assert.True(t, errors.Is(err, tt.err))
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tt.version, record.Version)
+			assert.Equal(t, tt.temp, tdtl.New(state).Get("properties.temp").String())
+		})
+	}
+}
+
+func TestHistory_prune(t *testing.T) {
+	h := newHistory(3, 4)
+	appendN(t, h, 1, 8)
+
+	records, total, err := h.List(context.Background(), "device123", 0, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(5), total)
+	assert.Equal(t, int64(4), records[len(records)-1].Version)
+
+	_, _, err = h.Get(context.Background(), "device123", AsOf{Version: 2})
+	assert.True(t, errors.Is(err, xerrors.ErrHistoryNotFound))
+
+	state, _, err := h.Get(context.Background(), "device123", AsOf{Version: 5})
+	assert.Nil(t, err)
+	assert.Equal(t, "5", tdtl.New(state).Get("properties.temp").String())
+}
+
+func TestHistory_AppendBatch(t *testing.T) {
+	h := newHistory(3, 4)
+	var entries []*Entry
+	for i := 1; i <= 8; i++ {
+		for _, entityID := range []string{"device123", "device234"} {
+			value := []byte(fmt.Sprintf("%d", i))
+			entries = append(entries, &Entry{
+				Record: &Record{
+					EntityID:  entityID,
+					Version:   int64(i),
+					Timestamp: int64(i * 1000),
+					Patches:   []Patch{{Op: "replace", Path: "properties.temp", Value: value}},
+				},
+				State: []byte(fmt.Sprintf(`{"id":%q,"version":%d,"properties":{"temp":%d}}`, entityID, i, i)),
+			})
+		}
+	}
+	assert.Nil(t, h.AppendBatch(context.Background(), entries))
+
+	// records of each entity numbered in order, pruned as if appended one by one.
+	for _, entityID := range []string{"device123", "device234"} {
+		records, total, err := h.List(context.Background(), entityID, 0, 10)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(5), total)
+		assert.Equal(t, int64(8), records[0].Seq)
+
+		state, _, err := h.Get(context.Background(), entityID, AsOf{Version: 6})
+		assert.Nil(t, err)
+		assert.Equal(t, "6", tdtl.New(state).Get("properties.temp").String())
+	}
+}
+
+// flakyStore fails writes of records of the entity once.
+type flakyStore struct {
+	store.Store
+	entityID string
+	failed   bool
+}
+
+func (s *flakyStore) Set(ctx context.Context, key string, data []byte) error {
+	if !s.failed && key == recordKey(s.entityID, 1) {
+		s.failed = true
+		return errors.New("store unavailable")
+	}
+	return s.Store.Set(ctx, key, data)
+}
+
+func TestHistory_AppendBatchRetry(t *testing.T) {
+	h := New(&flakyStore{Store: store.NewStore(resource.Metadata{Name: "memory"}), entityID: "device234"}, 3, 4)
+	var entries []*Entry
+	for _, entityID := range []string{"device123", "device234"} {
+		entries = append(entries, &Entry{
+			Record: &Record{EntityID: entityID, Version: 1, Patches: []Patch{{Op: "replace", Path: "properties.temp", Value: []byte("1")}}},
+			State:  []byte(fmt.Sprintf(`{"id":%q,"version":1,"properties":{"temp":1}}`, entityID)),
+		})
+	}
+	assert.NotNil(t, h.AppendBatch(context.Background(), entries))
+	assert.Nil(t, h.AppendBatch(context.Background(), entries))
+
+	// records appended before failure not appended again.
+	for _, entityID := range []string{"device123", "device234"} {
+		_, total, err := h.List(context.Background(), entityID, 0, 10)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(1), total)
+	}
+}
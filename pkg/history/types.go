@@ -0,0 +1,57 @@
+package history
+
+import (
+	"context"
+
+	jsoniter "github.com/json-iterator/go"
+)
+
+// Patch is a change applied on entity.
+type Patch struct {
+	Op    string              `json:"op"`
+	Path  string              `json:"path"`
+	Value jsoniter.RawMessage `json:"value,omitempty"`
+}
+
+// Record is the changes of entity made by an event.
+type Record struct {
+	Seq       int64   `json:"seq"`
+	EntityID  string  `json:"entity_id"`
+	Version   int64   `json:"version"`
+	Timestamp int64   `json:"timestamp"`
+	EventID   string  `json:"event_id"`
+	Born      string  `json:"born,omitempty"`
+	Owner     string  `json:"owner,omitempty"`
+	Source    string  `json:"source,omitempty"`
+	Patches   []Patch `json:"patches"`
+	// State is the full state of entity after changed, only recorded for checkpoints.
+	State jsoniter.RawMessage `json:"state,omitempty"`
+}
+
+// AsOf locate the state of entity, by version if Version set, otherwise by Timestamp.
+type AsOf struct {
+	Version   int64
+	Timestamp int64
+}
+
+// Entry is a record appended in batch, with the state of entity after changed.
+type Entry struct {
+	Record     *Record
+	State      []byte
+	Checkpoint bool
+
+	// appended marks entries stored, skipped when the batch retried.
+	appended bool
+}
+
+type History interface {
+	// Append append record of entity, full state is recorded if checkpoint.
+	Append(ctx context.Context, record *Record, state []byte, checkpoint bool) error
+	// AppendBatch append records of entities in order, entries appended by
+	// a failed call are skipped when the same batch retried.
+	AppendBatch(ctx context.Context, entries []*Entry) error
+	// List returns records of entity, newest first.
+	List(ctx context.Context, entityID string, offset, limit int) ([]*Record, int64, error)
+	// Get returns the state of entity as of version or timestamp, and the record reflects.
+	Get(ctx context.Context, entityID string, asOf AsOf) ([]byte, *Record, error)
+}
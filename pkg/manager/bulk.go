@@ -0,0 +1,104 @@
+package manager
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	v1 "github.com/tkeel-io/core/api/core/v1"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	logf "github.com/tkeel-io/core/pkg/logfield"
+	"github.com/tkeel-io/core/pkg/manager/holder"
+	"github.com/tkeel-io/core/pkg/types"
+	"github.com/tkeel-io/core/pkg/util"
+	"github.com/tkeel-io/kit/log"
+)
+
+// bulkCreateBatch bounds requests held by a bulk create at a time.
+const bulkCreateBatch = 500
+
+// CreateResult is the result of an entity in bulk create.
+type CreateResult struct {
+	ID   string
+	Err  error
+	Base *BaseRet
+}
+
+// CreateEntities create entities in batches, create events of a batch dispatched before
+// waiting responses, entities failed do not affect others.
+func (m *apiManager) CreateEntities(ctx context.Context, ens []*Base) ([]*CreateResult, error) {
+	elapsedTime := util.NewElapsed()
+	log.L().Info("entity.CreateEntities", logf.Int("entities", len(ens)))
+
+	entityIDs := make(map[string]struct{}, len(ens))
+	for _, en := range ens {
+		m.checkParams(en)
+		if _, has := entityIDs[en.ID]; has {
+			log.L().Error("create entities, duplicate entity id", logf.Eid(en.ID))
+			return nil, errors.Wrapf(xerrors.ErrInvalidRequest, "create entities, duplicate entity id %s", en.ID)
+		}
+		entityIDs[en.ID] = struct{}{}
+	}
+
+	results := make([]*CreateResult, 0, len(ens))
+	for offset := 0; offset < len(ens); offset += bulkCreateBatch {
+		end := offset + bulkCreateBatch
+		if end > len(ens) {
+			end = len(ens)
+		}
+
+		batch := ens[offset:end]
+		for index, resp := range m.dispatchCreate(ctx, batch) {
+			en := batch[index]
+			result := &CreateResult{ID: en.ID}
+			if resp.Status != types.StatusOK {
+				result.Err = respError(resp.ErrCode)
+				log.L().Warn("create entities", logf.Eid(en.ID), logf.Reason(resp.ErrCode))
+			} else {
+				var baseRet BaseRet
+				if err := json.Unmarshal(resp.Data, &baseRet); nil != err {
+					result.Err = errors.Wrap(err, "create entities, decode response")
+				} else {
+					result.Base = &baseRet
+				}
+			}
+			results = append(results, result)
+		}
+	}
+
+	log.L().Info("processing completed", logf.Int("entities", len(ens)),
+		logf.Elapsed(elapsedTime.Elapsed()))
+	return results, nil
+}
+
+// dispatchCreate dispatch create events of entities, then wait responses of the entities.
+func (m *apiManager) dispatchCreate(ctx context.Context, ens []*Base) []holder.Response {
+	return m.dispatchAndWait(ctx, len(ens), func(index int, reqID string) (v1.Event, error) {
+		en := ens[index]
+		bytes, err := en.EncodeJSON()
+		if nil != err {
+			log.L().Error("create entities", logf.Eid(en.ID), logf.ReqID(reqID), logf.Error(err))
+			return nil, errors.Wrap(err, "create entities")
+		}
+
+		return &v1.ProtoEvent{
+			Id:        util.IG().EvID(),
+			Timestamp: time.Now().UnixNano(),
+			Callback:  m.callbackAddr(),
+			Metadata: map[string]string{
+				v1.MetaBorn:      bornCreate,
+				v1.MetaType:      sysET,
+				v1.MetaRequestID: reqID,
+				v1.MetaEntityID:  en.ID,
+				v1.MetaOwner:     en.Owner,
+				v1.MetaSource:    en.Source,
+			},
+			Data: &v1.ProtoEvent_SystemData{
+				SystemData: &v1.SystemData{
+					Operator: string(v1.OpCreate),
+					Data:     bytes,
+				},
+			},
+		}, nil
+	})
+}
@@ -0,0 +1,97 @@
+package manager
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "github.com/tkeel-io/core/api/core/v1"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"github.com/tkeel-io/core/pkg/manager/holder"
+	"github.com/tkeel-io/core/pkg/types"
+)
+
+// createDispatcher respond create events, entities in existed already exist,
+// events of entities in unreachable failed to dispatch.
+type createDispatcher struct {
+	lock        sync.Mutex
+	manager     *apiManager
+	existed     map[string]bool
+	unreachable map[string]bool
+	entities    []string
+}
+
+func (d *createDispatcher) DispatchToLog(ctx context.Context, bytes []byte) error {
+	return nil
+}
+
+func (d *createDispatcher) Dispatch(ctx context.Context, ev v1.Event) error {
+	if d.unreachable[ev.Entity()] {
+		return errors.New("dispatcher unreachable")
+	}
+
+	d.lock.Lock()
+	d.entities = append(d.entities, ev.Entity())
+	d.lock.Unlock()
+
+	resp := &holder.Response{
+		ID:     ev.Attr(v1.MetaRequestID),
+		Status: types.StatusOK,
+		Data:   []byte(`{"id":"` + ev.Entity() + `","version":1}`),
+	}
+	if d.existed[ev.Entity()] {
+		resp.Status = types.StatusError
+		resp.ErrCode = xerrors.ErrEntityAleadyExists.Error()
+	}
+
+	go d.manager.OnRespond(ctx, resp)
+	return nil
+}
+
+func TestAPIManager_CreateEntities(t *testing.T) {
+	dispatcher := &createDispatcher{existed: map[string]bool{"meter-1": true}}
+	m, _ := New(context.Background(), nil, dispatcher)
+	dispatcher.manager = m.(*apiManager)
+
+	var ens []*Base
+	for _, id := range []string{"meter-0", "meter-1", "meter-2"} {
+		ens = append(ens, &Base{ID: id, TemplateID: "meter", Properties: []byte(`{"tariff":1}`)})
+	}
+
+	results, err := m.CreateEntities(context.Background(), ens)
+	assert.Nil(t, err)
+	assert.Len(t, results, 3)
+	assert.Equal(t, []string{"meter-0", "meter-1", "meter-2"}, dispatcher.entities)
+	assert.Nil(t, results[0].Err)
+	assert.Equal(t, int64(1), results[0].Base.Version)
+	assert.Equal(t, xerrors.ErrEntityAleadyExists.Error(), results[1].Err.Error())
+	assert.Nil(t, results[2].Err)
+
+	_, err = m.CreateEntities(context.Background(), []*Base{{ID: "meter-0"}, {ID: "meter-0"}})
+	assert.ErrorIs(t, err, xerrors.ErrInvalidRequest)
+}
+
+func TestAPIManager_dispatchAndWait(t *testing.T) {
+	dispatcher := &createDispatcher{unreachable: map[string]bool{"meter-2": true}}
+	m, _ := New(context.Background(), nil, dispatcher)
+	dispatcher.manager = m.(*apiManager)
+
+	ids := []string{"meter-0", "meter-1", "meter-2"}
+	responses := dispatcher.manager.dispatchAndWait(context.Background(), len(ids),
+		func(index int, reqID string) (v1.Event, error) {
+			if index == 1 {
+				return nil, errors.New("invalid entity")
+			}
+			return &v1.ProtoEvent{Metadata: map[string]string{
+				v1.MetaEntityID: ids[index], v1.MetaRequestID: reqID}}, nil
+		})
+
+	// responses ordered as requests.
+	assert.Len(t, responses, 3)
+	assert.Equal(t, types.StatusOK, responses[0].Status)
+	assert.Equal(t, "invalid entity", responses[1].ErrCode)
+	assert.Equal(t, "dispatcher unreachable", responses[2].ErrCode)
+	assert.Equal(t, []string{"meter-0"}, dispatcher.entities)
+}
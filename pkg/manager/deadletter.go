@@ -0,0 +1,71 @@
+package manager
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	v1 "github.com/tkeel-io/core/api/core/v1"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	logf "github.com/tkeel-io/core/pkg/logfield"
+	"github.com/tkeel-io/core/pkg/repository"
+	"github.com/tkeel-io/kit/log"
+)
+
+func (m *apiManager) ListDeadLetter(ctx context.Context, entityID string) ([]*repository.DeadLetter, error) {
+	letters, err := m.entityRepo.ListDeadLetter(ctx, &repository.ListDeadLetterReq{EntityID: entityID})
+	return letters, errors.Wrap(err, "list dead letter")
+}
+
+func (m *apiManager) GetDeadLetter(ctx context.Context, id string) (*repository.DeadLetter, error) {
+	letter, err := m.entityRepo.GetDeadLetter(ctx, &repository.DeadLetter{ID: id})
+	return letter, errors.Wrap(err, "get dead letter")
+}
+
+// ReplayDeadLetter dispatch the original event of dead letter again, and remove the dead letter,
+// the event is recorded as a new dead letter if failed again.
+func (m *apiManager) ReplayDeadLetter(ctx context.Context, id string) error {
+	letter, err := m.entityRepo.GetDeadLetter(ctx, &repository.DeadLetter{ID: id})
+	if nil != err {
+		return errors.Wrap(err, "replay dead letter")
+	}
+
+	var ev v1.ProtoEvent
+	if len(letter.Event) == 0 {
+		return errors.Wrap(xerrors.ErrInvalidRequest, "replay dead letter, event not retained")
+	} else if err = v1.Unmarshal(letter.Event, &ev); nil != err {
+		log.L().Warn("replay dead letter, decode event", logf.ID(id), logf.Reason(err.Error()))
+		return errors.Wrap(xerrors.ErrInvalidRequest, "replay dead letter, event undecodable")
+	} else if ev.Entity() == "" {
+		return errors.Wrap(xerrors.ErrInvalidRequest, "replay dead letter, entity id empty")
+	}
+
+	log.L().Info("replay dead letter", logf.ID(id),
+		logf.Eid(letter.EntityID), logf.EvID(letter.EventID))
+	if err = m.dispatcher.Dispatch(ctx, &ev); nil != err {
+		log.L().Error("replay dead letter", logf.ID(id), logf.Error(err))
+		return errors.Wrap(err, "replay dead letter")
+	}
+
+	return errors.Wrap(m.entityRepo.DelDeadLetter(ctx, letter), "replay dead letter")
+}
+
+func (m *apiManager) RemoveDeadLetter(ctx context.Context, id string) error {
+	return errors.Wrap(m.entityRepo.DelDeadLetter(ctx,
+		&repository.DeadLetter{ID: id}), "remove dead letter")
+}
+
+// PurgeDeadLetter remove dead letters of entity, all dead letters if entityID empty.
+func (m *apiManager) PurgeDeadLetter(ctx context.Context, entityID string) (int, error) {
+	letters, err := m.ListDeadLetter(ctx, entityID)
+	if nil != err {
+		return 0, errors.Wrap(err, "purge dead letter")
+	}
+
+	for index, letter := range letters {
+		if err = m.entityRepo.DelDeadLetter(ctx, letter); nil != err {
+			log.L().Error("purge dead letter", logf.ID(letter.ID), logf.Error(err))
+			return index, errors.Wrap(err, "purge dead letter")
+		}
+	}
+	return len(letters), nil
+}
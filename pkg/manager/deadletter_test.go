@@ -0,0 +1,95 @@
+package manager
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "github.com/tkeel-io/core/api/core/v1"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"github.com/tkeel-io/core/pkg/repository"
+)
+
+type deadLetterRepo struct {
+	repository.IRepository
+	letters map[string]*repository.DeadLetter
+}
+
+func (r *deadLetterRepo) GetDeadLetter(ctx context.Context, letter *repository.DeadLetter) (*repository.DeadLetter, error) {
+	if ret, has := r.letters[letter.ID]; has {
+		return ret, nil
+	}
+	return letter, xerrors.ErrResourceNotFound
+}
+
+func (r *deadLetterRepo) DelDeadLetter(ctx context.Context, letter *repository.DeadLetter) error {
+	delete(r.letters, letter.ID)
+	return nil
+}
+
+func (r *deadLetterRepo) ListDeadLetter(ctx context.Context, req *repository.ListDeadLetterReq) ([]*repository.DeadLetter, error) {
+	var letters []*repository.DeadLetter
+	for _, letter := range r.letters {
+		if req.EntityID == "" || req.EntityID == letter.EntityID {
+			letters = append(letters, letter)
+		}
+	}
+	return letters, nil
+}
+
+type replayDispatcher struct {
+	events []v1.Event
+}
+
+func (d *replayDispatcher) DispatchToLog(ctx context.Context, bytes []byte) error {
+	return nil
+}
+
+func (d *replayDispatcher) Dispatch(ctx context.Context, ev v1.Event) error {
+	d.events = append(d.events, ev)
+	return nil
+}
+
+func newDeadLetterManager(t *testing.T) (*apiManager, *deadLetterRepo, *replayDispatcher) {
+	bytes, err := v1.Marshal(&v1.ProtoEvent{
+		Id:       "ev-1",
+		Metadata: map[string]string{v1.MetaType: string(v1.ETEntity), v1.MetaEntityID: "device123"},
+	})
+	assert.Nil(t, err)
+
+	repo := &deadLetterRepo{letters: map[string]*repository.DeadLetter{
+		"dl-1": {ID: "dl-1", EntityID: "device123", EventID: "ev-1", Event: bytes},
+		"dl-2": {ID: "dl-2", Handler: "decode", Event: []byte("invalid")},
+		"dl-3": {ID: "dl-3", EntityID: "device234", EventID: "ev-3", Event: bytes},
+	}}
+	dispatcher := &replayDispatcher{}
+	m, _ := New(context.Background(), repo, dispatcher)
+	return m.(*apiManager), repo, dispatcher
+}
+
+func TestAPIManager_ReplayDeadLetter(t *testing.T) {
+	m, repo, dispatcher := newDeadLetterManager(t)
+	assert.Nil(t, m.ReplayDeadLetter(context.Background(), "dl-1"))
+	assert.Len(t, dispatcher.events, 1)
+	assert.Equal(t, "device123", dispatcher.events[0].Entity())
+	assert.NotContains(t, repo.letters, "dl-1")
+
+	// undecodable event kept.
+	assert.ErrorIs(t, m.ReplayDeadLetter(context.Background(), "dl-2"), xerrors.ErrInvalidRequest)
+	assert.Contains(t, repo.letters, "dl-2")
+
+	assert.ErrorIs(t, m.ReplayDeadLetter(context.Background(), "dl-4"), xerrors.ErrResourceNotFound)
+}
+
+func TestAPIManager_PurgeDeadLetter(t *testing.T) {
+	m, repo, _ := newDeadLetterManager(t)
+	purged, err := m.PurgeDeadLetter(context.Background(), "device234")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, purged)
+	assert.Len(t, repo.letters, 2)
+
+	purged, err = m.PurgeDeadLetter(context.Background(), "")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, purged)
+	assert.Len(t, repo.letters, 0)
+}
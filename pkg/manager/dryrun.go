@@ -0,0 +1,167 @@
+package manager
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"github.com/tkeel-io/core/pkg/function"
+	logf "github.com/tkeel-io/core/pkg/logfield"
+	"github.com/tkeel-io/core/pkg/mapper"
+	"github.com/tkeel-io/core/pkg/mapper/expression"
+	"github.com/tkeel-io/core/pkg/repository"
+	"github.com/tkeel-io/kit/log"
+	"github.com/tkeel-io/tdtl"
+)
+
+// DryRunReq evaluates expressions, or expressions converted from mapper TQL, of the entity.
+type DryRunReq struct {
+	Owner       string
+	EntityID    string
+	TQL         string
+	Expressions []repository.Expression
+	// States properties of entities in JSON, used instead of current states.
+	States map[string][]byte
+}
+
+// DryRunResult is the evaluation trace of an expression.
+type DryRunResult struct {
+	// Expression with paths rewritten as persisted.
+	Expression repository.Expression
+	Sources    map[string][]string
+	// Inputs values read from entity states, keyed by source path.
+	Inputs map[string]tdtl.Node
+	// Missing source paths undefined in entity states, or of entities not found.
+	Missing []string
+	Result  tdtl.Node
+	// Written reports whether the result would be written into the entity, otherwise Reason explains.
+	Written bool
+	Reason  string
+}
+
+const (
+	reasonNoInput   = "no input, source entities not found"
+	reasonUndefined = "result undefined or null"
+)
+
+// DryRunExpression evaluates expressions against current or supplied entity states without persisting,
+// results are computed as runtime does, expressions invalid are rejected as AppendExpression does.
+func (m *apiManager) DryRunExpression(ctx context.Context, req *DryRunReq) ([]*DryRunResult, error) {
+	if len(req.Expressions) == 0 && req.TQL == "" {
+		return nil, errors.Wrap(xerrors.ErrInvalidRequest, "dry run, expressions or TQL required")
+	}
+
+	exprs := make([]repository.Expression, 0, len(req.Expressions))
+	for index := range req.Expressions {
+		expr := repository.NewExpression(req.Owner, req.EntityID, req.Expressions[index].Name,
+			req.Expressions[index].Path, req.Expressions[index].Expression, req.Expressions[index].Description)
+		if err := checkExpression(expr); nil != err {
+			return nil, errors.Wrap(xerrors.ErrExpressionInvalid, err.Error())
+		}
+		exprs = append(exprs, *expr)
+	}
+
+	// expressions converted from mapper appended as AppendMapper does.
+	if req.TQL != "" {
+		mp := &mapper.Mapper{Owner: req.Owner, EntityID: req.EntityID, TQL: req.TQL}
+		if err := checkMapper(mp); nil != err {
+			return nil, errors.Wrap(xerrors.ErrExpressionInvalid, err.Error())
+		}
+		exprs = append(exprs, convExprs(*mp)...)
+	}
+
+	states := make(map[string]*tdtl.Collect)
+	for id, raw := range req.States {
+		state := tdtl.New(`{}`)
+		state.Set("id", tdtl.StringNode(id))
+		state.Set("properties", tdtl.New(raw))
+		if nil != state.Error() {
+			return nil, errors.Wrapf(xerrors.ErrInvalidRequest, "dry run, state of entity %s", id)
+		}
+		states[id] = state
+	}
+
+	results := make([]*DryRunResult, 0, len(exprs))
+	for _, expr := range exprs {
+		result, err := m.dryRun(ctx, expr, states)
+		if nil != err {
+			return nil, errors.Wrap(err, "dry run")
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+func (m *apiManager) dryRun(ctx context.Context, expr repository.Expression, states map[string]*tdtl.Collect) (*DryRunResult, error) {
+	exprIns, err := expression.NewExpr(expr.Expression, function.Funcs())
+	if nil != err {
+		return nil, errors.Wrap(xerrors.ErrExpressionInvalid, err.Error())
+	}
+
+	result := &DryRunResult{
+		Expression: expr,
+		Sources:    exprIns.Sources(),
+		Inputs:     make(map[string]tdtl.Node),
+	}
+
+	for entityID, paths := range result.Sources {
+		state, err := m.dryRunState(ctx, expr.Owner, entityID, states)
+		if nil != err {
+			return nil, err
+		} else if nil == state {
+			result.Missing = append(result.Missing, paths...)
+			continue
+		}
+
+		for _, path := range paths {
+			val := state.Get(mapper.NewWatchKey(path).PropertyKey)
+			if val.Type() == tdtl.Undefined || val.Type() == tdtl.Null {
+				result.Missing = append(result.Missing, path)
+			}
+			result.Inputs[path] = val
+		}
+	}
+	sort.Strings(result.Missing)
+
+	// runtime ignores expressions without states of sources.
+	if len(result.Inputs) == 0 {
+		result.Reason = reasonNoInput
+		return result, nil
+	}
+
+	result.Result, err = exprIns.Eval(ctx, result.Inputs)
+	switch {
+	case nil != err:
+		result.Reason = err.Error()
+	case result.Result.Type() == tdtl.Undefined || result.Result.Type() == tdtl.Null:
+		result.Reason = reasonUndefined
+	default:
+		result.Written = true
+	}
+	return result, nil
+}
+
+// dryRunState returns state supplied or current state of entity, nil if entity not found.
+func (m *apiManager) dryRunState(ctx context.Context, owner, entityID string, states map[string]*tdtl.Collect) (*tdtl.Collect, error) {
+	if state, has := states[entityID]; has {
+		return state, nil
+	}
+
+	base, err := m.GetEntity(ctx, &Base{ID: entityID, Owner: owner})
+	if nil != err {
+		if errors.Is(err, xerrors.ErrEntityNotFound) {
+			states[entityID] = nil
+			return nil, nil
+		}
+		log.L().Error("dry run, load entity", logf.Eid(entityID), logf.Error(err))
+		return nil, errors.Wrap(err, "load entity")
+	}
+
+	bytes, err := json.Marshal(base)
+	if nil != err {
+		return nil, errors.Wrap(err, "encode entity")
+	}
+	states[entityID] = tdtl.New(bytes)
+	return states[entityID], nil
+}
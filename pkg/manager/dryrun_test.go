@@ -0,0 +1,59 @@
+package manager
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"github.com/tkeel-io/core/pkg/repository"
+)
+
+func TestAPIManager_DryRunExpression(t *testing.T) {
+	m, _ := New(context.Background(), &scheduleRepo{}, &replayDispatcher{})
+	states := map[string][]byte{
+		"device123": []byte(`{"temp": 20, "unit": "C"}`),
+		"device234": []byte(`{"temp": 30}`),
+	}
+
+	results, err := m.DryRunExpression(context.Background(), &DryRunReq{
+		Owner:    "admin",
+		EntityID: "device123",
+		States:   states,
+		Expressions: []repository.Expression{
+			{Path: "properties.temp_f", Expression: "device123.temp * 1.8 + 32"},
+			{Path: "properties.label", Expression: "concat(device123.unit, device234.unit)"},
+		},
+	})
+	assert.Nil(t, err)
+	assert.Len(t, results, 2)
+
+	assert.True(t, results[0].Written)
+	assert.Equal(t, "68.000000", results[0].Result.String())
+	assert.Equal(t, "device123.properties.temp * 1.8 + 32", results[0].Expression.Expression)
+	assert.Equal(t, map[string][]string{"device123": {"device123.properties.temp"}}, results[0].Sources)
+	assert.Equal(t, "20", results[0].Inputs["device123.properties.temp"].String())
+
+	// property undefined, nothing written.
+	assert.False(t, results[1].Written)
+	assert.Equal(t, reasonUndefined, results[1].Reason)
+	assert.Equal(t, []string{"device234.properties.unit"}, results[1].Missing)
+
+	// mapper TQL converted into expressions.
+	results, err = m.DryRunExpression(context.Background(), &DryRunReq{
+		Owner:    "admin",
+		EntityID: "device123",
+		States:   states,
+		TQL:      "insert into device123 select device234.temp as temp2",
+	})
+	assert.Nil(t, err)
+	assert.Len(t, results, 1)
+	assert.Equal(t, "properties.temp2", results[0].Expression.Path)
+	assert.Equal(t, "30", results[0].Result.String())
+
+	_, err = m.DryRunExpression(context.Background(), &DryRunReq{EntityID: "device123", States: states,
+		Expressions: []repository.Expression{{Path: "properties.x", Expression: "unknown(device123.temp)"}}})
+	assert.ErrorIs(t, err, xerrors.ErrExpressionInvalid)
+	_, err = m.DryRunExpression(context.Background(), &DryRunReq{EntityID: "device123"})
+	assert.ErrorIs(t, err, xerrors.ErrInvalidRequest)
+}
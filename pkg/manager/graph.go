@@ -0,0 +1,35 @@
+package manager
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/tkeel-io/core/pkg/repository"
+	"github.com/tkeel-io/core/pkg/runtime"
+)
+
+// expressionGraph build the dependency graph of all expressions appended.
+func (m *apiManager) expressionGraph(ctx context.Context) *runtime.Graph {
+	graph := runtime.NewGraph()
+	m.entityRepo.RangeExpression(ctx, m.entityRepo.GetLastRevision(ctx),
+		func(exprs []*repository.Expression) {
+			graph.Load(exprs)
+		})
+	return graph
+}
+
+// checkCycle rejects expressions creating cycles in the dependency graph.
+func (m *apiManager) checkCycle(ctx context.Context, exprs []repository.Expression) error {
+	graph := m.expressionGraph(ctx)
+	for index := range exprs {
+		if err := graph.Append(exprs[index]); nil != err {
+			return errors.Wrap(err, "append expression into graph")
+		}
+	}
+	return nil
+}
+
+// ExpressionGraph returns dependencies of all expressions, dependencies from or into the entity if entityID specified.
+func (m *apiManager) ExpressionGraph(ctx context.Context, entityID string) ([]runtime.Dependency, error) {
+	return m.expressionGraph(ctx).Dependencies(entityID), nil
+}
@@ -0,0 +1,70 @@
+package manager
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"github.com/tkeel-io/core/pkg/mapper"
+	"github.com/tkeel-io/core/pkg/placement"
+	"github.com/tkeel-io/core/pkg/repository"
+)
+
+type exprRepo struct {
+	repository.IRepository
+	exprs map[string]*repository.Expression
+}
+
+func (r *exprRepo) GetLastRevision(ctx context.Context) int64 {
+	return 0
+}
+
+func (r *exprRepo) PutExpression(ctx context.Context, expr repository.Expression) error {
+	r.exprs[expr.ID] = &expr
+	return nil
+}
+
+func (r *exprRepo) GetExpression(ctx context.Context, expr repository.Expression) (repository.Expression, error) {
+	expr.GenKey()
+	if ret, has := r.exprs[expr.ID]; has {
+		return *ret, nil
+	}
+	return expr, xerrors.ErrResourceNotFound
+}
+
+func (r *exprRepo) RangeExpression(ctx context.Context, rev int64, handler repository.RangeExpressionFunc) {
+	var exprs []*repository.Expression
+	for _, expr := range r.exprs {
+		exprs = append(exprs, expr)
+	}
+	handler(exprs)
+}
+
+func TestAPIManager_ExpressionGraph(t *testing.T) {
+	placement.Initialize()
+	placement.Global().Append(placement.Info{ID: "core/1234", Flag: true})
+	repo := &exprRepo{exprs: make(map[string]*repository.Expression)}
+	m, _ := New(context.Background(), repo, &replayDispatcher{})
+
+	err := m.AppendExpression(context.Background(), []repository.Expression{
+		*repository.NewExpression("admin", "device2", "", "properties.b", "device1.a", ""),
+	})
+	assert.Nil(t, err)
+	err = m.AppendMapper(context.Background(), &mapper.Mapper{Owner: "admin",
+		EntityID: "device3", TQL: "insert into device3 select device2.b as c"})
+	assert.Nil(t, err)
+
+	// device1.a -> device2.b -> device3.c -> device1.a.
+	err = m.AppendExpression(context.Background(), []repository.Expression{
+		*repository.NewExpression("admin", "device1", "", "properties.a", "device3.c", ""),
+	})
+	assert.ErrorIs(t, err, xerrors.ErrExpressionCycle)
+	assert.Len(t, repo.exprs, 2)
+
+	deps, err := m.ExpressionGraph(context.Background(), "device2")
+	assert.Nil(t, err)
+	assert.Len(t, deps, 2)
+	assert.Equal(t, "device1.properties.a", deps[0].Source)
+	assert.Equal(t, "device3.properties.c", deps[1].Target)
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/tkeel-io/core/pkg/config"
 	"github.com/tkeel-io/core/pkg/dispatch"
 	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"github.com/tkeel-io/core/pkg/function"
 	logf "github.com/tkeel-io/core/pkg/logfield"
 	"github.com/tkeel-io/core/pkg/manager/holder"
 	"github.com/tkeel-io/core/pkg/mapper"
@@ -50,6 +51,7 @@ const (
 	bornPatch  = "apis.PatchEntity"
 	bornGet    = "apis.GetEntity"
 	bornDelete = "apis.DeleteEntity"
+	bornTx     = "apis.PatchEntities"
 )
 
 type apiManager struct {
@@ -57,7 +59,9 @@ type apiManager struct {
 	dispatcher dispatch.Dispatcher
 	entityRepo repository.IRepository
 
-	lock   sync.RWMutex
+	lock sync.RWMutex
+	// glock serializes expressions appended, checked against the dependency graph.
+	glock  sync.Mutex
 	ctx    context.Context
 	cancel context.CancelFunc
 }
@@ -97,6 +101,54 @@ func (m *apiManager) callbackAddr() string {
 	return fmt.Sprintf(respondFmt, util.ResolveAddr(), config.Get().Proxy.HTTPPort)
 }
 
+// dispatchAndWait hold and dispatch events of requests, then wait responses of the requests,
+// responses ordered as requests. requests failed to make or dispatch event respond error.
+func (m *apiManager) dispatchAndWait(ctx context.Context, count int, makeEvent func(index int, reqID string) (v1.Event, error)) []holder.Response {
+	waiters := make([]*holder.Waiter, count)
+	responses := make([]holder.Response, count)
+	for index := 0; index < count; index++ {
+		reqID := util.IG().ReqID()
+		ev, err := makeEvent(index, reqID)
+		if nil != err {
+			responses[index] = holder.Response{ID: reqID, Status: types.StatusError, ErrCode: err.Error()}
+			continue
+		}
+
+		// hold request.
+		waiters[index] = m.holder.Wait(ctx, reqID)
+		if err = m.dispatcher.Dispatch(ctx, ev); nil != err {
+			waiters[index].Cancel()
+			waiters[index] = nil
+			log.L().Error("dispatch event", logf.Eid(ev.Entity()), logf.ReqID(reqID), logf.Error(err))
+			responses[index] = holder.Response{ID: reqID, Status: types.StatusError, ErrCode: err.Error()}
+		}
+	}
+
+	// wait responses.
+	for index, waiter := range waiters {
+		if nil != waiter {
+			responses[index] = waiter.Wait()
+		}
+	}
+	return responses
+}
+
+// respError convert response errcode into error.
+func respError(code string) error {
+	switch code {
+	case xerrors.ErrEntityVersionConflict.Error():
+		return xerrors.ErrEntityVersionConflict
+	case xerrors.ErrPatchTestFailed.Error():
+		return xerrors.ErrPatchTestFailed
+	case xerrors.ErrEntityLocked.Error():
+		return xerrors.ErrEntityLocked
+	case xerrors.ErrEntityNotFound.Error():
+		return xerrors.ErrEntityNotFound
+	default:
+		return xerrors.New(code)
+	}
+}
+
 // CreateEntity create a entity.
 func (m *apiManager) CreateEntity(ctx context.Context, en *Base) (*BaseRet, error) {
 	var (
@@ -129,6 +181,8 @@ func (m *apiManager) CreateEntity(ctx context.Context, en *Base) (*BaseRet, erro
 			v1.MetaType:      sysET,
 			v1.MetaRequestID: reqID,
 			v1.MetaEntityID:  en.ID,
+			v1.MetaOwner:     en.Owner,
+			v1.MetaSource:    en.Source,
 		},
 		Data: &v1.ProtoEvent_SystemData{
 			SystemData: &v1.SystemData{
@@ -181,6 +235,8 @@ func (m *apiManager) PatchEntity(ctx context.Context, en *Base, pds []*v1.PatchD
 		v1.MetaType:      enET,
 		v1.MetaEntityID:  en.ID,
 		v1.MetaRequestID: reqID,
+		v1.MetaOwner:     en.Owner,
+		v1.MetaSource:    en.Source,
 	}
 	// use patch options.
 	for _, option := range opts {
@@ -190,7 +246,7 @@ func (m *apiManager) PatchEntity(ctx context.Context, en *Base, pds []*v1.PatchD
 	// dispatch event.
 	if err = m.dispatcher.Dispatch(ctx,
 		&v1.ProtoEvent{
-			Id:        util.IG().EvID(),
+			Id:        eventID(metadata),
 			Metadata:  metadata,
 			Timestamp: time.Now().UnixNano(),
 			Callback:  m.callbackAddr(),
@@ -212,7 +268,7 @@ func (m *apiManager) PatchEntity(ctx context.Context, en *Base, pds []*v1.PatchD
 	if resp.Status != types.StatusOK {
 		log.L().Error("patch entity", logf.Eid(en.ID),
 			logf.Error(xerrors.New(resp.ErrCode)), logf.Base(en.JSON()))
-		return out, raw, xerrors.New(resp.ErrCode)
+		return out, raw, respError(resp.ErrCode)
 	}
 
 	var baseRet BaseRet
@@ -270,7 +326,7 @@ func (m *apiManager) GetEntity(ctx context.Context, en *Base) (*BaseRet, error)
 	if resp.Status != types.StatusOK {
 		log.L().Error("get entity", logf.Eid(en.ID),
 			logf.ReqID(reqID), logf.Error(xerrors.New(resp.ErrCode)))
-		return nil, xerrors.New(resp.ErrCode)
+		return nil, respError(resp.ErrCode)
 	}
 
 	log.L().Info("processing completed", logf.Eid(en.ID),
@@ -287,7 +343,7 @@ func (m *apiManager) GetEntity(ctx context.Context, en *Base) (*BaseRet, error)
 }
 
 // DeleteEntity delete an entity from manager.
-func (m *apiManager) DeleteEntity(ctx context.Context, en *Base) error {
+func (m *apiManager) DeleteEntity(ctx context.Context, en *Base, opts ...Option) error {
 	var err error
 	reqID := util.IG().ReqID()
 	elapsedTime := util.NewElapsed()
@@ -297,17 +353,23 @@ func (m *apiManager) DeleteEntity(ctx context.Context, en *Base) error {
 	// hold request.
 	respWaiter := m.holder.Wait(ctx, reqID)
 
+	// setup metadata.
+	metadata := Metadata{
+		v1.MetaBorn:      bornDelete,
+		v1.MetaType:      sysET,
+		v1.MetaRequestID: reqID,
+		v1.MetaEntityID:  en.ID,
+	}
+	for _, option := range opts {
+		option(metadata)
+	}
+
 	// dispatch event.
 	if err = m.dispatcher.Dispatch(ctx, &v1.ProtoEvent{
-		Id:        util.IG().EvID(),
+		Id:        eventID(metadata),
 		Timestamp: time.Now().UnixNano(),
 		Callback:  m.callbackAddr(),
-		Metadata: map[string]string{
-			v1.MetaBorn:      bornDelete,
-			v1.MetaType:      sysET,
-			v1.MetaRequestID: reqID,
-			v1.MetaEntityID:  en.ID,
-		},
+		Metadata:  metadata,
 		Data: &v1.ProtoEvent_SystemData{
 			SystemData: &v1.SystemData{
 				Operator: string(v1.OpDelete),
@@ -328,7 +390,7 @@ func (m *apiManager) DeleteEntity(ctx context.Context, en *Base) error {
 	if resp := respWaiter.Wait(); resp.Status != types.StatusOK {
 		log.L().Error("delete entity", logf.Eid(en.ID),
 			logf.ReqID(reqID), logf.Error(xerrors.New(resp.ErrCode)))
-		return xerrors.New(resp.ErrCode)
+		return respError(resp.ErrCode)
 	}
 
 	log.L().Info("processing completed", logf.Eid(en.ID),
@@ -354,6 +416,7 @@ func (m *apiManager) AppendMapper(ctx context.Context, mp *mapper.Mapper) error
 	if err := m.appendExpression(ctx, exprs); nil != err {
 		log.L().Error("append mapper", logf.Error(err),
 			logf.ID(mp.ID), logf.Eid(mp.EntityID))
+		return errors.Wrap(err, "append mapper")
 	}
 
 	return nil
@@ -444,6 +507,13 @@ func checkExpression(expr *repository.Expression) error {
 		return errors.Wrap(err, "invalid expression")
 	}
 
+	// check functions called.
+	if err = expression.CheckCalls(expr.Expression, function.Funcs()); nil != err {
+		log.L().Error("check expression", logf.Path(expr.Path), logf.Error(err),
+			logf.Eid(expr.EntityID), logf.Owner(expr.Owner), logf.Expr(expr.Expression))
+		return errors.Wrap(err, "invalid expression")
+	}
+
 	propKeys := make(map[string]string)
 	for _, keys := range exprIns.Sources() {
 		for _, key := range keys {
@@ -485,6 +555,14 @@ func (m *apiManager) AppendExpression(ctx context.Context, exprs []repository.Ex
 				logf.Eid(expr.EntityID), logf.Owner(expr.Owner), logf.Expr(expr.Expression))
 			return errors.Wrap(err, "invalid expression")
 		}
+
+		// policy kept if expression updated.
+		if nil == expr.Policy {
+			// key of resource matched by prefix.
+			if old, err := m.entityRepo.GetExpression(ctx, expr); nil == err && old.Path == expr.Path {
+				exprs[index].Policy = old.Policy
+			}
+		}
 	}
 
 	return errors.Wrap(m.appendExpression(ctx, exprs), "append expression")
@@ -501,6 +579,14 @@ func (m *apiManager) appendExpression(ctx context.Context, exprs []repository.Ex
 		}
 	}
 
+	// reject expressions depending on each other cyclically.
+	m.glock.Lock()
+	defer m.glock.Unlock()
+	if err := m.checkCycle(ctx, exprs); nil != err {
+		log.L().Error("append expression, dependency cycle", logf.Error(err))
+		return errors.Wrap(err, "check dependency cycle")
+	}
+
 	// update expressions.
 	for _, expr := range exprs {
 		log.L().Debug("append expression", logf.Path(expr.Path),
@@ -543,6 +629,39 @@ func (m *apiManager) GetExpression(ctx context.Context, expr repository.Expressi
 	return &expr, nil
 }
 
+// SetExpressionPolicy update policy of the expression, policy removed if empty.
+func (m *apiManager) SetExpressionPolicy(ctx context.Context, expr repository.Expression, policy *repository.ExpressionPolicy) (*repository.Expression, error) {
+	if !policy.Empty() {
+		if err := policy.Validate(); nil != err {
+			return nil, errors.Wrap(xerrors.ErrInvalidRequest, err.Error())
+		}
+	} else {
+		policy = nil
+	}
+
+	m.glock.Lock()
+	defer m.glock.Unlock()
+	path := expr.Path
+	expr, err := m.entityRepo.GetExpression(ctx, expr)
+	if nil == err && expr.Path != path {
+		// key of resource matched by prefix.
+		err = errors.Wrap(xerrors.ErrResourceNotFound, "expression not found")
+	}
+	if nil != err {
+		log.L().Error("set expression policy, get expression", logf.Error(err),
+			logf.Path(expr.Path), logf.Eid(expr.EntityID), logf.Owner(expr.Owner))
+		return nil, errors.Wrap(err, "get expression")
+	}
+
+	expr.Policy = policy
+	if err = m.entityRepo.PutExpression(ctx, expr); nil != err {
+		log.L().Error("set expression policy", logf.Error(err),
+			logf.Path(expr.Path), logf.Eid(expr.EntityID), logf.Owner(expr.Owner))
+		return nil, errors.Wrap(err, "set expression policy")
+	}
+	return &expr, nil
+}
+
 func (m *apiManager) ListExpression(ctx context.Context, en *Base) ([]*repository.Expression, error) {
 	// list expressions.
 	var err error
@@ -577,6 +696,14 @@ func (m *apiManager) GetSubscription(ctx context.Context, subscription *reposito
 
 //////////////
 
+// eventID returns idempotency key of request if specified, otherwise a new event id.
+func eventID(meta Metadata) string {
+	if key := meta[v1.MetaIdempotencyKey]; key != "" {
+		return key
+	}
+	return util.IG().EvID()
+}
+
 func convExprs(mp mapper.Mapper) []repository.Expression {
 	segs := strings.SplitN(mp.TQL, "select", 2)
 	arr := strings.Split(segs[1], ",")
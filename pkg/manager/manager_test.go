@@ -17,10 +17,15 @@ limitations under the License.
 package manager
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
 	"github.com/tkeel-io/core/pkg/mapper"
+	"github.com/tkeel-io/core/pkg/placement"
+	"github.com/tkeel-io/core/pkg/repository"
 )
 
 func TestEntity_GetEntity(t *testing.T) {
@@ -51,3 +56,41 @@ func Test_checkTQL(t *testing.T) {
 		})
 	}
 }
+
+func Test_eventID(t *testing.T) {
+	meta := Metadata{}
+	assert.NotEqual(t, "", eventID(meta))
+
+	NewIdempotencyKeyOption("order-1")(meta)
+	assert.Equal(t, "order-1", eventID(meta))
+}
+
+func TestAPIManager_SetExpressionPolicy(t *testing.T) {
+	placement.Initialize()
+	placement.Global().Append(placement.Info{ID: "core/1234", Flag: true})
+	repo := &exprRepo{exprs: make(map[string]*repository.Expression)}
+	m, _ := New(context.Background(), repo, &replayDispatcher{})
+
+	ctx := context.Background()
+	expr := *repository.NewExpression("admin", "device2", "", "properties.b", "device1.a", "")
+	_, err := m.SetExpressionPolicy(ctx, expr, &repository.ExpressionPolicy{Debounce: time.Second})
+	assert.ErrorIs(t, err, xerrors.ErrResourceNotFound)
+
+	assert.Nil(t, m.AppendExpression(ctx, []repository.Expression{expr}))
+	_, err = m.SetExpressionPolicy(ctx, expr, &repository.ExpressionPolicy{Debounce: time.Second, MinInterval: time.Second})
+	assert.ErrorIs(t, err, xerrors.ErrInvalidRequest)
+	ret, err := m.SetExpressionPolicy(ctx, expr, &repository.ExpressionPolicy{Debounce: time.Second})
+	assert.Nil(t, err)
+	assert.Equal(t, time.Second, ret.Policy.Debounce)
+
+	// policy kept if expression updated.
+	expr = *repository.NewExpression("admin", "device2", "", "properties.b", "device1.c", "")
+	assert.Nil(t, m.AppendExpression(ctx, []repository.Expression{expr}))
+	assert.Equal(t, time.Second, repo.exprs[expr.ID].Policy.Debounce)
+
+	// policy removed if empty.
+	ret, err = m.SetExpressionPolicy(ctx, expr, &repository.ExpressionPolicy{})
+	assert.Nil(t, err)
+	assert.Nil(t, ret.Policy)
+	assert.Nil(t, repo.exprs[expr.ID].Policy)
+}
@@ -0,0 +1,153 @@
+package manager
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	logf "github.com/tkeel-io/core/pkg/logfield"
+	"github.com/tkeel-io/core/pkg/repository"
+	"github.com/tkeel-io/kit/log"
+	"github.com/tkeel-io/tdtl"
+)
+
+const (
+	defaultTraverseDepth = 1
+	maxTraverseDepth     = 16
+)
+
+// TraverseReq traverse relationships from entity, children along edges sourced from
+// entity, parents along edges targeting entity if reverse.
+type TraverseReq struct {
+	EntityID string
+	// owner of relationships traversed, all owners if empty.
+	Owner string
+	// relationship type, all types if empty.
+	Type    string
+	Reverse bool
+	// hops traversed, 1 if zero, at most 16.
+	Depth int
+}
+
+// Hop is an edge reached by traversal, depth is the hops from the entity traversed from.
+type Hop struct {
+	Depth        int
+	Relationship *repository.Relationship
+}
+
+func (m *apiManager) CreateRelationship(ctx context.Context, rel *repository.Relationship) (*repository.Relationship, error) {
+	switch {
+	case rel.From == "" || rel.To == "" || rel.Type == "":
+		return nil, errors.Wrap(xerrors.ErrRelationshipInvalid, "create relationship, from, to or type empty")
+	case rel.From == rel.To:
+		return nil, errors.Wrap(xerrors.ErrRelationshipInvalid, "create relationship, self relationship")
+	case rel.Cascade != "" && rel.Cascade != repository.RelationshipCascadeDelete:
+		return nil, errors.Wrapf(xerrors.ErrRelationshipInvalid, "create relationship, cascade %s", rel.Cascade)
+	}
+
+	// both endpoints must exist and belong to the owner, so that cascade never crosses owners.
+	for index, entityID := range []string{rel.From, rel.To} {
+		owner, err := m.entityOwner(ctx, entityID)
+		if nil != err {
+			log.L().Warn("create relationship", logf.Eid(entityID), logf.Reason(err.Error()))
+			return nil, errors.Wrapf(err, "create relationship, endpoint %s", entityID)
+		}
+
+		// owner of source entity if owner unspecified.
+		if index == 0 && rel.Owner == "" {
+			rel.Owner = owner
+		}
+		if owner != rel.Owner {
+			log.L().Warn("create relationship, owner mismatched", logf.Eid(entityID), logf.Owner(rel.Owner))
+			return nil, errors.Wrapf(xerrors.ErrEntityNotFound, "create relationship, endpoint %s", entityID)
+		}
+	}
+
+	rel.CreatedAt = time.Now().UnixMilli()
+	log.L().Info("create relationship", logf.Eid(rel.From), logf.Type(rel.Type),
+		logf.Target(rel.To), logf.Owner(rel.Owner))
+	if err := m.entityRepo.PutRelationship(ctx, rel); nil != err {
+		log.L().Error("create relationship", logf.Eid(rel.From), logf.Target(rel.To), logf.Error(err))
+		return nil, errors.Wrap(err, "create relationship")
+	}
+	return rel, nil
+}
+
+func (m *apiManager) DeleteRelationship(ctx context.Context, rel *repository.Relationship) error {
+	ret, err := m.entityRepo.GetRelationship(ctx, &repository.Relationship{
+		From: rel.From, Type: rel.Type, To: rel.To})
+	if nil != err {
+		return errors.Wrap(err, "delete relationship")
+	} else if ret.From != rel.From || ret.Type != rel.Type || ret.To != rel.To {
+		// key of resource matched by prefix.
+		return errors.Wrap(xerrors.ErrResourceNotFound, "delete relationship")
+	} else if rel.Owner != "" && ret.Owner != rel.Owner {
+		// relationships of other owners invisible.
+		return errors.Wrap(xerrors.ErrResourceNotFound, "delete relationship")
+	}
+
+	log.L().Info("delete relationship", logf.Eid(rel.From), logf.Type(rel.Type), logf.Target(rel.To))
+	return errors.Wrap(m.entityRepo.DelRelationship(ctx, ret), "delete relationship")
+}
+
+func (m *apiManager) ListRelationship(ctx context.Context, req *repository.ListRelationshipReq) ([]*repository.Relationship, error) {
+	rels, err := m.entityRepo.ListRelationship(ctx, m.entityRepo.GetLastRevision(ctx), req)
+	return rels, errors.Wrap(err, "list relationship")
+}
+
+// TraverseRelationship returns edges reached within depth hops in breadth first order,
+// entities visited once.
+func (m *apiManager) TraverseRelationship(ctx context.Context, req *TraverseReq) ([]*Hop, error) {
+	depth := req.Depth
+	if depth <= 0 {
+		depth = defaultTraverseDepth
+	} else if depth > maxTraverseDepth {
+		depth = maxTraverseDepth
+	}
+
+	var hops []*Hop
+	revision := m.entityRepo.GetLastRevision(ctx)
+	visited := map[string]bool{req.EntityID: true}
+	frontier := []string{req.EntityID}
+	for hop := 1; hop <= depth && len(frontier) > 0; hop++ {
+		var next []string
+		for _, entityID := range frontier {
+			rels, err := m.entityRepo.ListRelationship(ctx, revision, &repository.ListRelationshipReq{
+				EntityID: entityID, Owner: req.Owner, Type: req.Type, Reverse: req.Reverse})
+			if nil != err {
+				return nil, errors.Wrap(err, "traverse relationship")
+			}
+
+			for _, rel := range rels {
+				hops = append(hops, &Hop{Depth: hop, Relationship: rel})
+				neighbor := rel.To
+				if req.Reverse {
+					neighbor = rel.From
+				}
+				if !visited[neighbor] {
+					visited[neighbor] = true
+					next = append(next, neighbor)
+				}
+			}
+		}
+		frontier = next
+	}
+	return hops, nil
+}
+
+// entityOwner returns owner of the entity persisted.
+func (m *apiManager) entityOwner(ctx context.Context, entityID string) (string, error) {
+	bytes, err := m.entityRepo.GetEntity(ctx, entityID)
+	if nil != err {
+		if errors.Is(err, xerrors.ErrResourceNotFound) || errors.Is(err, xerrors.ErrEntityNotFound) {
+			return "", errors.Wrap(xerrors.ErrEntityNotFound, err.Error())
+		}
+		return "", errors.Wrap(err, "load entity")
+	}
+
+	if owner := tdtl.New(bytes).Get("owner"); tdtl.String == owner.Type() {
+		return owner.String(), nil
+	}
+	return "", nil
+}
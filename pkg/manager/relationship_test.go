@@ -0,0 +1,161 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"github.com/tkeel-io/core/pkg/repository"
+)
+
+type relationshipRepo struct {
+	repository.IRepository
+	rels     map[string]*repository.Relationship
+	entities map[string]string
+}
+
+func newRelationshipRepo(owner string, entityIDs ...string) *relationshipRepo {
+	repo := &relationshipRepo{
+		rels:     make(map[string]*repository.Relationship),
+		entities: make(map[string]string),
+	}
+	for _, entityID := range entityIDs {
+		repo.entities[entityID] = owner
+	}
+	return repo
+}
+
+func (r *relationshipRepo) GetEntity(ctx context.Context, eid string) ([]byte, error) {
+	if owner, has := r.entities[eid]; has {
+		return []byte(fmt.Sprintf(`{"id":%q,"owner":%q}`, eid, owner)), nil
+	}
+	return nil, xerrors.ErrResourceNotFound
+}
+
+func (r *relationshipRepo) GetLastRevision(ctx context.Context) int64 {
+	return 0
+}
+
+func (r *relationshipRepo) PutRelationship(ctx context.Context, rel *repository.Relationship) error {
+	key, _ := rel.EncodeKey()
+	r.rels[string(key)] = rel
+	return nil
+}
+
+func (r *relationshipRepo) GetRelationship(ctx context.Context, rel *repository.Relationship) (*repository.Relationship, error) {
+	key, _ := rel.EncodeKey()
+	if ret, has := r.rels[string(key)]; has {
+		return ret, nil
+	}
+	return rel, xerrors.ErrResourceNotFound
+}
+
+func (r *relationshipRepo) DelRelationship(ctx context.Context, rel *repository.Relationship) error {
+	key, _ := rel.EncodeKey()
+	delete(r.rels, string(key))
+	return nil
+}
+
+func (r *relationshipRepo) ListRelationship(ctx context.Context, rev int64, req *repository.ListRelationshipReq) ([]*repository.Relationship, error) {
+	var rels []*repository.Relationship
+	for _, rel := range r.rels {
+		entityID := rel.From
+		if req.Reverse {
+			entityID = rel.To
+		}
+		if entityID == req.EntityID && (req.Type == "" || req.Type == rel.Type) &&
+			(req.Owner == "" || req.Owner == rel.Owner) {
+			rels = append(rels, rel)
+		}
+	}
+	return rels, nil
+}
+
+func TestAPIManager_CreateRelationship(t *testing.T) {
+	repo := newRelationshipRepo("admin", "site1", "gateway1")
+	repo.entities["gateway2"] = "tenant"
+	m, _ := New(context.Background(), repo, &replayDispatcher{})
+
+	rel, err := m.CreateRelationship(context.Background(),
+		&repository.Relationship{Type: "contains", From: "site1", To: "gateway1"})
+	assert.Nil(t, err)
+	assert.NotZero(t, rel.CreatedAt)
+	assert.Equal(t, "admin", rel.Owner)
+	assert.Len(t, repo.rels, 1)
+
+	// endpoints missing or owned by others.
+	_, err = m.CreateRelationship(context.Background(),
+		&repository.Relationship{Type: "contains", From: "site1", To: "device1"})
+	assert.ErrorIs(t, err, xerrors.ErrEntityNotFound)
+	_, err = m.CreateRelationship(context.Background(),
+		&repository.Relationship{Type: "contains", From: "site1", To: "gateway2"})
+	assert.ErrorIs(t, err, xerrors.ErrEntityNotFound)
+	_, err = m.CreateRelationship(context.Background(),
+		&repository.Relationship{Type: "contains", From: "site1", To: "gateway1", Owner: "tenant"})
+	assert.ErrorIs(t, err, xerrors.ErrEntityNotFound)
+	assert.Len(t, repo.rels, 1)
+
+	_, err = m.CreateRelationship(context.Background(),
+		&repository.Relationship{Type: "contains", From: "site1", To: "site1"})
+	assert.ErrorIs(t, err, xerrors.ErrRelationshipInvalid)
+	_, err = m.CreateRelationship(context.Background(),
+		&repository.Relationship{From: "site1", To: "gateway1"})
+	assert.ErrorIs(t, err, xerrors.ErrRelationshipInvalid)
+	_, err = m.CreateRelationship(context.Background(),
+		&repository.Relationship{Type: "contains", From: "site1", To: "gateway1", Cascade: "unknown"})
+	assert.ErrorIs(t, err, xerrors.ErrRelationshipInvalid)
+
+	err = m.DeleteRelationship(context.Background(),
+		&repository.Relationship{Type: "contains", From: "site1", To: "gateway2"})
+	assert.ErrorIs(t, err, xerrors.ErrResourceNotFound)
+	err = m.DeleteRelationship(context.Background(),
+		&repository.Relationship{Type: "contains", From: "site1", To: "gateway1", Owner: "tenant"})
+	assert.ErrorIs(t, err, xerrors.ErrResourceNotFound)
+	err = m.DeleteRelationship(context.Background(),
+		&repository.Relationship{Type: "contains", From: "site1", To: "gateway1", Owner: "admin"})
+	assert.Nil(t, err)
+	assert.Len(t, repo.rels, 0)
+}
+
+func TestAPIManager_TraverseRelationship(t *testing.T) {
+	repo := newRelationshipRepo("admin", "site1", "gateway1", "device1", "device2")
+	m, _ := New(context.Background(), repo, &replayDispatcher{})
+
+	// site1 -> gateway1 -> device1, device2 -> site1.
+	for _, rel := range []*repository.Relationship{
+		{Type: "contains", From: "site1", To: "gateway1"},
+		{Type: "contains", From: "gateway1", To: "device1"},
+		{Type: "contains", From: "gateway1", To: "device2"},
+		{Type: "reports", From: "device2", To: "site1"},
+	} {
+		_, err := m.CreateRelationship(context.Background(), rel)
+		assert.Nil(t, err)
+	}
+
+	hops, err := m.TraverseRelationship(context.Background(), &TraverseReq{EntityID: "site1"})
+	assert.Nil(t, err)
+	assert.Len(t, hops, 1)
+	assert.Equal(t, "gateway1", hops[0].Relationship.To)
+
+	hops, err = m.TraverseRelationship(context.Background(), &TraverseReq{EntityID: "site1", Type: "contains", Depth: 5})
+	assert.Nil(t, err)
+	assert.Len(t, hops, 3)
+	assert.Equal(t, 2, hops[2].Depth)
+
+	// cycle visited once.
+	hops, err = m.TraverseRelationship(context.Background(), &TraverseReq{EntityID: "site1", Depth: 5})
+	assert.Nil(t, err)
+	assert.Len(t, hops, 4)
+
+	hops, err = m.TraverseRelationship(context.Background(), &TraverseReq{EntityID: "device1", Reverse: true, Depth: 2})
+	assert.Nil(t, err)
+	assert.Len(t, hops, 2)
+	assert.Equal(t, "site1", hops[1].Relationship.From)
+
+	// relationships of other owners invisible.
+	hops, err = m.TraverseRelationship(context.Background(), &TraverseReq{EntityID: "site1", Owner: "tenant", Depth: 5})
+	assert.Nil(t, err)
+	assert.Len(t, hops, 0)
+}
@@ -0,0 +1,75 @@
+package manager
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	logf "github.com/tkeel-io/core/pkg/logfield"
+	"github.com/tkeel-io/core/pkg/repository"
+	"github.com/tkeel-io/core/pkg/scheduler"
+	"github.com/tkeel-io/core/pkg/util"
+	"github.com/tkeel-io/kit/log"
+)
+
+// CreateSchedule store schedule fired by scheduler, the due time of recurring
+// schedule computed from cron, one-shot schedule fired at NextTime.
+func (m *apiManager) CreateSchedule(ctx context.Context, schedule *repository.Schedule) (*repository.Schedule, error) {
+	now := time.Now()
+	if schedule.EntityID == "" {
+		return nil, errors.Wrap(xerrors.ErrScheduleInvalid, "create schedule, entity id empty")
+	} else if len(schedule.Patches) == 0 {
+		return nil, errors.Wrap(xerrors.ErrScheduleInvalid, "create schedule, patches empty")
+	}
+
+	if schedule.Cron != "" {
+		next, err := scheduler.NextTime(schedule.Cron, schedule.Timezone, now)
+		if nil != err {
+			return nil, errors.Wrap(xerrors.ErrScheduleInvalid, err.Error())
+		}
+		schedule.NextTime = next
+	} else if schedule.NextTime <= 0 {
+		return nil, errors.Wrap(xerrors.ErrScheduleInvalid, "create schedule, due time lack")
+	}
+
+	if schedule.ID == "" {
+		schedule.ID = util.IG().SchID()
+	}
+	schedule.CreatedAt = now.UnixMilli()
+
+	log.L().Info("create schedule", logf.ID(schedule.ID),
+		logf.Eid(schedule.EntityID), logf.Owner(schedule.Owner), logf.Value(schedule.NextTime))
+	if err := m.entityRepo.PutSchedule(ctx, schedule); nil != err {
+		log.L().Error("create schedule", logf.ID(schedule.ID), logf.Error(err))
+		return nil, errors.Wrap(err, "create schedule")
+	}
+	return schedule, nil
+}
+
+func (m *apiManager) ListSchedule(ctx context.Context, entityID string) ([]*repository.Schedule, error) {
+	schedules, err := m.entityRepo.ListSchedule(ctx,
+		m.entityRepo.GetLastRevision(ctx), &repository.ListScheduleReq{EntityID: entityID})
+	return schedules, errors.Wrap(err, "list schedule")
+}
+
+// GetSchedule returns schedule of entity, not found if the schedule belongs to other entity.
+func (m *apiManager) GetSchedule(ctx context.Context, entityID, id string) (*repository.Schedule, error) {
+	schedule, err := m.entityRepo.GetSchedule(ctx, &repository.Schedule{ID: id})
+	if nil != err {
+		return nil, errors.Wrap(err, "get schedule")
+	} else if schedule.EntityID != entityID {
+		return nil, errors.Wrap(xerrors.ErrResourceNotFound, "get schedule")
+	}
+	return schedule, nil
+}
+
+func (m *apiManager) CancelSchedule(ctx context.Context, entityID, id string) error {
+	schedule, err := m.GetSchedule(ctx, entityID, id)
+	if nil != err {
+		return errors.Wrap(err, "cancel schedule")
+	}
+
+	log.L().Info("cancel schedule", logf.ID(id), logf.Eid(entityID))
+	return errors.Wrap(m.entityRepo.DelSchedule(ctx, schedule), "cancel schedule")
+}
@@ -0,0 +1,76 @@
+package manager
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"github.com/tkeel-io/core/pkg/repository"
+)
+
+type scheduleRepo struct {
+	repository.IRepository
+	schedules map[string]*repository.Schedule
+}
+
+func (r *scheduleRepo) PutSchedule(ctx context.Context, schedule *repository.Schedule) error {
+	r.schedules[schedule.ID] = schedule
+	return nil
+}
+
+func (r *scheduleRepo) GetSchedule(ctx context.Context, schedule *repository.Schedule) (*repository.Schedule, error) {
+	if ret, has := r.schedules[schedule.ID]; has {
+		return ret, nil
+	}
+	return schedule, xerrors.ErrResourceNotFound
+}
+
+func (r *scheduleRepo) DelSchedule(ctx context.Context, schedule *repository.Schedule) error {
+	delete(r.schedules, schedule.ID)
+	return nil
+}
+
+func TestAPIManager_CreateSchedule(t *testing.T) {
+	repo := &scheduleRepo{schedules: make(map[string]*repository.Schedule)}
+	m, _ := New(context.Background(), repo, &replayDispatcher{})
+	patches := []*repository.SchedulePatch{{Path: "properties.mode", Operator: "replace", Value: []byte(`"eco"`)}}
+
+	dueTime := time.Now().Add(10 * time.Minute).UnixMilli()
+	schedule, err := m.CreateSchedule(context.Background(),
+		&repository.Schedule{EntityID: "device123", NextTime: dueTime, Patches: patches})
+	assert.Nil(t, err)
+	assert.NotEmpty(t, schedule.ID)
+	assert.Equal(t, dueTime, repo.schedules[schedule.ID].NextTime)
+
+	schedule, err = m.CreateSchedule(context.Background(),
+		&repository.Schedule{ID: "sch-1", EntityID: "device123", Cron: "0 22 * * *", Patches: patches})
+	assert.Nil(t, err)
+	assert.Greater(t, schedule.NextTime, time.Now().UnixMilli())
+
+	_, err = m.CreateSchedule(context.Background(),
+		&repository.Schedule{EntityID: "device123", Cron: "0 25 * * *", Patches: patches})
+	assert.ErrorIs(t, err, xerrors.ErrScheduleInvalid)
+	_, err = m.CreateSchedule(context.Background(),
+		&repository.Schedule{EntityID: "device123", Patches: patches})
+	assert.ErrorIs(t, err, xerrors.ErrScheduleInvalid)
+	_, err = m.CreateSchedule(context.Background(),
+		&repository.Schedule{EntityID: "device123", NextTime: dueTime})
+	assert.ErrorIs(t, err, xerrors.ErrScheduleInvalid)
+}
+
+func TestAPIManager_CancelSchedule(t *testing.T) {
+	repo := &scheduleRepo{schedules: map[string]*repository.Schedule{
+		"sch-1": {ID: "sch-1", EntityID: "device123"},
+	}}
+	m, _ := New(context.Background(), repo, &replayDispatcher{})
+
+	// schedule belongs to other entity.
+	assert.ErrorIs(t, m.CancelSchedule(context.Background(), "device234", "sch-1"), xerrors.ErrResourceNotFound)
+	assert.Contains(t, repo.schedules, "sch-1")
+
+	assert.Nil(t, m.CancelSchedule(context.Background(), "device123", "sch-1"))
+	assert.NotContains(t, repo.schedules, "sch-1")
+	assert.ErrorIs(t, m.CancelSchedule(context.Background(), "device123", "sch-1"), xerrors.ErrResourceNotFound)
+}
@@ -0,0 +1,124 @@
+package manager
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	v1 "github.com/tkeel-io/core/api/core/v1"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	logf "github.com/tkeel-io/core/pkg/logfield"
+	"github.com/tkeel-io/core/pkg/manager/holder"
+	"github.com/tkeel-io/core/pkg/types"
+	"github.com/tkeel-io/core/pkg/util"
+	"github.com/tkeel-io/kit/log"
+)
+
+// PatchEntities patch entities in two phases, patches are staged and entities are locked
+// by owner runtimes in prepare phase, committed only if all entities prepared, otherwise aborted.
+func (m *apiManager) PatchEntities(ctx context.Context, items []*PatchItem) ([]*PatchResult, error) {
+	txID := util.IG().TxID()
+	elapsedTime := util.NewElapsed()
+	log.L().Info("entity.PatchEntities", logf.String("tx_id", txID), logf.Int("entities", len(items)))
+
+	entityIDs := make(map[string]struct{}, len(items))
+	for _, item := range items {
+		if _, has := entityIDs[item.Base.ID]; has || item.Base.ID == "" {
+			log.L().Error("patch entities, invalid entity id",
+				logf.String("tx_id", txID), logf.Eid(item.Base.ID))
+			return nil, errors.Wrap(xerrors.ErrInvalidRequest, "patch entities, invalid entity id")
+		}
+		entityIDs[item.Base.ID] = struct{}{}
+	}
+
+	// 1. prepare.
+	var aborted bool
+	results := make([]*PatchResult, len(items))
+	prepared := make([]*PatchItem, 0, len(items))
+	preparedResults := make([]*PatchResult, 0, len(items))
+	for index, resp := range m.dispatchTransaction(ctx, txID, v1.TxPrepare, items) {
+		results[index] = &PatchResult{ID: items[index].Base.ID}
+		if resp.Status != types.StatusOK {
+			aborted = true
+			results[index].Err = respError(resp.ErrCode)
+			log.L().Warn("patch entities, prepare", logf.String("tx_id", txID),
+				logf.Eid(items[index].Base.ID), logf.Reason(resp.ErrCode))
+			continue
+		}
+		prepared = append(prepared, items[index])
+		preparedResults = append(preparedResults, results[index])
+	}
+
+	// 2. abort prepared entities if any entity failed.
+	if aborted {
+		for index, resp := range m.dispatchTransaction(ctx, txID, v1.TxAbort, prepared) {
+			preparedResults[index].Err = xerrors.ErrTransactionAborted
+			if resp.Status != types.StatusOK {
+				// entity will be unlocked when transaction expired.
+				log.L().Error("patch entities, abort", logf.String("tx_id", txID),
+					logf.Eid(prepared[index].Base.ID), logf.Error(xerrors.New(resp.ErrCode)))
+			}
+		}
+		return results, xerrors.ErrTransactionAborted
+	}
+
+	// 3. commit.
+	var err error
+	for index, resp := range m.dispatchTransaction(ctx, txID, v1.TxCommit, prepared) {
+		result := preparedResults[index]
+		if resp.Status != types.StatusOK {
+			result.Err = respError(resp.ErrCode)
+			err = errors.Wrap(result.Err, "patch entities, commit")
+			log.L().Error("patch entities, commit", logf.String("tx_id", txID),
+				logf.Eid(result.ID), logf.Error(result.Err))
+			continue
+		}
+
+		var baseRet BaseRet
+		if result.Err = json.Unmarshal(resp.Data, &baseRet); nil != result.Err {
+			log.L().Error("patch entities, decode response", logf.String("tx_id", txID),
+				logf.Eid(result.ID), logf.Error(result.Err))
+			continue
+		}
+		result.Base, result.Raw = &baseRet, resp.Data
+	}
+
+	log.L().Info("processing completed", logf.String("tx_id", txID),
+		logf.Elapsed(elapsedTime.Elapsed()))
+	return results, err
+}
+
+// dispatchTransaction dispatch transaction phase to runtimes which own the entities, and wait responses.
+func (m *apiManager) dispatchTransaction(ctx context.Context, txID string, phase v1.TxPhase, items []*PatchItem) []holder.Response {
+	return m.dispatchAndWait(ctx, len(items), func(index int, reqID string) (v1.Event, error) {
+		item := items[index]
+		metadata := Metadata{
+			v1.MetaBorn:      bornTx,
+			v1.MetaType:      enET,
+			v1.MetaEntityID:  item.Base.ID,
+			v1.MetaRequestID: reqID,
+			v1.MetaTxID:      txID,
+			v1.MetaTxPhase:   string(phase),
+			v1.MetaOwner:     item.Base.Owner,
+			v1.MetaSource:    item.Base.Source,
+		}
+
+		var patches []*v1.PatchData
+		if phase == v1.TxPrepare {
+			patches = item.Patches
+			for _, option := range item.Options {
+				option(metadata)
+			}
+		}
+
+		return &v1.ProtoEvent{
+			Id:        util.IG().EvID(),
+			Metadata:  metadata,
+			Timestamp: time.Now().UnixNano(),
+			Callback:  m.callbackAddr(),
+			Data: &v1.ProtoEvent_Patches{
+				Patches: &v1.PatchDatas{Patches: patches},
+			},
+		}, nil
+	})
+}
@@ -0,0 +1,87 @@
+package manager
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "github.com/tkeel-io/core/api/core/v1"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"github.com/tkeel-io/core/pkg/manager/holder"
+	"github.com/tkeel-io/core/pkg/types"
+)
+
+// txDispatcher respond transaction events, prepare of entities in failed returns error.
+type txDispatcher struct {
+	manager *apiManager
+	failed  map[string]bool
+	phases  map[string][]v1.TxPhase
+}
+
+func (d *txDispatcher) DispatchToLog(ctx context.Context, bytes []byte) error {
+	return nil
+}
+
+func (d *txDispatcher) Dispatch(ctx context.Context, ev v1.Event) error {
+	phase := v1.TxPhase(ev.Attr(v1.MetaTxPhase))
+	d.phases[ev.Entity()] = append(d.phases[ev.Entity()], phase)
+
+	resp := &holder.Response{
+		ID:     ev.Attr(v1.MetaRequestID),
+		Status: types.StatusOK,
+		Data:   []byte(`{"id":"` + ev.Entity() + `","version":2,"properties":{"group":"g2"}}`),
+	}
+	if phase == v1.TxPrepare && d.failed[ev.Entity()] {
+		resp.Status = types.StatusError
+		resp.ErrCode = xerrors.ErrEntityLocked.Error()
+	}
+
+	go d.manager.OnRespond(ctx, resp)
+	return nil
+}
+
+func newTxManager(failed map[string]bool) (*apiManager, *txDispatcher) {
+	dispatcher := &txDispatcher{failed: failed, phases: map[string][]v1.TxPhase{}}
+	m, _ := New(context.Background(), nil, dispatcher)
+	dispatcher.manager = m.(*apiManager)
+	return dispatcher.manager, dispatcher
+}
+
+func newPatchItems(ids ...string) []*PatchItem {
+	items := make([]*PatchItem, 0, len(ids))
+	for _, id := range ids {
+		items = append(items, &PatchItem{
+			Base:    &Base{ID: id},
+			Patches: []*v1.PatchData{{Path: "properties.group", Operator: "replace", Value: []byte(`"g2"`)}},
+		})
+	}
+	return items
+}
+
+func TestAPIManager_PatchEntities(t *testing.T) {
+	m, dispatcher := newTxManager(nil)
+	results, err := m.PatchEntities(context.Background(), newPatchItems("group-1", "group-2"))
+	assert.Nil(t, err)
+	assert.Len(t, results, 2)
+	for _, ret := range results {
+		assert.Nil(t, ret.Err)
+		assert.Equal(t, int64(2), ret.Base.Version)
+		assert.Equal(t, []v1.TxPhase{v1.TxPrepare, v1.TxCommit}, dispatcher.phases[ret.ID])
+	}
+}
+
+func TestAPIManager_PatchEntitiesAborted(t *testing.T) {
+	m, dispatcher := newTxManager(map[string]bool{"group-2": true})
+	results, err := m.PatchEntities(context.Background(), newPatchItems("group-1", "group-2"))
+	assert.ErrorIs(t, err, xerrors.ErrTransactionAborted)
+	assert.Equal(t, xerrors.ErrTransactionAborted, results[0].Err)
+	assert.Equal(t, xerrors.ErrEntityLocked, results[1].Err)
+	assert.Equal(t, []v1.TxPhase{v1.TxPrepare, v1.TxAbort}, dispatcher.phases["group-1"])
+	assert.Equal(t, []v1.TxPhase{v1.TxPrepare}, dispatcher.phases["group-2"])
+}
+
+func TestAPIManager_PatchEntitiesDuplicated(t *testing.T) {
+	m, _ := newTxManager(nil)
+	_, err := m.PatchEntities(context.Background(), newPatchItems("group-1", "group-1"))
+	assert.ErrorIs(t, err, xerrors.ErrInvalidRequest)
+}
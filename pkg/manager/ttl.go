@@ -0,0 +1,85 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	logf "github.com/tkeel-io/core/pkg/logfield"
+	"github.com/tkeel-io/core/pkg/repository"
+	xjson "github.com/tkeel-io/core/pkg/util/json"
+	"github.com/tkeel-io/kit/log"
+)
+
+const ttlSchedulePrefix = "ttl-"
+
+// ExpireEntity schedule deletion of entity after ttl, replaces the previous ttl of entity,
+// returns revert restoring the previous ttl if the request failed to apply.
+func (m *apiManager) ExpireEntity(ctx context.Context, en *Base, ttl time.Duration) (Revert, error) {
+	log.L().Info("expire entity", logf.Eid(en.ID), logf.Owner(en.Owner), logf.Elapsed(ttl))
+	revert, err := m.putExpiry(ctx, &repository.Schedule{
+		ID:        ttlScheduleID(en.ID, ""),
+		EntityID:  en.ID,
+		Owner:     en.Owner,
+		Source:    en.Source,
+		Action:    repository.ScheduleActionDelete,
+		NextTime:  time.Now().Add(ttl).UnixMilli(),
+		CreatedAt: time.Now().UnixMilli(),
+	})
+	return revert, errors.Wrap(err, "expire entity")
+}
+
+// ExpireProperty schedule removal of property path after ttl, replaces the previous ttl of the path,
+// returns revert restoring the previous ttl if the request failed to apply.
+func (m *apiManager) ExpireProperty(ctx context.Context, en *Base, path string, ttl time.Duration) (Revert, error) {
+	log.L().Info("expire entity property", logf.Eid(en.ID),
+		logf.Owner(en.Owner), logf.Path(path), logf.Elapsed(ttl))
+	revert, err := m.putExpiry(ctx, &repository.Schedule{
+		ID:        ttlScheduleID(en.ID, path),
+		EntityID:  en.ID,
+		Owner:     en.Owner,
+		Source:    en.Source,
+		Action:    repository.ScheduleActionPatch,
+		NextTime:  time.Now().Add(ttl).UnixMilli(),
+		CreatedAt: time.Now().UnixMilli(),
+		Patches: []*repository.SchedulePatch{{
+			Path:     path,
+			Operator: xjson.OpRemove.String(),
+		}},
+	})
+	return revert, errors.Wrap(err, "expire entity property")
+}
+
+// putExpiry put ttl schedule, revert put back the schedule replaced, or remove the schedule if none.
+func (m *apiManager) putExpiry(ctx context.Context, schedule *repository.Schedule) (Revert, error) {
+	prev, err := m.entityRepo.GetSchedule(ctx, &repository.Schedule{ID: schedule.ID})
+	if nil != err {
+		if !errors.Is(err, xerrors.ErrResourceNotFound) {
+			return nil, errors.Wrap(err, "get ttl schedule")
+		}
+		prev = nil
+	}
+
+	if err = m.entityRepo.PutSchedule(ctx, schedule); nil != err {
+		return nil, errors.Wrap(err, "put ttl schedule")
+	}
+
+	return func(ctx context.Context) error {
+		log.L().Info("revert ttl", logf.ID(schedule.ID), logf.Eid(schedule.EntityID))
+		if nil != prev {
+			return errors.Wrap(m.entityRepo.PutSchedule(ctx, prev), "revert ttl schedule")
+		}
+		return errors.Wrap(m.entityRepo.DelSchedule(ctx, schedule), "revert ttl schedule")
+	}, nil
+}
+
+// ttlScheduleID returns schedule id of entity ttl, or ttl of property path.
+func ttlScheduleID(entityID, path string) string {
+	if path == "" {
+		return ttlSchedulePrefix + entityID
+	}
+	return fmt.Sprintf("%s%s-%s", ttlSchedulePrefix, entityID, url.PathEscape(path))
+}
@@ -0,0 +1,55 @@
+package manager
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tkeel-io/core/pkg/repository"
+)
+
+func TestAPIManager_Expire(t *testing.T) {
+	repo := &scheduleRepo{schedules: make(map[string]*repository.Schedule)}
+	m, _ := New(context.Background(), repo, &replayDispatcher{})
+	en := &Base{ID: "device123", Owner: "admin"}
+
+	_, err := m.ExpireEntity(context.Background(), en, time.Minute)
+	assert.Nil(t, err)
+	_, err = m.ExpireEntity(context.Background(), en, time.Hour)
+	assert.Nil(t, err)
+	_, err = m.ExpireProperty(context.Background(), en, "properties.alarm", time.Minute)
+	assert.Nil(t, err)
+	assert.Len(t, repo.schedules, 2)
+
+	// ttl replaced.
+	schedule := repo.schedules["ttl-device123"]
+	assert.Equal(t, repository.ScheduleActionDelete, schedule.Action)
+	assert.InDelta(t, time.Now().Add(time.Hour).UnixMilli(), schedule.NextTime, 1000)
+
+	schedule = repo.schedules["ttl-device123-properties.alarm"]
+	assert.Equal(t, "properties.alarm", schedule.Patches[0].Path)
+	assert.Equal(t, "remove", schedule.Patches[0].Operator)
+}
+
+func TestAPIManager_ExpireRevert(t *testing.T) {
+	repo := &scheduleRepo{schedules: make(map[string]*repository.Schedule)}
+	m, _ := New(context.Background(), repo, &replayDispatcher{})
+	en := &Base{ID: "device123", Owner: "admin"}
+
+	_, err := m.ExpireEntity(context.Background(), en, time.Minute)
+	assert.Nil(t, err)
+	prev := repo.schedules["ttl-device123"]
+
+	// previous ttl restored.
+	revert, err := m.ExpireEntity(context.Background(), en, time.Hour)
+	assert.Nil(t, err)
+	assert.Nil(t, revert(context.Background()))
+	assert.Equal(t, prev, repo.schedules["ttl-device123"])
+
+	// ttl removed if none before.
+	revert, err = m.ExpireProperty(context.Background(), en, "properties.alarm", time.Minute)
+	assert.Nil(t, err)
+	assert.Nil(t, revert(context.Background()))
+	assert.Len(t, repo.schedules, 1)
+}
@@ -19,11 +19,14 @@ package manager
 import (
 	"context"
 	"errors"
+	"strconv"
+	"time"
 
 	v1 "github.com/tkeel-io/core/api/core/v1"
 	"github.com/tkeel-io/core/pkg/manager/holder"
 	"github.com/tkeel-io/core/pkg/mapper"
 	"github.com/tkeel-io/core/pkg/repository"
+	"github.com/tkeel-io/core/pkg/runtime"
 )
 
 const CoreAPISender = "core.api"
@@ -41,10 +44,14 @@ type APIManager interface {
 	OnRespond(context.Context, *holder.Response)
 	// CreateEntity create entity.
 	CreateEntity(context.Context, *Base) (*BaseRet, error)
+	// CreateEntities create entities in batches, results reported per entity.
+	CreateEntities(context.Context, []*Base) ([]*CreateResult, error)
 	// UpdateEntity update entity.
 	PatchEntity(context.Context, *Base, []*v1.PatchData, ...Option) (*BaseRet, []byte, error)
+	// PatchEntities patch entities in a transaction, either all patches committed or none.
+	PatchEntities(context.Context, []*PatchItem) ([]*PatchResult, error)
 	// DeleteEntity delete entity.
-	DeleteEntity(context.Context, *Base) error
+	DeleteEntity(context.Context, *Base, ...Option) error
 	// GetProperties returns entity properties.
 	GetEntity(context.Context, *Base) (*BaseRet, error)
 	// AppendMapper append entity mapper.
@@ -55,12 +62,58 @@ type APIManager interface {
 	AppendExpression(context.Context, []repository.Expression) error
 	RemoveExpression(context.Context, []repository.Expression) error
 	GetExpression(context.Context, repository.Expression) (*repository.Expression, error)
+	SetExpressionPolicy(context.Context, repository.Expression, *repository.ExpressionPolicy) (*repository.Expression, error)
 	ListExpression(context.Context, *Base) ([]*repository.Expression, error)
+	// DryRunExpression evaluates expressions without persisting.
+	DryRunExpression(context.Context, *DryRunReq) ([]*DryRunResult, error)
+	// ExpressionGraph returns dependencies of expressions.
+	ExpressionGraph(context.Context, string) ([]runtime.Dependency, error)
 
 	// Subscription.
 	CreateSubscription(context.Context, *repository.Subscription) error
 	DeleteSubscription(context.Context, *repository.Subscription) error
 	GetSubscription(context.Context, *repository.Subscription) (*repository.Subscription, error)
+
+	// DeadLetter.
+	ListDeadLetter(context.Context, string) ([]*repository.DeadLetter, error)
+	GetDeadLetter(context.Context, string) (*repository.DeadLetter, error)
+	ReplayDeadLetter(context.Context, string) error
+	RemoveDeadLetter(context.Context, string) error
+	PurgeDeadLetter(context.Context, string) (int, error)
+
+	// Schedule.
+	CreateSchedule(context.Context, *repository.Schedule) (*repository.Schedule, error)
+	ListSchedule(context.Context, string) ([]*repository.Schedule, error)
+	GetSchedule(context.Context, string, string) (*repository.Schedule, error)
+	CancelSchedule(context.Context, string, string) error
+
+	// TTL.
+	ExpireEntity(context.Context, *Base, time.Duration) (Revert, error)
+	ExpireProperty(context.Context, *Base, string, time.Duration) (Revert, error)
+
+	// Relationship.
+	CreateRelationship(context.Context, *repository.Relationship) (*repository.Relationship, error)
+	DeleteRelationship(context.Context, *repository.Relationship) error
+	ListRelationship(context.Context, *repository.ListRelationshipReq) ([]*repository.Relationship, error)
+	TraverseRelationship(context.Context, *TraverseReq) ([]*Hop, error)
+}
+
+// Revert undo a change made before the request applied, if the request failed.
+type Revert func(context.Context) error
+
+// PatchItem is the patches of an entity in transaction.
+type PatchItem struct {
+	Base    *Base
+	Patches []*v1.PatchData
+	Options []Option
+}
+
+// PatchResult is the result of an entity in transaction.
+type PatchResult struct {
+	ID   string
+	Err  error
+	Base *BaseRet
+	Raw  []byte
 }
 
 type Metadata map[string]string
@@ -72,3 +125,24 @@ func NewPathConstructorOption(pc v1.PathConstructor) Option {
 		meta[v1.MetaPathConstructor] = string(pc)
 	}
 }
+
+// NewIdempotencyKeyOption use key as event id, request applied once to entity.
+func NewIdempotencyKeyOption(key string) Option {
+	return func(meta Metadata) {
+		meta[v1.MetaIdempotencyKey] = key
+	}
+}
+
+// NewIfVersionOption apply request only if entity version equals version.
+func NewIfVersionOption(version int64) Option {
+	return func(meta Metadata) {
+		meta[v1.MetaIfVersion] = strconv.FormatInt(version, 10)
+	}
+}
+
+// NewIfExistsOption apply request only if entity exists.
+func NewIfExistsOption() Option {
+	return func(meta Metadata) {
+		meta[v1.MetaIfVersion] = v1.IfVersionAny
+	}
+}
@@ -0,0 +1,484 @@
+package expression
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/tidwall/gjson"
+	"github.com/tkeel-io/tdtl"
+)
+
+// builtin is a function of the standard library.
+type builtin struct {
+	// count of args, variadic if max is negative.
+	min, max int
+	fn       tdtl.ContextFunc
+}
+
+func (b builtin) arity() string {
+	switch {
+	case b.max < 0:
+		return fmt.Sprintf("at least %d", b.min)
+	case b.min == b.max:
+		return fmt.Sprintf("%d", b.min)
+	}
+	return fmt.Sprintf("%d to %d", b.min, b.max)
+}
+
+func (b builtin) call(args ...tdtl.Node) tdtl.Node {
+	if len(args) < b.min || (b.max >= 0 && len(args) > b.max) {
+		return tdtl.UNDEFINED_RESULT
+	}
+	return b.fn(args...)
+}
+
+var builtins = map[string]builtin{
+	// math.
+	"abs":   {1, 1, absFunc},
+	"round": {1, 2, roundFunc},
+	"clamp": {3, 3, clampFunc},
+	// string.
+	"concat": {1, -1, concatFunc},
+	"substr": {2, 3, substrFunc},
+	"regex":  {2, 3, regexFunc},
+	// time.
+	"now":    {0, 0, nowFunc},
+	"format": {1, 3, formatFunc},
+	"diff":   {2, 3, diffFunc},
+	// json.
+	"len":  {1, 1, lenFunc},
+	"keys": {1, 1, keysFunc},
+	"has":  {2, 2, hasFunc},
+	// conditionals.
+	"if":       {3, 3, ifFunc},
+	"coalesce": {1, -1, coalesceFunc},
+	// aggregations, windows kept by runtime.
+	"avg":   {2, 3, windowFunc("avg")},
+	"min":   {2, 3, windowFunc("min")},
+	"max":   {2, 3, windowFunc("max")},
+	"sum":   {2, 3, windowFunc("sum")},
+	"count": {2, 3, windowFunc("count")},
+}
+
+// Funcs returns functions of the standard library merged with extFuncs,
+// builtin functions are not overridden by extFuncs except fan-in aggregations.
+func Funcs(extFuncs map[string]tdtl.ContextFunc) map[string]tdtl.ContextFunc {
+	funcs := make(map[string]tdtl.ContextFunc, len(builtins)+len(fanins)+len(extFuncs))
+	for name, b := range fanins {
+		funcs[name] = b.call
+	}
+	for name, fn := range extFuncs {
+		funcs[name] = fn
+	}
+	for name, b := range builtins {
+		funcs[name] = b.call
+	}
+	return funcs
+}
+
+// IsBuiltin reports whether name is a function of the standard library.
+func IsBuiltin(name string) bool {
+	_, has := builtins[name]
+	_, fanIn := fanins[name]
+	return has || fanIn
+}
+
+func undefined(n tdtl.Node) bool {
+	return nil == n || n.Type() == tdtl.Undefined || n.Type() == tdtl.Null
+}
+
+// number returns IntNode or FloatNode of n, strings of numbers converted.
+func number(n tdtl.Node) (tdtl.Node, bool) {
+	if undefined(n) {
+		return nil, false
+	}
+
+	var ret tdtl.Node
+	switch n.Type() {
+	case tdtl.Int:
+		ret = n.To(tdtl.Int)
+	case tdtl.Float:
+		ret = n.To(tdtl.Float)
+	case tdtl.String:
+		ret = n.To(tdtl.Number)
+	default:
+		return nil, false
+	}
+
+	switch ret.(type) {
+	case tdtl.IntNode, tdtl.FloatNode:
+		return ret, true
+	}
+	return nil, false
+}
+
+func toFloat(n tdtl.Node) (float64, bool) {
+	switch v, _ := number(n); v := v.(type) {
+	case tdtl.IntNode:
+		return float64(v), true
+	case tdtl.FloatNode:
+		return float64(v), true
+	}
+	return 0, false
+}
+
+func toInt(n tdtl.Node) (int64, bool) {
+	switch v, _ := number(n); v := v.(type) {
+	case tdtl.IntNode:
+		return int64(v), true
+	case tdtl.FloatNode:
+		return int64(v), true
+	}
+	return 0, false
+}
+
+func toString(n tdtl.Node) (string, bool) {
+	if undefined(n) {
+		return "", false
+	}
+	return n.String(), true
+}
+
+func absFunc(args ...tdtl.Node) tdtl.Node {
+	switch v, _ := number(args[0]); v := v.(type) {
+	case tdtl.IntNode:
+		if v < 0 {
+			return -v
+		}
+		return v
+	case tdtl.FloatNode:
+		return tdtl.FloatNode(math.Abs(float64(v)))
+	}
+	return tdtl.UNDEFINED_RESULT
+}
+
+// roundFunc round(x[, digits]), returns int without digits.
+func roundFunc(args ...tdtl.Node) tdtl.Node {
+	v, ok := number(args[0])
+	if !ok {
+		return tdtl.UNDEFINED_RESULT
+	}
+
+	if len(args) == 1 {
+		if f, isFloat := v.(tdtl.FloatNode); isFloat {
+			return tdtl.IntNode(math.Round(float64(f)))
+		}
+		return v
+	}
+
+	digits, ok := toInt(args[1])
+	if !ok {
+		return tdtl.UNDEFINED_RESULT
+	}
+	f, _ := toFloat(v)
+	pow := math.Pow10(int(digits))
+	return tdtl.FloatNode(math.Round(f*pow) / pow)
+}
+
+// clampFunc clamp(x, min, max), returns int if all args are int.
+func clampFunc(args ...tdtl.Node) tdtl.Node {
+	x, ok1 := number(args[0])
+	lo, ok2 := number(args[1])
+	hi, ok3 := number(args[2])
+	if !ok1 || !ok2 || !ok3 {
+		return tdtl.UNDEFINED_RESULT
+	}
+
+	xi, isInt1 := x.(tdtl.IntNode)
+	loi, isInt2 := lo.(tdtl.IntNode)
+	hii, isInt3 := hi.(tdtl.IntNode)
+	if isInt1 && isInt2 && isInt3 {
+		if loi > hii {
+			return tdtl.UNDEFINED_RESULT
+		}
+		if xi < loi {
+			return loi
+		} else if xi > hii {
+			return hii
+		}
+		return xi
+	}
+
+	xf, _ := toFloat(x)
+	lof, _ := toFloat(lo)
+	hif, _ := toFloat(hi)
+	if lof > hif {
+		return tdtl.UNDEFINED_RESULT
+	}
+	return tdtl.FloatNode(math.Min(math.Max(xf, lof), hif))
+}
+
+// concatFunc concat(s1, s2, ...), undefined if any arg undefined.
+func concatFunc(args ...tdtl.Node) tdtl.Node {
+	var builder strings.Builder
+	for _, arg := range args {
+		s, ok := toString(arg)
+		if !ok {
+			return tdtl.UNDEFINED_RESULT
+		}
+		builder.WriteString(s)
+	}
+	return tdtl.StringNode(builder.String())
+}
+
+// substrFunc substr(s, start[, length]), counts in characters, negative start counts from the end.
+func substrFunc(args ...tdtl.Node) tdtl.Node {
+	s, ok := toString(args[0])
+	if !ok {
+		return tdtl.UNDEFINED_RESULT
+	}
+	start, ok := toInt(args[1])
+	if !ok {
+		return tdtl.UNDEFINED_RESULT
+	}
+
+	runes := []rune(s)
+	size := int64(len(runes))
+	if start < 0 {
+		start += size
+	}
+	if start < 0 {
+		start = 0
+	} else if start > size {
+		start = size
+	}
+
+	end := size
+	if len(args) == 3 {
+		length, ok := toInt(args[2])
+		if !ok || length < 0 {
+			return tdtl.UNDEFINED_RESULT
+		}
+		if start+length < end {
+			end = start + length
+		}
+	}
+	return tdtl.StringNode(string(runes[start:end]))
+}
+
+// regexFunc regex(s, pattern) reports whether s matches pattern,
+// regex(s, pattern, group) returns the group matched, 0 for the whole match.
+func regexFunc(args ...tdtl.Node) tdtl.Node {
+	s, ok1 := toString(args[0])
+	pattern, ok2 := toString(args[1])
+	if !ok1 || !ok2 {
+		return tdtl.UNDEFINED_RESULT
+	}
+	re, err := compileRegex(pattern)
+	if nil != err {
+		return tdtl.UNDEFINED_RESULT
+	}
+
+	if len(args) == 2 {
+		return tdtl.BoolNode(re.MatchString(s))
+	}
+
+	group, ok := toInt(args[2])
+	if !ok {
+		return tdtl.UNDEFINED_RESULT
+	}
+	matches := re.FindStringSubmatch(s)
+	if group < 0 || group >= int64(len(matches)) {
+		return tdtl.UNDEFINED_RESULT
+	}
+	return tdtl.StringNode(matches[group])
+}
+
+// patterns are mostly literals, cache bounded against patterns built from values.
+const maxRegexCache = 1024
+
+var regexCache = struct {
+	sync.RWMutex
+	patterns map[string]*regexp.Regexp
+}{patterns: make(map[string]*regexp.Regexp)}
+
+func compileRegex(pattern string) (*regexp.Regexp, error) {
+	regexCache.RLock()
+	re, has := regexCache.patterns[pattern]
+	regexCache.RUnlock()
+	if has {
+		return re, nil
+	}
+
+	re, err := regexp.Compile(pattern)
+	if nil != err {
+		return nil, err //nolint
+	}
+
+	regexCache.Lock()
+	if len(regexCache.patterns) < maxRegexCache {
+		regexCache.patterns[pattern] = re
+	}
+	regexCache.Unlock()
+	return re, nil
+}
+
+// nowFunc now(), returns unix timestamp in milliseconds.
+func nowFunc(args ...tdtl.Node) tdtl.Node {
+	return tdtl.IntNode(time.Now().UnixMilli())
+}
+
+// toTime parse unix timestamp in milliseconds or RFC3339 string.
+func toTime(n tdtl.Node) (time.Time, bool) {
+	if n.Type() == tdtl.String {
+		if t, err := time.Parse(time.RFC3339Nano, n.String()); nil == err {
+			return t, true
+		}
+	}
+	if ms, ok := toInt(n); ok {
+		return time.UnixMilli(ms), true
+	}
+	return time.Time{}, false
+}
+
+// formatFunc format(ts[, layout[, timezone]]), layout of golang, RFC3339 and UTC by default.
+func formatFunc(args ...tdtl.Node) tdtl.Node {
+	t, ok := toTime(args[0])
+	if !ok {
+		return tdtl.UNDEFINED_RESULT
+	}
+
+	layout, location := time.RFC3339, time.UTC
+	if len(args) > 1 {
+		if layout, ok = toString(args[1]); !ok {
+			return tdtl.UNDEFINED_RESULT
+		}
+	}
+	if len(args) > 2 {
+		name, ok := toString(args[2])
+		if !ok {
+			return tdtl.UNDEFINED_RESULT
+		}
+		var err error
+		if location, err = loadLocation(name); nil != err {
+			return tdtl.UNDEFINED_RESULT
+		}
+	}
+	return tdtl.StringNode(t.In(location).Format(layout))
+}
+
+var locations sync.Map
+
+func loadLocation(name string) (*time.Location, error) {
+	if loc, has := locations.Load(name); has {
+		return loc.(*time.Location), nil
+	}
+	loc, err := time.LoadLocation(name)
+	if nil != err {
+		return nil, err //nolint
+	}
+	locations.Store(name, loc)
+	return loc, nil
+}
+
+var durationUnits = map[string]time.Duration{
+	"ms": time.Millisecond,
+	"s":  time.Second,
+	"m":  time.Minute,
+	"h":  time.Hour,
+	"d":  24 * time.Hour,
+}
+
+// diffFunc diff(ts1, ts2[, unit]), returns ts1 - ts2 in unit, milliseconds by default.
+func diffFunc(args ...tdtl.Node) tdtl.Node {
+	t1, ok1 := toTime(args[0])
+	t2, ok2 := toTime(args[1])
+	if !ok1 || !ok2 {
+		return tdtl.UNDEFINED_RESULT
+	}
+
+	unit := time.Millisecond
+	if len(args) == 3 {
+		name, _ := toString(args[2])
+		var has bool
+		if unit, has = durationUnits[name]; !has {
+			return tdtl.UNDEFINED_RESULT
+		}
+	}
+	return tdtl.IntNode(t1.Sub(t2) / unit)
+}
+
+// lenFunc len(x), length of string in characters, array in items, object in keys.
+func lenFunc(args ...tdtl.Node) tdtl.Node {
+	switch arg := args[0]; arg.Type() {
+	case tdtl.String:
+		return tdtl.IntNode(utf8.RuneCountInString(arg.String()))
+	case tdtl.Array, tdtl.Object:
+		count := 0
+		tdtl.New(arg.Raw()).Foreach(func(key []byte, value *tdtl.Collect) {
+			count++
+		})
+		return tdtl.IntNode(count)
+	}
+	return tdtl.UNDEFINED_RESULT
+}
+
+// keysFunc keys(object), returns keys of object in order.
+func keysFunc(args ...tdtl.Node) tdtl.Node {
+	if args[0].Type() != tdtl.Object {
+		return tdtl.UNDEFINED_RESULT
+	}
+
+	keys := []string{}
+	tdtl.New(args[0].Raw()).Foreach(func(key []byte, value *tdtl.Collect) {
+		keys = append(keys, string(key))
+	})
+	bytes, err := json.Marshal(keys)
+	if nil != err {
+		return tdtl.UNDEFINED_RESULT
+	}
+	return tdtl.New(bytes)
+}
+
+// hasFunc has(x, path), reports whether path exists in object or array, e.g. 'a.b[0]'.
+func hasFunc(args ...tdtl.Node) tdtl.Node {
+	switch args[0].Type() {
+	case tdtl.Array, tdtl.Object:
+	default:
+		return tdtl.UNDEFINED_RESULT
+	}
+
+	path, ok := toString(args[1])
+	if !ok {
+		return tdtl.UNDEFINED_RESULT
+	}
+	path = strings.NewReplacer("[", ".", "]", "").Replace(path)
+	return tdtl.BoolNode(gjson.GetBytes(args[0].Raw(), path).Exists())
+}
+
+// ifFunc if(cond, then, else), returns then if cond is true.
+func ifFunc(args ...tdtl.Node) tdtl.Node {
+	if truthy(args[0]) {
+		return args[1]
+	}
+	return args[2]
+}
+
+func truthy(n tdtl.Node) bool {
+	if undefined(n) {
+		return false
+	}
+	if b, ok := n.To(tdtl.Bool).(tdtl.BoolNode); ok {
+		return bool(b)
+	}
+	if v, ok := toFloat(n); ok {
+		return v != 0
+	}
+	return false
+}
+
+// coalesceFunc coalesce(x1, x2, ...), returns the first arg not undefined or null.
+func coalesceFunc(args ...tdtl.Node) tdtl.Node {
+	for _, arg := range args {
+		if !undefined(arg) {
+			return arg
+		}
+	}
+	return tdtl.UNDEFINED_RESULT
+}
@@ -0,0 +1,80 @@
+package expression
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"github.com/tkeel-io/tdtl"
+)
+
+func TestBuiltins(t *testing.T) {
+	in := map[string]tdtl.Node{
+		"device1.temp":  tdtl.New(`-12.345`),
+		"device1.level": tdtl.New(`120`),
+		"device1.name":  tdtl.New(`"sensor-0042"`),
+		"device1.ts":    tdtl.New(`1650000000000`),
+		"device1.meta":  tdtl.New(`{"model":"x1","tags":["a","b"]}`),
+		"device1.model": tdtl.New(`"x1"`),
+		"device1.tags":  tdtl.New(`["a","b"]`),
+	}
+
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{`abs(device1.temp)`, "12.345000"},
+		{`abs(-3)`, "3"},
+		{`round(device1.temp)`, "-12"},
+		{`round(device1.temp, 2)`, "-12.350000"},
+		{`clamp(device1.level, 0, 100)`, "100"},
+		{`clamp(device1.temp, -10, 10.5)`, "-10.000000"},
+		{`concat(device1.name, '/', device1.model)`, "sensor-0042/x1"},
+		{`substr(device1.name, 0, 6)`, "sensor"},
+		{`substr(device1.name, -4)`, "0042"},
+		{`regex(device1.name, '^sensor-[0-9]+$')`, "true"},
+		{`regex(device1.name, 'sensor-0*([0-9]+)', 1)`, "42"},
+		{`format(device1.ts, '2006-01-02 15:04:05', 'Asia/Shanghai')`, "2022-04-15 13:20:00"},
+		{`format(device1.ts)`, "2022-04-15T05:20:00Z"},
+		{`diff(1650003600000, device1.ts, 'm')`, "60"},
+		{`diff('2022-04-15T06:20:00Z', device1.ts, 'h')`, "1"},
+		{`len(device1.name)`, "11"},
+		{`len(device1.meta)`, "2"},
+		{`len(device1.tags)`, "2"},
+		{`keys(device1.meta)`, `["model","tags"]`},
+		{`has(device1.meta, 'tags[1]')`, "true"},
+		{`has(device1.meta, 'serial')`, "false"},
+		{`if(device1.level > 100, 'high', 'normal')`, "high"},
+		{`coalesce(device1.serial, device1.model)`, "x1"},
+	}
+
+	for _, test := range tests {
+		expr, err := NewExpr(test.expr, nil)
+		assert.Nil(t, err, test.expr)
+		ret, err := expr.Eval(context.Background(), in)
+		assert.Nil(t, err, test.expr)
+		assert.Equal(t, test.want, ret.String(), test.expr)
+	}
+
+	// invalid args evaluated as undefined.
+	for _, text := range []string{`abs(device1.name)`, `clamp(1, 10, 0)`, `substr(device1.name)`,
+		`format(device1.ts, '', 'Mars/Base')`, `diff(device1.ts, 0, 'w')`, `keys(device1.name)`} {
+		expr, err := NewExpr(text, nil)
+		assert.Nil(t, err, text)
+		ret, err := expr.Eval(context.Background(), in)
+		assert.Nil(t, err, text)
+		assert.Equal(t, tdtl.Undefined, ret.Type(), text)
+	}
+}
+
+func TestCheckCalls(t *testing.T) {
+	udf := map[string]tdtl.ContextFunc{"crc": func(args ...tdtl.Node) tdtl.Node { return tdtl.IntNode(0) }}
+
+	assert.Nil(t, CheckCalls(`abs(device1.temp) + round(device1.temp, 1)`, nil))
+	assert.Nil(t, CheckCalls(`if(crc(device1.name) > 0, concat('a', 'b', 'c'), now())`, udf))
+	assert.ErrorIs(t, CheckCalls(`crc(device1.name)`, nil), xerrors.ErrExpressionInvalid)
+	assert.ErrorIs(t, CheckCalls(`abs(round(device1.temp, 1, 2))`, nil), xerrors.ErrExpressionInvalid)
+	assert.ErrorIs(t, CheckCalls(`if(device1.temp > 0, 1)`, nil), xerrors.ErrExpressionInvalid)
+	assert.ErrorIs(t, CheckCalls(`concat()`, nil), xerrors.ErrExpressionInvalid)
+}
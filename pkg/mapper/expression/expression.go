@@ -3,9 +3,12 @@ package expression
 import (
 	"context"
 
+	"github.com/antlr/antlr4/runtime/Go/antlr"
 	"github.com/pkg/errors"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
 	"github.com/tkeel-io/core/pkg/repository"
 	"github.com/tkeel-io/tdtl"
+	"github.com/tkeel-io/tdtl/parser"
 )
 
 type IExpression interface {
@@ -26,7 +29,7 @@ type Expr struct {
 }
 
 func NewExpr(expression string, extFuncs map[string]tdtl.ContextFunc) (IExpression, error) {
-	exprIns, err := tdtl.NewExpr(expression, extFuncs)
+	exprIns, err := tdtl.NewExpr(expression, Funcs(extFuncs))
 	return &Expr{exprIns: exprIns}, errors.Wrap(err, "new expression evaler")
 }
 
@@ -38,3 +41,39 @@ func (e *Expr) Eval(ctx context.Context, in map[string]tdtl.Node) (tdtl.Node, er
 func (e *Expr) Sources() map[string][]string {
 	return e.exprIns.Sources()
 }
+
+type callListener struct {
+	parser.BaseTDTLListener
+	calls []*parser.Call_exprContext
+}
+
+func (l *callListener) EnterCall_expr(c *parser.Call_exprContext) {
+	l.calls = append(l.calls, c)
+}
+
+// CheckCalls check functions called by the expression are builtin or in extFuncs,
+// and count of args passed to builtin functions.
+func CheckCalls(expression string, extFuncs map[string]tdtl.ContextFunc) error {
+	lexer := parser.NewTDTLLexer(antlr.NewInputStream(expression))
+	p := parser.NewTDTLParser(antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel))
+	p.RemoveErrorListeners()
+
+	var listener callListener
+	antlr.ParseTreeWalkerDefault.Walk(&listener, p.Field_elem())
+	for _, c := range listener.calls {
+		name, argc := c.GetKey().GetText(), len(c.AllExpr())
+		if _, has := fanins[name]; has {
+			if _, err := parseFanIn(c); nil != err {
+				return err
+			}
+		} else if b, has := builtins[name]; has {
+			if argc < b.min || (b.max >= 0 && argc > b.max) {
+				return errors.Wrapf(xerrors.ErrExpressionInvalid,
+					"function %s called with %d args, %s expected", name, argc, b.arity())
+			}
+		} else if _, has = extFuncs[name]; !has {
+			return errors.Wrapf(xerrors.ErrExpressionInvalid, "function %s undefined", name)
+		}
+	}
+	return nil
+}
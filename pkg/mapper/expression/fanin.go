@@ -0,0 +1,254 @@
+package expression
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+	"github.com/pkg/errors"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"github.com/tkeel-io/tdtl"
+	"github.com/tkeel-io/tdtl/parser"
+)
+
+// conditions of selector.
+const (
+	SelectorType     = "type"
+	SelectorTemplate = "template"
+	SelectorOwner    = "owner"
+	SelectorRelated  = "related"
+)
+
+// fan-in aggregations over entities selected, called as agg_of(selector, path),
+// members aggregated by runtime of the target entity, undefined evaluated elsewhere.
+var fanins = map[string]builtin{
+	"avg_of":   {2, 2, fanInFunc},
+	"min_of":   {2, 2, fanInFunc},
+	"max_of":   {2, 2, fanInFunc},
+	"sum_of":   {2, 2, fanInFunc},
+	"count_of": {2, 2, fanInFunc},
+}
+
+func fanInFunc(args ...tdtl.Node) tdtl.Node {
+	return tdtl.UNDEFINED_RESULT
+}
+
+// Selector selects entities by type, template, owner or relationship, conditions are conjunctive.
+type Selector struct {
+	Type     string
+	Template string
+	Owner    string
+	// entities targeted by relationships of RelatedType from RelatedFrom.
+	RelatedFrom string
+	RelatedType string
+}
+
+// ParseSelector parse selector like 'type=device,related=site1/contains'.
+func ParseSelector(text string) (Selector, error) {
+	var s Selector
+	conds := make(map[string]bool)
+	for _, cond := range strings.Split(text, ",") {
+		segs := strings.SplitN(strings.TrimSpace(cond), "=", 2)
+		if len(segs) != 2 || strings.TrimSpace(segs[1]) == "" {
+			return s, errors.Wrapf(xerrors.ErrExpressionInvalid, "selector %s, condition %s", text, cond)
+		}
+
+		key, value := strings.TrimSpace(segs[0]), strings.TrimSpace(segs[1])
+		if conds[key] {
+			return s, errors.Wrapf(xerrors.ErrExpressionInvalid, "selector %s, condition %s duplicated", text, key)
+		}
+		conds[key] = true
+
+		switch key {
+		case SelectorType:
+			s.Type = value
+		case SelectorTemplate:
+			s.Template = value
+		case SelectorOwner:
+			s.Owner = value
+		case SelectorRelated:
+			rel := strings.SplitN(value, "/", 2)
+			if len(rel) != 2 || rel[0] == "" || rel[1] == "" {
+				return s, errors.Wrapf(xerrors.ErrExpressionInvalid, "selector %s, related %s, from/type expected", text, value)
+			}
+			s.RelatedFrom, s.RelatedType = rel[0], rel[1]
+		default:
+			return s, errors.Wrapf(xerrors.ErrExpressionInvalid, "selector %s, condition %s undefined", text, key)
+		}
+	}
+	return s, nil
+}
+
+// Match reports whether entity attributes matched, relationship not checked.
+func (s Selector) Match(typ, template, owner string) bool {
+	return (s.Type == "" || s.Type == typ) &&
+		(s.Template == "" || s.Template == template) &&
+		(s.Owner == "" || s.Owner == owner)
+}
+
+// FanIn is the path of entities selected, aggregated by fan-in calls.
+type FanIn struct {
+	Selector Selector
+	Text     string
+	Path     string
+}
+
+// Key returns key of members of the fan-in.
+func (f FanIn) Key() string {
+	return f.Text + "#" + f.Path
+}
+
+// FanIns returns fan-ins of the expression, fan-ins called with the same args returned once.
+func FanIns(expression string) ([]FanIn, error) {
+	lexer := parser.NewTDTLLexer(antlr.NewInputStream(expression))
+	p := parser.NewTDTLParser(antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel))
+	p.RemoveErrorListeners()
+
+	var fanIns []FanIn
+	var listener callListener
+	keys := make(map[string]bool)
+	antlr.ParseTreeWalkerDefault.Walk(&listener, p.Field_elem())
+	for _, c := range listener.calls {
+		if _, has := fanins[c.GetKey().GetText()]; !has {
+			continue
+		}
+
+		fanIn, err := parseFanIn(c)
+		if nil != err {
+			return nil, err
+		} else if !keys[fanIn.Key()] {
+			keys[fanIn.Key()] = true
+			fanIns = append(fanIns, fanIn)
+		}
+	}
+	return fanIns, nil
+}
+
+// parseFanIn parse fan-in call, selector and path must be string literals.
+func parseFanIn(c *parser.Call_exprContext) (FanIn, error) {
+	name, args := c.GetKey().GetText(), c.AllExpr()
+	if len(args) != 2 {
+		return FanIn{}, errors.Wrapf(xerrors.ErrExpressionInvalid,
+			"function %s called with %d args, 2 expected", name, len(args))
+	}
+
+	text, ok := literal(args[0].GetText())
+	path, ok2 := literal(args[1].GetText())
+	if !ok || !ok2 || path == "" || strings.ContainsAny(path, "*+") {
+		return FanIn{}, errors.Wrapf(xerrors.ErrExpressionInvalid,
+			"function %s, selector and path must be string literals", name)
+	}
+
+	selector, err := ParseSelector(text)
+	return FanIn{Selector: selector, Text: text, Path: path}, err
+}
+
+func literal(text string) (string, bool) {
+	if len(text) < 2 || text[0] != text[len(text)-1] || (text[0] != '\'' && text[0] != '"') {
+		return "", false
+	}
+	return text[1 : len(text)-1], true
+}
+
+// FanInFuncs returns fan-in aggregations over statistics of members, nil if no members.
+func FanInFuncs(stats func(key string) *Window) map[string]tdtl.ContextFunc {
+	funcs := make(map[string]tdtl.ContextFunc, len(fanins))
+	for name := range fanins {
+		agg := aggregations[strings.TrimSuffix(name, "_of")]
+		funcs[name] = func(args ...tdtl.Node) tdtl.Node {
+			if len(args) != 2 {
+				return tdtl.UNDEFINED_RESULT
+			}
+
+			w := stats(FanIn{Text: args[0].String(), Path: args[1].String()}.Key())
+			if nil == w {
+				w = &Window{}
+			}
+			return agg(w)
+		}
+	}
+	return funcs
+}
+
+// Members are values of entities selected by a fan-in, statistics maintained incrementally.
+type Members struct {
+	Values map[string]float64 `json:"values"`
+
+	stats Window
+	// statistics recomputed if not valid, e.g. min or max removed.
+	valid bool
+}
+
+// Set value of member.
+func (m *Members) Set(id string, val float64) {
+	if nil == m.Values {
+		m.Values = make(map[string]float64)
+	}
+
+	old, has := m.Values[id]
+	m.Values[id] = val
+	switch {
+	case !m.valid:
+	case has && (old == m.stats.Min || old == m.stats.Max):
+		m.valid = false
+	case has:
+		m.stats.Sum += val - old
+		m.stats.Min = minFloat(m.stats.Min, val)
+		m.stats.Max = maxFloat(m.stats.Max, val)
+	default:
+		m.stats.observe(val)
+	}
+}
+
+// Remove member.
+func (m *Members) Remove(id string) {
+	old, has := m.Values[id]
+	if !has {
+		return
+	}
+
+	delete(m.Values, id)
+	switch {
+	case !m.valid:
+	case old == m.stats.Min || old == m.stats.Max:
+		m.valid = false
+	default:
+		m.stats.Count--
+		m.stats.Sum -= old
+	}
+}
+
+// Stats returns statistics of members.
+func (m *Members) Stats() *Window {
+	if !m.valid {
+		ids := make([]string, 0, len(m.Values))
+		for id := range m.Values {
+			ids = append(ids, id)
+		}
+		// sum in order, results of float addition reproducible.
+		sort.Strings(ids)
+
+		m.stats = Window{}
+		for _, id := range ids {
+			m.stats.observe(m.Values[id])
+		}
+		m.valid = true
+	}
+
+	stats := m.stats
+	return &stats
+}
+
+func minFloat(a, b float64) float64 {
+	if b < a {
+		return b
+	}
+	return a
+}
+
+func maxFloat(a, b float64) float64 {
+	if b > a {
+		return b
+	}
+	return a
+}
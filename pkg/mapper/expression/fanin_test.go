@@ -0,0 +1,72 @@
+package expression
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"github.com/tkeel-io/tdtl"
+)
+
+func TestFanIns(t *testing.T) {
+	fanIns, err := FanIns(`sum_of('type=device,related=site1/contains', 'properties.power') / count_of('type=device,related=site1/contains', 'properties.power') + site1.properties.base`)
+	assert.Nil(t, err)
+	assert.Equal(t, []FanIn{{
+		Selector: Selector{Type: "device", RelatedFrom: "site1", RelatedType: "contains"},
+		Text:     "type=device,related=site1/contains",
+		Path:     "properties.power",
+	}}, fanIns)
+	assert.True(t, fanIns[0].Selector.Match("device", "tpl1", "admin"))
+	assert.False(t, fanIns[0].Selector.Match("gateway", "tpl1", "admin"))
+
+	for _, text := range []string{
+		`sum_of(device1.properties.selector, 'properties.power')`,
+		`sum_of('type=device', 'properties.*')`,
+		`sum_of('type=device,type=gateway', 'properties.power')`,
+		`sum_of('related=site1', 'properties.power')`,
+		`sum_of('group=g1', 'properties.power')`,
+		`sum_of('type=device')`,
+	} {
+		_, err = FanIns(text)
+		assert.ErrorIs(t, err, xerrors.ErrExpressionInvalid, text)
+		assert.ErrorIs(t, CheckCalls(text, nil), xerrors.ErrExpressionInvalid, text)
+	}
+	assert.True(t, IsBuiltin("avg_of"))
+}
+
+func TestMembers(t *testing.T) {
+	var m Members
+	m.Set("device1", 10)
+	m.Set("device2", 20)
+	m.Set("device3", 30)
+	assert.Equal(t, &Window{Count: 3, Sum: 60, Min: 10, Max: 30}, m.Stats())
+
+	// updated incrementally.
+	m.Set("device2", 25)
+	m.Remove("device4")
+	assert.Equal(t, &Window{Count: 3, Sum: 65, Min: 10, Max: 30}, m.Stats())
+
+	// extremes removed, recomputed.
+	m.Remove("device3")
+	m.Set("device1", 40)
+	assert.Equal(t, &Window{Count: 2, Sum: 65, Min: 25, Max: 40}, m.Stats())
+
+	funcs := FanInFuncs(func(key string) *Window {
+		if key == "type=device#properties.power" {
+			return m.Stats()
+		}
+		return nil
+	})
+	expr, err := NewExpr(`avg_of('type=device', 'properties.power') + count_of('type=gateway', 'properties.power')`, funcs)
+	assert.Nil(t, err)
+	ret, err := expr.Eval(context.Background(), map[string]tdtl.Node{})
+	assert.Nil(t, err)
+	assert.Equal(t, "32.500000", ret.String())
+
+	// undefined evaluated without members.
+	expr, err = NewExpr(`sum_of('type=device', 'properties.power')`, nil)
+	assert.Nil(t, err)
+	ret, _ = expr.Eval(context.Background(), map[string]tdtl.Node{})
+	assert.Equal(t, tdtl.Undefined, ret.Type())
+}
@@ -0,0 +1,236 @@
+package expression
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+	"github.com/pkg/errors"
+	"github.com/tkeel-io/tdtl"
+	"github.com/tkeel-io/tdtl/parser"
+)
+
+const (
+	// MaxWindowSamples bound samples kept in a sliding window, the oldest dropped.
+	MaxWindowSamples = 4096
+
+	modeSliding  = "sliding"
+	modeTumbling = "tumbling"
+)
+
+// aggregations over windows, called as agg(value, window[, mode]),
+// window is a duration like '5m' or count of samples, mode is sliding by default or tumbling.
+var aggregations = map[string]func(*Window) tdtl.Node{
+	"avg": func(w *Window) tdtl.Node {
+		if w.Count == 0 {
+			return tdtl.UNDEFINED_RESULT
+		}
+		return tdtl.FloatNode(w.Sum / float64(w.Count))
+	},
+	"min": func(w *Window) tdtl.Node {
+		if w.Count == 0 {
+			return tdtl.UNDEFINED_RESULT
+		}
+		return tdtl.FloatNode(w.Min)
+	},
+	"max": func(w *Window) tdtl.Node {
+		if w.Count == 0 {
+			return tdtl.UNDEFINED_RESULT
+		}
+		return tdtl.FloatNode(w.Max)
+	},
+	"sum": func(w *Window) tdtl.Node {
+		return tdtl.FloatNode(w.Sum)
+	},
+	"count": func(w *Window) tdtl.Node {
+		return tdtl.IntNode(w.Count)
+	},
+}
+
+// Sample is a value sampled at unix milliseconds.
+type Sample struct {
+	TS    int64   `json:"ts"`
+	Value float64 `json:"v"`
+}
+
+// Window is the state of an aggregation call, samples of sliding window,
+// or start of the current bucket of tumbling window. Statistics are of samples in the window.
+type Window struct {
+	Samples []Sample `json:"samples,omitempty"`
+	Start   int64    `json:"start,omitempty"`
+	Count   int64    `json:"count"`
+	Sum     float64  `json:"sum"`
+	Min     float64  `json:"min"`
+	Max     float64  `json:"max"`
+}
+
+// Windows are states of aggregation calls of an expression, in the order of evaluation.
+type Windows []*Window
+
+// windowSpec is the window of an aggregation call.
+type windowSpec struct {
+	duration time.Duration
+	samples  int
+	tumbling bool
+}
+
+func parseWindowSpec(args []tdtl.Node) (windowSpec, bool) {
+	var spec windowSpec
+	if len(args) > 2 {
+		switch mode, _ := toString(args[2]); mode {
+		case modeTumbling:
+			spec.tumbling = true
+		case modeSliding:
+		default:
+			return spec, false
+		}
+	}
+
+	if args[1].Type() == tdtl.String {
+		if d, err := time.ParseDuration(args[1].String()); nil == err {
+			spec.duration = d
+			return spec, d > 0
+		}
+	}
+
+	// tumbling window bounded by duration.
+	samples, ok := toInt(args[1])
+	if !ok || samples <= 0 || spec.tumbling {
+		return spec, false
+	}
+	spec.samples = int(math.Min(float64(samples), MaxWindowSamples))
+	return spec, true
+}
+
+// add sample into the window, samples out of the window dropped.
+func (w *Window) add(spec windowSpec, value tdtl.Node, now time.Time) {
+	ts := now.UnixMilli()
+	val, ok := toFloat(value)
+	if spec.tumbling {
+		start := ts - ts%spec.duration.Milliseconds()
+		if start != w.Start {
+			*w = Window{Start: start}
+		}
+		if ok {
+			w.observe(val)
+		}
+		return
+	}
+
+	w.Start = 0
+	if ok {
+		w.Samples = append(w.Samples, Sample{TS: ts, Value: val})
+	}
+
+	var index int
+	switch {
+	case spec.duration > 0:
+		for index < len(w.Samples) && w.Samples[index].TS <= ts-spec.duration.Milliseconds() {
+			index++
+		}
+	case len(w.Samples) > spec.samples:
+		index = len(w.Samples) - spec.samples
+	}
+	if len(w.Samples)-index > MaxWindowSamples {
+		index = len(w.Samples) - MaxWindowSamples
+	}
+	w.Samples = w.Samples[index:]
+
+	w.Count, w.Sum, w.Min, w.Max = 0, 0, 0, 0
+	for _, sample := range w.Samples {
+		w.observe(sample.Value)
+	}
+}
+
+func (w *Window) observe(val float64) {
+	if w.Count == 0 || val < w.Min {
+		w.Min = val
+	}
+	if w.Count == 0 || val > w.Max {
+		w.Max = val
+	}
+	w.Count++
+	w.Sum += val
+}
+
+// aggregate returns the aggregation over the window after the value sampled.
+func aggregate(name string, w *Window, now time.Time, args ...tdtl.Node) tdtl.Node {
+	spec, ok := parseWindowSpec(args)
+	if !ok {
+		return tdtl.UNDEFINED_RESULT
+	}
+
+	w.add(spec, args[0], now)
+	return aggregations[name](w)
+}
+
+// windowFunc aggregate over the value only, expressions evaluated without windows kept.
+func windowFunc(name string) tdtl.ContextFunc {
+	return func(args ...tdtl.Node) tdtl.Node {
+		return aggregate(name, &Window{}, time.Now(), args...)
+	}
+}
+
+// WindowExpr is an expression calling aggregations, windows updated by each evaluation.
+type WindowExpr struct {
+	Expr
+	calls   int
+	now     time.Time
+	windows Windows
+}
+
+// NewWindowExpr returns expression aggregating over windows, windows of previous evaluations continued.
+func NewWindowExpr(expression string, extFuncs map[string]tdtl.ContextFunc, windows Windows) (*WindowExpr, error) {
+	expr := &WindowExpr{windows: windows}
+	funcs := Funcs(extFuncs)
+	for name := range aggregations {
+		name, b := name, builtins[name]
+		funcs[name] = func(args ...tdtl.Node) tdtl.Node {
+			index := expr.calls
+			expr.calls++
+			for len(expr.windows) <= index {
+				expr.windows = append(expr.windows, &Window{})
+			}
+			if len(args) < b.min || len(args) > b.max {
+				return tdtl.UNDEFINED_RESULT
+			}
+			return aggregate(name, expr.windows[index], expr.now, args...)
+		}
+	}
+
+	exprIns, err := tdtl.NewExpr(expression, funcs)
+	expr.exprIns = exprIns
+	return expr, errors.Wrap(err, "new expression evaler")
+}
+
+func (e *WindowExpr) Eval(ctx context.Context, in map[string]tdtl.Node) (tdtl.Node, error) {
+	return e.EvalAt(ctx, in, time.Now())
+}
+
+// EvalAt evaluates the expression with values sampled at now.
+func (e *WindowExpr) EvalAt(ctx context.Context, in map[string]tdtl.Node, now time.Time) (tdtl.Node, error) {
+	e.calls, e.now = 0, now
+	return e.Expr.Eval(ctx, in)
+}
+
+// Windows returns windows updated by evaluations.
+func (e *WindowExpr) Windows() Windows {
+	return e.windows
+}
+
+// Windowed reports whether the expression calls aggregations over windows.
+func Windowed(expression string) bool {
+	lexer := parser.NewTDTLLexer(antlr.NewInputStream(expression))
+	p := parser.NewTDTLParser(antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel))
+	p.RemoveErrorListeners()
+
+	var listener callListener
+	antlr.ParseTreeWalkerDefault.Walk(&listener, p.Field_elem())
+	for _, c := range listener.calls {
+		if _, has := aggregations[c.GetKey().GetText()]; has {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,58 @@
+package expression
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tkeel-io/tdtl"
+)
+
+func TestWindowExpr(t *testing.T) {
+	base := time.UnixMilli(1650000000000)
+	eval := func(expr *WindowExpr, power float64, offset time.Duration) string {
+		ret, err := expr.EvalAt(context.Background(),
+			map[string]tdtl.Node{"site1.power": tdtl.FloatNode(power)}, base.Add(offset))
+		assert.Nil(t, err)
+		return ret.String()
+	}
+
+	// sliding over duration.
+	expr, err := NewWindowExpr(`avg(site1.power, '5m')`, nil, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "10.000000", eval(expr, 10, 0))
+	assert.Equal(t, "15.000000", eval(expr, 20, time.Minute))
+	assert.Equal(t, "25.000000", eval(expr, 30, 5*time.Minute))
+	assert.Len(t, expr.Windows()[0].Samples, 2)
+
+	// windows continued, calls kept in order.
+	expr, err = NewWindowExpr(`max(site1.power, '5m') - min(site1.power, 2)`, nil, expr.Windows())
+	assert.Nil(t, err)
+	assert.Equal(t, "0.000000", eval(expr, 40, 6*time.Minute))
+	assert.Len(t, expr.Windows(), 2)
+	assert.Len(t, expr.Windows()[1].Samples, 1)
+	assert.Equal(t, "10.000000", eval(expr, 50, 7*time.Minute))
+
+	// tumbling buckets.
+	expr, err = NewWindowExpr(`sum(site1.power, '1h', 'tumbling') + count(site1.power, '1h', 'tumbling')`, nil, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "11.000000", eval(expr, 10, 0))
+	assert.Equal(t, "32.000000", eval(expr, 20, time.Minute))
+	assert.Equal(t, "6.000000", eval(expr, 5, time.Hour))
+
+	// undefined without samples, invalid windows.
+	expr, err = NewWindowExpr(`avg(site1.temp, 10)`, nil, nil)
+	assert.Nil(t, err)
+	ret, _ := expr.EvalAt(context.Background(), map[string]tdtl.Node{}, base)
+	assert.Equal(t, tdtl.Undefined, ret.Type())
+	for _, text := range []string{`avg(site1.power, 0)`, `avg(site1.power, 10, 'tumbling')`, `avg(site1.power, '5m', 'hopping')`} {
+		expr, err = NewWindowExpr(text, nil, nil)
+		assert.Nil(t, err)
+		ret, _ = expr.EvalAt(context.Background(), map[string]tdtl.Node{"site1.power": tdtl.FloatNode(10)}, base)
+		assert.Equal(t, tdtl.Undefined, ret.Type(), text)
+	}
+
+	assert.True(t, Windowed(`if(avg(site1.power, '5m') > 10, 1, 0)`))
+	assert.False(t, Windowed(`abs(site1.power)`))
+}
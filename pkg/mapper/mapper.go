@@ -18,6 +18,8 @@ package mapper
 
 import (
 	"github.com/pkg/errors"
+	"github.com/tkeel-io/core/pkg/function"
+	"github.com/tkeel-io/core/pkg/mapper/expression"
 	"github.com/tkeel-io/tdtl"
 )
 
@@ -38,7 +40,7 @@ type mapper struct {
 }
 
 func NewMapper(mp Mapper, version int64) (IMapper, error) {
-	tqlInst, err := tdtl.NewTDTL(mp.TQL, nil)
+	tqlInst, err := tdtl.NewTDTL(mp.TQL, expression.Funcs(function.Funcs()))
 	if nil != err {
 		return nil, errors.Wrap(err, "construct mapper")
 	}
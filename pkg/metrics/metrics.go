@@ -12,12 +12,26 @@ const (
 	MetricsLabelTelemetryID = "telemetry_id"
 	MetricsLabelMsgType     = "msg_type"
 	MetricsLabelSpaceType   = "space_type"
+	MetricsLabelRuntime     = "runtime_id"
+	MetricsLabelCache       = "cache"
+	MetricsLabelResult      = "result"
+	MetricsLabelPath        = "path"
+	MetricsLabelReason      = "reason"
 
 	// msg type.
 	MsgTypeSubscribe  = "subscribe"
 	MsgTypeRawData    = "rawdata"
 	MsgTypeTimeseries = "timeseries"
 
+	// cache type.
+	CacheTypeEntity = "entity"
+	CacheTypeRemote = "remote"
+
+	// cache result.
+	CacheResultHit   = "hit"
+	CacheResultMiss  = "miss"
+	CacheResultEvict = "evict"
+
 	// space type.
 	SpaceTypeTotal = "total"
 	SpaceTypeUsed  = "used"
@@ -39,6 +53,24 @@ const (
 
 	// metrics device telemetry.
 	EntityTelemetry = "entity_telemetry"
+
+	// metrics runtime entity cache.
+	MetricsEntityCache = "core_entity_cache_total"
+
+	// metrics runtime entity cache entries.
+	MetricsEntityCacheEntries = "core_entity_cache_entries"
+
+	// metrics runtime queued messages.
+	MetricsRuntimeQueueDepth = "core_runtime_queue_depth"
+
+	// metrics runtime entity mailboxes.
+	MetricsRuntimeMailboxes = "core_runtime_mailboxes"
+
+	// metrics entity keys pruned by retention.
+	MetricsRetentionPruned = "core_entity_retention_pruned_total"
+
+	// metrics computed events dropped while exceeding max ttl.
+	MetricsComputedDropped = "core_runtime_computed_dropped_total"
 )
 
 var CollectorMsgCount = prometheus.NewCounterVec(
@@ -89,6 +121,54 @@ var CollectorTelemetry = prometheus.NewGaugeVec(
 	[]string{MetricsLabelTenant, MetricsLabelSchema, MetricsLabelEntity, MetricsLabelTelemetryID},
 )
 
+var CollectorEntityCache = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: MetricsEntityCache,
+		Help: "runtime entity cache hit/miss/evict count.",
+	},
+	[]string{MetricsLabelRuntime, MetricsLabelCache, MetricsLabelResult},
+)
+
+var CollectorEntityCacheEntries = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: MetricsEntityCacheEntries,
+		Help: "runtime entity cache entries.",
+	},
+	[]string{MetricsLabelRuntime, MetricsLabelCache},
+)
+
+var CollectorRuntimeQueueDepth = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: MetricsRuntimeQueueDepth,
+		Help: "runtime messages queued in entity mailboxes.",
+	},
+	[]string{MetricsLabelRuntime},
+)
+
+var CollectorRuntimeMailboxes = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: MetricsRuntimeMailboxes,
+		Help: "runtime entity mailboxes queued or being handled.",
+	},
+	[]string{MetricsLabelRuntime},
+)
+
+var CollectorRetentionPruned = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: MetricsRetentionPruned,
+		Help: "entity telemetry and raw data keys pruned by retention.",
+	},
+	[]string{MetricsLabelPath, MetricsLabelReason},
+)
+
+var CollectorComputedDropped = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: MetricsComputedDropped,
+		Help: "runtime computed events dropped while exceeding max ttl, expressions may depend on each other cyclically.",
+	},
+	[]string{MetricsLabelRuntime, MetricsLabelEntity},
+)
+
 var Metrics = []prometheus.Collector{
 	CollectorRawDataStorage,
 	CollectorTimeseriesStorage,
@@ -96,4 +176,10 @@ var Metrics = []prometheus.Collector{
 	CollectorMsgStorageSpace,
 	CollectorMsgStorageSeconds,
 	CollectorTelemetry,
+	CollectorEntityCache,
+	CollectorEntityCacheEntries,
+	CollectorRuntimeQueueDepth,
+	CollectorRuntimeMailboxes,
+	CollectorRetentionPruned,
+	CollectorComputedDropped,
 }
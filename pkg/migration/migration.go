@@ -0,0 +1,247 @@
+package migration
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+	"sort"
+
+	jsoniter "github.com/json-iterator/go"
+	"github.com/pkg/errors"
+	pb "github.com/tkeel-io/core/api/core/v1"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	logf "github.com/tkeel-io/core/pkg/logfield"
+	"github.com/tkeel-io/core/pkg/repository"
+	"github.com/tkeel-io/kit/log"
+	"github.com/tkeel-io/tdtl"
+)
+
+const (
+	exportPageSize = 100
+	// maxRecordSize bounds bytes of a record imported.
+	maxRecordSize = 16 << 20
+)
+
+// Migrator exports entities with expressions, subscriptions and relationships of the entities
+// into NDJSON, and imports records exported. resources already exist are skipped when
+// importing, so that import can be retried.
+type Migrator struct {
+	repo     repository.IRepository
+	searcher Searcher
+}
+
+func New(repo repository.IRepository, searcher Searcher) *Migrator {
+	return &Migrator{
+		repo:     repo,
+		searcher: searcher,
+	}
+}
+
+// Export write records of entities matched by filter into w, a record per line.
+func (m *Migrator) Export(ctx context.Context, filter *Filter, w io.Writer) (*Stats, error) {
+	stats := &Stats{}
+	encoder := json.NewEncoder(w)
+	revision := m.repo.GetLastRevision(ctx)
+	owners := make(map[string]bool)
+	exported := make(map[string]bool)
+	for page := int32(1); ; page++ {
+		resp, err := m.searcher.Search(ctx, &pb.SearchRequest{
+			Owner:     filter.Owner,
+			Source:    filter.Source,
+			Query:     filter.Query,
+			Condition: filter.Condition,
+			PageNum:   page,
+			PageSize:  exportPageSize,
+		})
+		if nil != err {
+			return stats, errors.Wrap(err, "export entities, search entities")
+		}
+
+		for _, item := range resp.Items {
+			entityID := item.GetStructValue().GetFields()["id"].GetStringValue()
+			if entityID == "" || exported[entityID] {
+				continue
+			}
+
+			index, err := item.MarshalJSON()
+			if nil != err {
+				return stats, errors.Wrap(err, "export entities, encode index")
+			}
+
+			owner, found, err := m.exportEntity(ctx, encoder, revision, entityID, index, stats)
+			if nil != err {
+				return stats, errors.Wrap(err, "export entities")
+			} else if found {
+				exported[entityID] = true
+				owners[owner] = true
+			}
+		}
+
+		if len(resp.Items) < exportPageSize || int64(page)*exportPageSize >= resp.Total {
+			break
+		}
+	}
+
+	// subscriptions sourced from entities exported.
+	ownerIDs := make([]string, 0, len(owners))
+	for owner := range owners {
+		ownerIDs = append(ownerIDs, owner)
+	}
+	sort.Strings(ownerIDs)
+	for _, owner := range ownerIDs {
+		subs, err := m.repo.ListSubscription(ctx, revision, &repository.ListSubscriptionReq{Owner: owner})
+		if nil != err && !errors.Is(err, xerrors.ErrResourceNotFound) {
+			return stats, errors.Wrap(err, "export entities, list subscriptions")
+		}
+		for _, sub := range subs {
+			if !exported[sub.SourceEntityID] {
+				continue
+			}
+			if err = encoder.Encode(&Record{Kind: KindSubscription, ID: sub.ID, Owner: sub.Owner, Subscription: sub}); nil != err {
+				return stats, errors.Wrap(err, "export entities, write subscription")
+			}
+			stats.Subscriptions++
+		}
+	}
+
+	log.L().Info("export entities", logf.Owner(filter.Owner), logf.Any("stats", stats))
+	return stats, nil
+}
+
+// exportEntity write records of entity, returns owner of entity and whether entity exists.
+func (m *Migrator) exportEntity(ctx context.Context, encoder *jsoniter.Encoder,
+	revision int64, entityID string, index []byte, stats *Stats) (string, bool, error) {
+	state, err := m.repo.GetEntity(ctx, entityID)
+	if nil != err {
+		if errors.Is(err, xerrors.ErrResourceNotFound) {
+			// search index is stale.
+			log.L().Warn("export entity, entity not found", logf.Eid(entityID))
+			return "", false, nil
+		}
+		return "", false, errors.Wrapf(err, "get entity %s", entityID)
+	}
+
+	owner := tdtl.New(state).Get("owner").String()
+	if err = encoder.Encode(&Record{Kind: KindEntity, ID: entityID, Owner: owner, Entity: state, Index: index}); nil != err {
+		return "", false, errors.Wrapf(err, "write entity %s", entityID)
+	}
+	stats.Entities++
+
+	// expressions listed by prefix, entities with same prefix excluded.
+	exprs, err := m.repo.ListExpression(ctx, revision, &repository.ListExprReq{Owner: owner, EntityID: entityID})
+	if nil != err && !errors.Is(err, xerrors.ErrResourceNotFound) {
+		return "", false, errors.Wrapf(err, "list expressions of entity %s", entityID)
+	}
+	for _, expr := range exprs {
+		if expr.EntityID != entityID {
+			continue
+		}
+		if err = encoder.Encode(&Record{Kind: KindExpression, ID: expr.ID, Owner: expr.Owner, Expression: expr}); nil != err {
+			return "", false, errors.Wrapf(err, "write expression of entity %s", entityID)
+		}
+		stats.Expressions++
+	}
+
+	rels, err := m.repo.ListRelationship(ctx, revision, &repository.ListRelationshipReq{EntityID: entityID})
+	if nil != err && !errors.Is(err, xerrors.ErrResourceNotFound) {
+		return "", false, errors.Wrapf(err, "list relationships of entity %s", entityID)
+	}
+	for _, rel := range rels {
+		if err = encoder.Encode(&Record{Kind: KindRelationship, ID: entityID, Owner: rel.Owner, Relationship: rel}); nil != err {
+			return "", false, errors.Wrapf(err, "write relationship of entity %s", entityID)
+		}
+		stats.Relationships++
+	}
+
+	return owner, true, nil
+}
+
+// Import read records from r and recreate resources of records, resources already exist skipped.
+func (m *Migrator) Import(ctx context.Context, r io.Reader) (*Stats, error) {
+	stats := &Stats{}
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64<<10), maxRecordSize)
+	for line := 1; scanner.Scan(); line++ {
+		raw := bytes.TrimSpace(scanner.Bytes())
+		if len(raw) == 0 {
+			continue
+		}
+
+		var record Record
+		if err := json.Unmarshal(raw, &record); nil != err {
+			return stats, errors.Wrapf(xerrors.ErrInvalidRequest, "import entities, line %d, %s", line, err.Error())
+		} else if err = m.importRecord(ctx, &record, stats); nil != err {
+			return stats, errors.Wrapf(err, "import entities, line %d", line)
+		}
+	}
+
+	if err := scanner.Err(); nil != err {
+		return stats, errors.Wrap(err, "import entities, read records")
+	} else if err = m.repo.FlushEntity(ctx); nil != err {
+		return stats, errors.Wrap(err, "import entities, flush entities")
+	}
+
+	log.L().Info("import entities", logf.Any("stats", stats))
+	return stats, nil
+}
+
+func (m *Migrator) importRecord(ctx context.Context, record *Record, stats *Stats) error {
+	var (
+		err     error
+		has     bool
+		counter *int
+	)
+
+	switch {
+	case record.Kind == KindEntity && record.ID != "" && len(record.Entity) > 0:
+		counter = &stats.Entities
+		if has, err = m.repo.HasEntity(ctx, record.ID); nil == err && !has {
+			err = m.repo.PutEntity(ctx, record.ID, record.Entity)
+			if nil == err && len(record.Index) > 0 {
+				if _, innerErr := m.searcher.IndexBytes(ctx, record.ID, record.Index); nil != innerErr {
+					log.L().Error("import entity, index entity", logf.Eid(record.ID), logf.Error(innerErr))
+				}
+			}
+		}
+	case record.Kind == KindExpression && record.Expression != nil:
+		counter = &stats.Expressions
+		if has, err = m.repo.HasExpression(ctx, *record.Expression); nil == err && !has {
+			err = m.repo.PutExpression(ctx, *record.Expression)
+		}
+	case record.Kind == KindSubscription && record.Subscription != nil:
+		counter = &stats.Subscriptions
+		if has, err = m.repo.HasSubscription(ctx, record.Subscription); nil == err && !has {
+			err = m.repo.PutSubscription(ctx, record.Subscription)
+		}
+	case record.Kind == KindRelationship && record.Relationship != nil:
+		counter = &stats.Relationships
+		if has, err = m.hasRelationship(ctx, record.Relationship); nil == err && !has {
+			err = m.repo.PutRelationship(ctx, record.Relationship)
+		}
+	default:
+		return errors.Wrapf(xerrors.ErrInvalidRequest, "invalid record, kind %s, id %s", record.Kind, record.ID)
+	}
+
+	if nil != err {
+		return errors.Wrapf(err, "import %s %s", record.Kind, record.ID)
+	} else if has {
+		stats.Skipped++
+		return nil
+	}
+
+	*counter++
+	return nil
+}
+
+func (m *Migrator) hasRelationship(ctx context.Context, rel *repository.Relationship) (bool, error) {
+	ret, err := m.repo.GetRelationship(ctx, &repository.Relationship{From: rel.From, Type: rel.Type, To: rel.To})
+	if nil != err {
+		if errors.Is(err, xerrors.ErrResourceNotFound) {
+			return false, nil
+		}
+		return false, errors.Wrap(err, "get relationship")
+	}
+	// key of resource matched by prefix.
+	return ret.From == rel.From && ret.Type == rel.Type && ret.To == rel.To, nil
+}
@@ -0,0 +1,195 @@
+package migration
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	pb "github.com/tkeel-io/core/api/core/v1"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"github.com/tkeel-io/core/pkg/repository"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+type memRepo struct {
+	repository.IRepository
+	entities      map[string][]byte
+	expressions   map[string]*repository.Expression
+	subscriptions map[string]*repository.Subscription
+	relationships map[string]*repository.Relationship
+}
+
+func newMemRepo() *memRepo {
+	return &memRepo{
+		entities:      make(map[string][]byte),
+		expressions:   make(map[string]*repository.Expression),
+		subscriptions: make(map[string]*repository.Subscription),
+		relationships: make(map[string]*repository.Relationship),
+	}
+}
+
+func (r *memRepo) GetLastRevision(context.Context) int64 { return 1 }
+
+func (r *memRepo) FlushEntity(context.Context) error { return nil }
+
+func (r *memRepo) PutEntity(_ context.Context, eid string, data []byte) error {
+	r.entities[eid] = data
+	return nil
+}
+
+func (r *memRepo) GetEntity(_ context.Context, eid string) ([]byte, error) {
+	if data, ok := r.entities[eid]; ok {
+		return data, nil
+	}
+	return nil, xerrors.ErrResourceNotFound
+}
+
+func (r *memRepo) HasEntity(_ context.Context, eid string) (bool, error) {
+	_, ok := r.entities[eid]
+	return ok, nil
+}
+
+func (r *memRepo) PutExpression(_ context.Context, expr repository.Expression) error {
+	key, _ := expr.EncodeKey()
+	r.expressions[string(key)] = &expr
+	return nil
+}
+
+func (r *memRepo) HasExpression(_ context.Context, expr repository.Expression) (bool, error) {
+	key, _ := expr.EncodeKey()
+	_, ok := r.expressions[string(key)]
+	return ok, nil
+}
+
+func (r *memRepo) ListExpression(_ context.Context, _ int64, req *repository.ListExprReq) ([]*repository.Expression, error) {
+	var exprs []*repository.Expression
+	prefix := repository.ListExpressionPrefix(req.Owner, req.EntityID)
+	for key, expr := range r.expressions {
+		if strings.HasPrefix(key, prefix) {
+			exprs = append(exprs, expr)
+		}
+	}
+	return exprs, nil
+}
+
+func (r *memRepo) PutSubscription(_ context.Context, sub *repository.Subscription) error {
+	key, _ := sub.EncodeKey()
+	r.subscriptions[string(key)] = sub
+	return nil
+}
+
+func (r *memRepo) HasSubscription(_ context.Context, sub *repository.Subscription) (bool, error) {
+	key, _ := sub.EncodeKey()
+	_, ok := r.subscriptions[string(key)]
+	return ok, nil
+}
+
+func (r *memRepo) ListSubscription(_ context.Context, _ int64, req *repository.ListSubscriptionReq) ([]*repository.Subscription, error) {
+	var subs []*repository.Subscription
+	prefix := repository.ListSubscriptionPrefix(req.Owner, req.EntityID)
+	for key, sub := range r.subscriptions {
+		if strings.HasPrefix(key, prefix) {
+			subs = append(subs, sub)
+		}
+	}
+	return subs, nil
+}
+
+func (r *memRepo) PutRelationship(_ context.Context, rel *repository.Relationship) error {
+	key, _ := rel.EncodeKey()
+	r.relationships[string(key)] = rel
+	return nil
+}
+
+func (r *memRepo) GetRelationship(_ context.Context, rel *repository.Relationship) (*repository.Relationship, error) {
+	key, _ := rel.EncodeKey()
+	if ret, ok := r.relationships[string(key)]; ok {
+		return ret, nil
+	}
+	return nil, xerrors.ErrResourceNotFound
+}
+
+func (r *memRepo) ListRelationship(_ context.Context, _ int64, req *repository.ListRelationshipReq) ([]*repository.Relationship, error) {
+	var rels []*repository.Relationship
+	prefix := repository.ListRelationshipPrefix(req.EntityID, req.Type, req.Reverse)
+	for key, rel := range r.relationships {
+		if strings.HasPrefix(key, prefix) {
+			rels = append(rels, rel)
+		}
+	}
+	return rels, nil
+}
+
+// memSearcher returns entities of repo whose owner matched, a page contains an entity.
+type memSearcher struct {
+	repo    *memRepo
+	ids     []string
+	indexed map[string][]byte
+}
+
+func (s *memSearcher) Search(_ context.Context, req *pb.SearchRequest) (*pb.SearchResponse, error) {
+	out := &pb.SearchResponse{Total: int64(len(s.ids))}
+	if int(req.PageNum) <= len(s.ids) {
+		id := s.ids[req.PageNum-1]
+		item, _ := structpb.NewValue(map[string]interface{}{"id": id, "owner": req.Owner})
+		out.Items = append(out.Items, item)
+	}
+	return out, nil
+}
+
+func (s *memSearcher) IndexBytes(_ context.Context, id string, jsonData []byte) (*pb.IndexResponse, error) {
+	s.indexed[id] = jsonData
+	return &pb.IndexResponse{Status: "SUCCESS"}, nil
+}
+
+func TestMigrator_ExportImport(t *testing.T) {
+	ctx := context.Background()
+	source := newMemRepo()
+	source.PutEntity(ctx, "device1", []byte(`{"id":"device1","owner":"admin","scheme":{"temp":{"type":"int"}},"properties":{"temp":20}}`))
+	source.PutEntity(ctx, "device12", []byte(`{"id":"device12","owner":"admin","properties":{}}`))
+	source.PutExpression(ctx, *repository.NewExpression("admin", "device1", "avg", "avg", "device2.temp", ""))
+	source.PutExpression(ctx, *repository.NewExpression("admin", "device12", "max", "max", "device2.temp", ""))
+	source.PutSubscription(ctx, &repository.Subscription{ID: "sub-1", Owner: "admin", SourceEntityID: "device1"})
+	source.PutSubscription(ctx, &repository.Subscription{ID: "sub-2", Owner: "admin", SourceEntityID: "device3"})
+	source.PutRelationship(ctx, &repository.Relationship{Type: "contains", From: "device1", To: "device12", Owner: "admin"})
+
+	// device1 exported only, expressions of device12 share prefix with device1.
+	var buf bytes.Buffer
+	stats, err := New(source, &memSearcher{repo: source, ids: []string{"device1", "device404"}}).
+		Export(ctx, &Filter{Owner: "admin"}, &buf)
+	assert.Nil(t, err)
+	assert.Equal(t, &Stats{Entities: 1, Expressions: 1, Subscriptions: 1, Relationships: 1}, stats)
+	assert.Equal(t, 4, strings.Count(buf.String(), "\n"))
+
+	target := newMemRepo()
+	searcher := &memSearcher{repo: target, indexed: make(map[string][]byte)}
+	migrator := New(target, searcher)
+	stats, err = migrator.Import(ctx, bytes.NewReader(buf.Bytes()))
+	assert.Nil(t, err)
+	assert.Equal(t, &Stats{Entities: 1, Expressions: 1, Subscriptions: 1, Relationships: 1}, stats)
+	assert.Equal(t, source.entities["device1"], target.entities["device1"])
+	assert.JSONEq(t, `{"id":"device1","owner":"admin"}`, string(searcher.indexed["device1"]))
+	assert.Len(t, target.expressions, 1)
+	assert.Len(t, target.relationships, 1)
+
+	// import idempotently.
+	target.PutEntity(ctx, "device1", []byte(`{"id":"device1","owner":"admin","properties":{"temp":30}}`))
+	stats, err = migrator.Import(ctx, bytes.NewReader(buf.Bytes()))
+	assert.Nil(t, err)
+	assert.Equal(t, &Stats{Skipped: 4}, stats)
+	assert.JSONEq(t, `{"id":"device1","owner":"admin","properties":{"temp":30}}`, string(target.entities["device1"]))
+}
+
+func TestMigrator_ImportInvalid(t *testing.T) {
+	migrator := New(newMemRepo(), &memSearcher{indexed: make(map[string][]byte)})
+	stats, err := migrator.Import(context.Background(), strings.NewReader(
+		`{"kind":"entity","id":"device1","entity":{"id":"device1"}}`+"\n\n"+`{"kind":"unknown"}`))
+	assert.ErrorIs(t, err, xerrors.ErrInvalidRequest)
+	assert.Contains(t, err.Error(), "line 3")
+	assert.Equal(t, 1, stats.Entities)
+
+	_, err = migrator.Import(context.Background(), strings.NewReader(`{"kind":`))
+	assert.ErrorIs(t, err, xerrors.ErrInvalidRequest)
+}
@@ -0,0 +1,53 @@
+package migration
+
+import (
+	"context"
+
+	jsoniter "github.com/json-iterator/go"
+	pb "github.com/tkeel-io/core/api/core/v1"
+	"github.com/tkeel-io/core/pkg/repository"
+)
+
+const (
+	KindEntity       = "entity"
+	KindExpression   = "expression"
+	KindSubscription = "subscription"
+	KindRelationship = "relationship"
+)
+
+// Record is a line of NDJSON exported, one resource of entity per record.
+type Record struct {
+	Kind  string `json:"kind"`
+	ID    string `json:"id"`
+	Owner string `json:"owner,omitempty"`
+	// Entity is the state of entity, including scheme of entity.
+	Entity jsoniter.RawMessage `json:"entity,omitempty"`
+	// Index is the search document of entity.
+	Index        jsoniter.RawMessage      `json:"index,omitempty"`
+	Expression   *repository.Expression   `json:"expression,omitempty"`
+	Subscription *repository.Subscription `json:"subscription,omitempty"`
+	Relationship *repository.Relationship `json:"relationship,omitempty"`
+}
+
+// Filter selects entities exported, entities of owner matched by query and conditions.
+type Filter struct {
+	Owner     string
+	Source    string
+	Query     string
+	Condition []*pb.SearchCondition
+}
+
+// Stats counts records exported or imported by kind, records already exist are skipped.
+type Stats struct {
+	Entities      int `json:"entities"`
+	Expressions   int `json:"expressions"`
+	Subscriptions int `json:"subscriptions"`
+	Relationships int `json:"relationships"`
+	Skipped       int `json:"skipped"`
+}
+
+// Searcher searches entities exported and indexes entities imported, implemented by search.Service.
+type Searcher interface {
+	Search(context.Context, *pb.SearchRequest) (*pb.SearchResponse, error)
+	IndexBytes(ctx context.Context, id string, jsonData []byte) (*pb.IndexResponse, error)
+}
@@ -1,56 +1,156 @@
 package placement
 
 import (
+	"hash/crc32"
 	"sort"
+	"strconv"
 	"sync"
-
-	"github.com/tkeel-io/core/pkg/util"
 )
 
+// defaultReplicas is the number of virtual nodes mounted on the hash ring for each queue.
+const defaultReplicas = 160
+
 var globalPlacement *placement
 
+// placement implements consistent hashing with virtual nodes, so that appending or
+// removing a queue only remaps the keys which belong to the changed queue.
 type placement struct {
-	lock      sync.RWMutex
-	queues    map[string]Info
-	hashTable sort.StringSlice
+	lock     sync.RWMutex
+	replicas int
+	queues   map[string]Info
+	ring     []uint32
+	owners   map[uint32]string
+	handlers []ChangedHandler
 }
 
 func New() Placement {
-	return &placement{}
+	return newPlacement(defaultReplicas)
+}
+
+func newPlacement(replicas int) *placement {
+	if replicas <= 0 {
+		replicas = defaultReplicas
+	}
+
+	return &placement{
+		lock:     sync.RWMutex{},
+		replicas: replicas,
+		queues:   make(map[string]Info),
+		ring:     []uint32{},
+		owners:   make(map[uint32]string),
+	}
 }
 
 func (p *placement) Append(info Info) {
 	p.lock.Lock()
+	_, exists := p.queues[info.ID]
 	p.queues[info.ID] = info
-	p.hashTable = append(p.hashTable, info.ID)
-	sort.Sort(p.hashTable)
-	p.hashTable = util.Unique(p.hashTable)
+	if !exists {
+		p.mount(info.ID)
+	}
+	handlers := p.handlers
 	p.lock.Unlock()
+
+	if !exists {
+		p.notify(handlers, Event{Type: EventAppend, Info: info})
+	}
 }
 
 func (p *placement) Remove(info Info) {
 	p.lock.Lock()
+	_, exists := p.queues[info.ID]
 	delete(p.queues, info.ID)
-	index := p.hashTable.Search(info.ID)
-	if p.hashTable.Len() > index && info.ID == p.hashTable[index] {
-		p.hashTable = append(p.hashTable[:index], p.hashTable[index+1:]...)
+	if exists {
+		p.unmount(info.ID)
 	}
+	handlers := p.handlers
 	p.lock.Unlock()
+
+	if exists {
+		p.notify(handlers, Event{Type: EventRemove, Info: info})
+	}
 }
 
 func (p *placement) Select(key string) Info {
-	hashKey := util.Hash32(key)
+	hashKey := hash(key)
 	p.lock.RLock()
-	selectIndex := hashKey % uint32(p.hashTable.Len())
-	info := p.queues[p.hashTable[selectIndex]]
-	p.lock.RUnlock()
-	return info
+	defer p.lock.RUnlock()
+	if len(p.ring) == 0 {
+		return Info{}
+	}
+
+	// find the first virtual node clockwise.
+	index := sort.Search(len(p.ring), func(i int) bool {
+		return p.ring[i] >= hashKey
+	})
+	if index == len(p.ring) {
+		index = 0
+	}
+
+	return p.queues[p.owners[p.ring[index]]]
 }
 
-func Initialize() {
-	globalPlacement = &placement{
-		lock:      sync.RWMutex{},
-		queues:    make(map[string]Info),
-		hashTable: sort.StringSlice{},
+func (p *placement) List() []Info {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+	infos := make([]Info, 0, len(p.queues))
+	for _, info := range p.queues {
+		infos = append(infos, info)
 	}
+
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].ID < infos[j].ID
+	})
+	return infos
+}
+
+func (p *placement) OnChanged(handler ChangedHandler) {
+	p.lock.Lock()
+	p.handlers = append(p.handlers, handler)
+	p.lock.Unlock()
+}
+
+func (p *placement) notify(handlers []ChangedHandler, ev Event) {
+	for _, handler := range handlers {
+		handler(ev)
+	}
+}
+
+func (p *placement) mount(id string) {
+	for index := 0; index < p.replicas; index++ {
+		vnode := hash(virtualKey(id, index))
+		// skip hash collision, the first owner wins.
+		if _, has := p.owners[vnode]; has {
+			continue
+		}
+		p.owners[vnode] = id
+		p.ring = append(p.ring, vnode)
+	}
+	sort.Slice(p.ring, func(i, j int) bool {
+		return p.ring[i] < p.ring[j]
+	})
+}
+
+func (p *placement) unmount(id string) {
+	ring := p.ring[:0]
+	for _, vnode := range p.ring {
+		if p.owners[vnode] == id {
+			delete(p.owners, vnode)
+			continue
+		}
+		ring = append(ring, vnode)
+	}
+	p.ring = ring
+}
+
+func virtualKey(id string, index int) string {
+	return id + "#" + strconv.Itoa(index)
+}
+
+func hash(key string) uint32 {
+	return crc32.ChecksumIEEE([]byte(key))
+}
+
+func Initialize() {
+	globalPlacement = newPlacement(defaultReplicas)
 }
@@ -0,0 +1,82 @@
+package placement
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlacement_Select(t *testing.T) {
+	p := newPlacement(defaultReplicas)
+	assert.Equal(t, Info{}, p.Select("device123"))
+
+	p.Append(Info{ID: "core-0", Flag: true})
+	p.Append(Info{ID: "core-1", Flag: true})
+	p.Append(Info{ID: "core-2", Flag: true})
+
+	// select is stable.
+	for index := 0; index < 100; index++ {
+		key := fmt.Sprintf("device-%d", index)
+		assert.Equal(t, p.Select(key), p.Select(key))
+	}
+
+	// every queue owns keys.
+	counts := map[string]int{}
+	for index := 0; index < 3000; index++ {
+		counts[p.Select(fmt.Sprintf("device-%d", index)).ID]++
+	}
+	assert.Len(t, counts, 3)
+}
+
+func TestPlacement_Rebalance(t *testing.T) {
+	p := newPlacement(defaultReplicas)
+	p.Append(Info{ID: "core-0"})
+	p.Append(Info{ID: "core-1"})
+	p.Append(Info{ID: "core-2"})
+
+	total := 10000
+	before := make(map[string]string, total)
+	for index := 0; index < total; index++ {
+		key := fmt.Sprintf("iotd-%d", index)
+		before[key] = p.Select(key).ID
+	}
+
+	p.Append(Info{ID: "core-3"})
+	moved := 0
+	for key, owner := range before {
+		curr := p.Select(key).ID
+		if curr != owner {
+			moved++
+			// keys only move to the appended queue.
+			assert.Equal(t, "core-3", curr)
+		}
+	}
+	// ideally 1/4 of the keys move, never almost all of them.
+	assert.Less(t, moved, total/2)
+
+	p.Remove(Info{ID: "core-3"})
+	for key, owner := range before {
+		assert.Equal(t, owner, p.Select(key).ID)
+	}
+}
+
+func TestPlacement_OnChanged(t *testing.T) {
+	p := newPlacement(defaultReplicas)
+	events := []Event{}
+	p.OnChanged(func(ev Event) {
+		events = append(events, ev)
+	})
+
+	p.Append(Info{ID: "core-0"})
+	// append existed queue only update info.
+	p.Append(Info{ID: "core-0", Flag: true})
+	p.Remove(Info{ID: "core-0"})
+	p.Remove(Info{ID: "core-0"})
+
+	assert.Equal(t, []Event{
+		{Type: EventAppend, Info: Info{ID: "core-0"}},
+		{Type: EventRemove, Info: Info{ID: "core-0"}},
+	}, events)
+	assert.Len(t, p.List(), 0)
+}
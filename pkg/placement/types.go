@@ -5,10 +5,28 @@ type Info struct {
 	Flag bool
 }
 
+type EventType string
+
+const (
+	EventAppend EventType = "append"
+	EventRemove EventType = "remove"
+)
+
+// Event describe a change of the placement.
+type Event struct {
+	Type EventType
+	Info Info
+}
+
+// ChangedHandler called after queue appended into or removed from placement.
+type ChangedHandler func(Event)
+
 type Placement interface {
 	Select(string) Info
 	Append(Info)
 	Remove(Info)
+	List() []Info
+	OnChanged(ChangedHandler)
 }
 
 func Global() Placement {
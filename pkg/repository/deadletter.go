@@ -0,0 +1,348 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	logf "github.com/tkeel-io/core/pkg/logfield"
+	"github.com/tkeel-io/core/pkg/repository/dao"
+	"github.com/tkeel-io/kit/log"
+)
+
+const (
+	DeadLetterStorePrefix = "CORE.DEADLETTER"
+
+	// MaxEntityDeadLetters dead letters retained of each entity, the oldest removed if exceeded.
+	MaxEntityDeadLetters = 100
+	// MaxDeadLetterEntities entities retaining dead letters, dead letters of the entity
+	// least recently failed removed if exceeded.
+	MaxDeadLetterEntities = 1000
+	// MaxDeadLetterEventBytes original event larger is not retained, the dead letter cannot be replayed.
+	MaxDeadLetterEventBytes = 64 * 1024
+	// DeadLetterTTL dead letters older are removed.
+	DeadLetterTTL = 7 * 24 * time.Hour
+)
+
+type ListDeadLetterReq struct {
+	EntityID string
+}
+
+var _ dao.Resource = (*DeadLetter)(nil)
+
+// DeadLetter is an event failed in runtime.
+type DeadLetter struct {
+	// dead letter identifier.
+	ID string `json:"id"`
+	// runtime which the event failed in.
+	RuntimeID string `json:"runtime_id"`
+	EntityID  string `json:"entity_id"`
+	EventID   string `json:"event_id"`
+	// handler which the event failed in.
+	Handler string `json:"handler"`
+	Error   string `json:"error"`
+	// kafka message offset, -1 if event not consumed from kafka.
+	Partition int32 `json:"partition"`
+	Offset    int64 `json:"offset"`
+	Timestamp int64 `json:"timestamp"`
+	// original event, encoded, empty if exceeds MaxDeadLetterEventBytes.
+	Event []byte `json:"event,omitempty"`
+}
+
+func (d *DeadLetter) EncodeKey() ([]byte, error) {
+	if d.ID == "" {
+		return nil, errors.Errorf("DeadLetter ID is empty")
+	}
+
+	return []byte(DeadLetterStorePrefix + "." + d.ID), nil
+}
+
+func (d *DeadLetter) Encode() ([]byte, error) {
+	bytes, err := json.Marshal(d)
+	return bytes, errors.Wrap(err, "encode DeadLetter")
+}
+
+func (d *DeadLetter) Decode(key, bytes []byte) error {
+	if bytes != nil {
+		err := json.Unmarshal(bytes, d)
+		return errors.Wrap(err, "decode DeadLetter")
+	}
+
+	// CORE.DEADLETTER.dl-1234
+	if !strings.HasPrefix(string(key), DeadLetterStorePrefix+".") {
+		return errors.Errorf("error:decode DeadLetter from key[%s]", string(key))
+	}
+	d.ID = strings.TrimPrefix(string(key), DeadLetterStorePrefix+".")
+	return nil
+}
+
+func (d *DeadLetter) expired(now time.Time) bool {
+	return d.Timestamp < now.Add(-DeadLetterTTL).UnixMilli()
+}
+
+// deadLetterIndex is dead letters of an entity without events, ordered by timestamp.
+type deadLetterIndex struct {
+	entityID string
+	letters  []*DeadLetter
+}
+
+func (i *deadLetterIndex) EncodeKey() ([]byte, error) {
+	return []byte(DeadLetterStorePrefix + ".INDEX." + i.entityID), nil
+}
+
+func (i *deadLetterIndex) Encode() ([]byte, error) {
+	bytes, err := json.Marshal(i.letters)
+	return bytes, errors.Wrap(err, "encode dead letter index")
+}
+
+func (i *deadLetterIndex) Decode(key, bytes []byte) error {
+	return errors.Wrap(json.Unmarshal(bytes, &i.letters), "decode dead letter index")
+}
+
+// prune remove dead letters expired or exceeding MaxEntityDeadLetters, returns dead letters removed.
+func (i *deadLetterIndex) prune(now time.Time) []*DeadLetter {
+	var removed, retained []*DeadLetter
+	for _, letter := range i.letters {
+		if letter.expired(now) {
+			removed = append(removed, letter)
+			continue
+		}
+		retained = append(retained, letter)
+	}
+
+	if len(retained) > MaxEntityDeadLetters {
+		removed = append(removed, retained[:len(retained)-MaxEntityDeadLetters]...)
+		retained = retained[len(retained)-MaxEntityDeadLetters:]
+	}
+	i.letters = retained
+	return removed
+}
+
+// deadLetterEntities is the entities retaining dead letters, keyed by entity id,
+// valued by timestamp of the latest dead letter.
+type deadLetterEntities struct {
+	entities map[string]int64
+}
+
+func (e *deadLetterEntities) EncodeKey() ([]byte, error) {
+	return []byte(DeadLetterStorePrefix + ".ENTITIES"), nil
+}
+
+func (e *deadLetterEntities) Encode() ([]byte, error) {
+	bytes, err := json.Marshal(e.entities)
+	return bytes, errors.Wrap(err, "encode dead letter entities")
+}
+
+func (e *deadLetterEntities) Decode(key, bytes []byte) error {
+	return errors.Wrap(json.Unmarshal(bytes, &e.entities), "decode dead letter entities")
+}
+
+// prune remove entities expired or exceeding MaxDeadLetterEntities, returns entities removed.
+func (e *deadLetterEntities) prune(now time.Time) []string {
+	var removed []string
+	ids := make([]string, 0, len(e.entities))
+	for id, ts := range e.entities {
+		if ts < now.Add(-DeadLetterTTL).UnixMilli() {
+			removed = append(removed, id)
+			continue
+		}
+		ids = append(ids, id)
+	}
+
+	if len(ids) > MaxDeadLetterEntities {
+		sort.Slice(ids, func(i, j int) bool {
+			return e.entities[ids[i]] < e.entities[ids[j]]
+		})
+		removed = append(removed, ids[:len(ids)-MaxDeadLetterEntities]...)
+	}
+
+	for _, id := range removed {
+		delete(e.entities, id)
+	}
+	return removed
+}
+
+// PutDeadLetter store dead letter in state store, indexed by entity. dead letters of an entity
+// are put by the runtime owning the entity, the index of the entity is not written concurrently.
+func (r *repo) PutDeadLetter(ctx context.Context, letter *DeadLetter) error {
+	if len(letter.Event) > MaxDeadLetterEventBytes {
+		letter.Event = nil
+	}
+
+	if err := r.dao.StoreResource(ctx, letter); nil != err {
+		return errors.Wrap(err, "put dead letter repository")
+	}
+
+	r.dlock.Lock()
+	defer r.dlock.Unlock()
+
+	now := time.Now()
+	index, err := r.getDeadLetterIndex(ctx, letter.EntityID)
+	if nil != err {
+		return errors.Wrap(err, "put dead letter repository")
+	}
+	summary := *letter
+	summary.Event = nil
+	index.letters = append(index.letters, &summary)
+	removed := index.prune(now)
+	if err = r.dao.StoreResource(ctx, index); nil != err {
+		return errors.Wrap(err, "put dead letter repository")
+	}
+	r.removeDeadLetters(ctx, removed)
+
+	entities, err := r.getDeadLetterEntities(ctx)
+	if nil != err {
+		return errors.Wrap(err, "put dead letter repository")
+	}
+	entities.entities[letter.EntityID] = letter.Timestamp
+	evicted := entities.prune(now)
+	if err = r.dao.StoreResource(ctx, entities); nil != err {
+		return errors.Wrap(err, "put dead letter repository")
+	}
+
+	for _, entityID := range evicted {
+		if err = r.purgeDeadLetterIndex(ctx, entityID); nil != err {
+			log.L().Warn("evict dead letters", logf.Eid(entityID), logf.Reason(err.Error()))
+		}
+	}
+	return nil
+}
+
+func (r *repo) GetDeadLetter(ctx context.Context, letter *DeadLetter) (*DeadLetter, error) {
+	if _, err := r.dao.GetStoreResource(ctx, letter); nil != err {
+		if notFound(err) {
+			return letter, errors.Wrap(xerrors.ErrResourceNotFound, "get dead letter repository")
+		}
+		return letter, errors.Wrap(err, "get dead letter repository")
+	} else if letter.expired(time.Now()) {
+		return letter, errors.Wrap(xerrors.ErrResourceNotFound, "get dead letter repository")
+	}
+	return letter, nil
+}
+
+// DelDeadLetter remove dead letter and its entry in the index of entity.
+func (r *repo) DelDeadLetter(ctx context.Context, letter *DeadLetter) error {
+	stored := &DeadLetter{ID: letter.ID}
+	if _, err := r.dao.GetStoreResource(ctx, stored); nil != err {
+		if notFound(err) {
+			return nil
+		}
+		return errors.Wrap(err, "del dead letter repository")
+	}
+
+	r.dlock.Lock()
+	defer r.dlock.Unlock()
+
+	index, err := r.getDeadLetterIndex(ctx, stored.EntityID)
+	if nil != err {
+		return errors.Wrap(err, "del dead letter repository")
+	}
+	for i := range index.letters {
+		if index.letters[i].ID == letter.ID {
+			index.letters = append(index.letters[:i], index.letters[i+1:]...)
+			break
+		}
+	}
+
+	if len(index.letters) > 0 {
+		err = r.dao.StoreResource(ctx, index)
+	} else {
+		err = r.purgeDeadLetterIndex(ctx, stored.EntityID)
+	}
+	if nil != err {
+		return errors.Wrap(err, "del dead letter repository")
+	}
+
+	err = r.dao.RemoveStoreResource(ctx, stored)
+	return errors.Wrap(err, "del dead letter repository")
+}
+
+// ListDeadLetter list dead letters without events from index of entity, all entities if entity id empty.
+func (r *repo) ListDeadLetter(ctx context.Context, req *ListDeadLetterReq) ([]*DeadLetter, error) {
+	entityIDs := []string{req.EntityID}
+	if req.EntityID == "" {
+		entities, err := r.getDeadLetterEntities(ctx)
+		if nil != err {
+			return nil, errors.Wrap(err, "list dead letter repository")
+		}
+		entityIDs = entityIDs[:0]
+		for entityID := range entities.entities {
+			entityIDs = append(entityIDs, entityID)
+		}
+	}
+
+	now := time.Now()
+	var letters []*DeadLetter
+	for _, entityID := range entityIDs {
+		index, err := r.getDeadLetterIndex(ctx, entityID)
+		if nil != err {
+			return nil, errors.Wrap(err, "list dead letter repository")
+		}
+		for _, letter := range index.letters {
+			if !letter.expired(now) {
+				letters = append(letters, letter)
+			}
+		}
+	}
+
+	sort.SliceStable(letters, func(i, j int) bool {
+		return letters[i].Timestamp < letters[j].Timestamp
+	})
+	return letters, nil
+}
+
+func (r *repo) getDeadLetterIndex(ctx context.Context, entityID string) (*deadLetterIndex, error) {
+	index := &deadLetterIndex{entityID: entityID}
+	if _, err := r.dao.GetStoreResource(ctx, index); nil != err && !notFound(err) {
+		return nil, errors.Wrap(err, "get dead letter index")
+	}
+	return index, nil
+}
+
+func (r *repo) getDeadLetterEntities(ctx context.Context) (*deadLetterEntities, error) {
+	entities := &deadLetterEntities{}
+	if _, err := r.dao.GetStoreResource(ctx, entities); nil != err && !notFound(err) {
+		return nil, errors.Wrap(err, "get dead letter entities")
+	}
+	if nil == entities.entities {
+		entities.entities = make(map[string]int64)
+	}
+	return entities, nil
+}
+
+// purgeDeadLetterIndex remove dead letters of entity and the index, the entity removed from entities.
+func (r *repo) purgeDeadLetterIndex(ctx context.Context, entityID string) error {
+	index, err := r.getDeadLetterIndex(ctx, entityID)
+	if nil != err {
+		return err
+	}
+	r.removeDeadLetters(ctx, index.letters)
+	if err = r.dao.RemoveStoreResource(ctx, index); nil != err {
+		return errors.Wrap(err, "remove dead letter index")
+	}
+
+	entities, err := r.getDeadLetterEntities(ctx)
+	if nil != err {
+		return err
+	} else if _, has := entities.entities[entityID]; !has {
+		return nil
+	}
+	delete(entities.entities, entityID)
+	return errors.Wrap(r.dao.StoreResource(ctx, entities), "remove dead letter entity")
+}
+
+// removeDeadLetters remove dead letters pruned from index, failures logged only.
+func (r *repo) removeDeadLetters(ctx context.Context, letters []*DeadLetter) {
+	for _, letter := range letters {
+		if err := r.dao.RemoveStoreResource(ctx, letter); nil != err {
+			log.L().Warn("remove dead letter", logf.ID(letter.ID), logf.Reason(err.Error()))
+		}
+	}
+}
+
+func notFound(err error) bool {
+	return errors.Is(err, xerrors.ErrResourceNotFound) || errors.Is(err, xerrors.ErrEntityNotFound)
+}
@@ -0,0 +1,117 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tkeel-io/core/pkg/config"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"github.com/tkeel-io/core/pkg/repository/dao"
+	_ "github.com/tkeel-io/core/pkg/resource/store/memory"
+)
+
+func newDeadLetterRepo(t *testing.T) *repo {
+	daoIns, err := dao.NewMock(context.Background(), config.Metadata{Name: "memory"}, config.EtcdConfig{})
+	assert.Nil(t, err)
+	return &repo{dao: daoIns}
+}
+
+func TestDeadLetter_Decode(t *testing.T) {
+	letter := &DeadLetter{ID: "dl-1234", EntityID: "device123", Handler: "decode", Event: []byte("invalid")}
+	key, err := letter.EncodeKey()
+	assert.Nil(t, err)
+	assert.Equal(t, DeadLetterStorePrefix+".dl-1234", string(key))
+
+	bytes, err := letter.Encode()
+	assert.Nil(t, err)
+
+	var ret DeadLetter
+	assert.Nil(t, ret.Decode(key, bytes))
+	assert.Equal(t, letter, &ret)
+
+	ret = DeadLetter{}
+	assert.Nil(t, ret.Decode(key, nil))
+	assert.Equal(t, "dl-1234", ret.ID)
+
+	_, err = (&DeadLetter{}).EncodeKey()
+	assert.NotNil(t, err)
+}
+
+func TestRepo_DeadLetter(t *testing.T) {
+	r := newDeadLetterRepo(t)
+	ctx := context.Background()
+	now := time.Now().UnixMilli()
+
+	assert.Nil(t, r.PutDeadLetter(ctx, &DeadLetter{ID: "dl-1", EntityID: "device123", Timestamp: now, Event: []byte("ev")}))
+	assert.Nil(t, r.PutDeadLetter(ctx, &DeadLetter{ID: "dl-2", EntityID: "device234", Timestamp: now + 1}))
+	assert.Nil(t, r.PutDeadLetter(ctx, &DeadLetter{ID: "dl-3", Handler: "decode", Timestamp: now + 2,
+		Event: make([]byte, MaxDeadLetterEventBytes+1)}))
+
+	// listed from index of entity, events omitted.
+	letters, err := r.ListDeadLetter(ctx, &ListDeadLetterReq{EntityID: "device123"})
+	assert.Nil(t, err)
+	assert.Len(t, letters, 1)
+	assert.Equal(t, "dl-1", letters[0].ID)
+	assert.Nil(t, letters[0].Event)
+
+	letters, err = r.ListDeadLetter(ctx, &ListDeadLetterReq{})
+	assert.Nil(t, err)
+	assert.Len(t, letters, 3)
+
+	letter, err := r.GetDeadLetter(ctx, &DeadLetter{ID: "dl-1"})
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("ev"), letter.Event)
+
+	// event exceeding limit not retained.
+	letter, err = r.GetDeadLetter(ctx, &DeadLetter{ID: "dl-3"})
+	assert.Nil(t, err)
+	assert.Nil(t, letter.Event)
+
+	assert.Nil(t, r.DelDeadLetter(ctx, &DeadLetter{ID: "dl-1"}))
+	_, err = r.GetDeadLetter(ctx, &DeadLetter{ID: "dl-1"})
+	assert.ErrorIs(t, err, xerrors.ErrResourceNotFound)
+	letters, err = r.ListDeadLetter(ctx, &ListDeadLetterReq{})
+	assert.Nil(t, err)
+	assert.Len(t, letters, 2)
+	assert.Nil(t, r.DelDeadLetter(ctx, &DeadLetter{ID: "dl-1"}))
+}
+
+func TestRepo_DeadLetterRetention(t *testing.T) {
+	r := newDeadLetterRepo(t)
+	ctx := context.Background()
+	now := time.Now().UnixMilli()
+
+	// expired dead letters removed.
+	expired := time.Now().Add(-DeadLetterTTL - time.Minute).UnixMilli()
+	assert.Nil(t, r.PutDeadLetter(ctx, &DeadLetter{ID: "dl-expired", EntityID: "device123", Timestamp: expired}))
+	_, err := r.GetDeadLetter(ctx, &DeadLetter{ID: "dl-expired"})
+	assert.ErrorIs(t, err, xerrors.ErrResourceNotFound)
+
+	// oldest dead letters of entity removed.
+	for i := 0; i <= MaxEntityDeadLetters; i++ {
+		assert.Nil(t, r.PutDeadLetter(ctx, &DeadLetter{
+			ID: fmt.Sprintf("dl-%d", i), EntityID: "device123", Timestamp: now + int64(i)}))
+	}
+	letters, err := r.ListDeadLetter(ctx, &ListDeadLetterReq{EntityID: "device123"})
+	assert.Nil(t, err)
+	assert.Len(t, letters, MaxEntityDeadLetters)
+	assert.Equal(t, "dl-1", letters[0].ID)
+	_, err = r.GetDeadLetter(ctx, &DeadLetter{ID: "dl-0"})
+	assert.ErrorIs(t, err, xerrors.ErrResourceNotFound)
+
+	// dead letters of entity least recently failed removed.
+	entities := &deadLetterEntities{entities: map[string]int64{"device123": now}}
+	for i := 1; i < MaxDeadLetterEntities; i++ {
+		entities.entities[fmt.Sprintf("device-%d", i)] = now + int64(i)
+	}
+	assert.Nil(t, r.dao.StoreResource(ctx, entities))
+	assert.Nil(t, r.PutDeadLetter(ctx, &DeadLetter{ID: "dl-new", EntityID: "device-new", Timestamp: now + 1}))
+	letters, err = r.ListDeadLetter(ctx, &ListDeadLetterReq{EntityID: "device123"})
+	assert.Nil(t, err)
+	assert.Len(t, letters, 0)
+	_, err = r.GetDeadLetter(ctx, &DeadLetter{ID: "dl-1"})
+	assert.ErrorIs(t, err, xerrors.ErrResourceNotFound)
+}
@@ -122,9 +122,12 @@ func (r *repo) FlushEntity(ctx context.Context) error {
 
 func (r *repo) GetEntity(ctx context.Context, eid string) ([]byte, error) {
 	ret, err := r.dao.GetStoreResource(ctx, &entityResource{id: eid})
+	if nil != err {
+		return nil, errors.Wrap(err, "get entity repository")
+	}
 
 	res, _ := ret.(*entityResource)
-	return res.data, errors.Wrap(err, "get entity repository")
+	return res.data, nil
 }
 
 func (r *repo) DelEntity(ctx context.Context, eid string) error {
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/tkeel-io/core/pkg/repository/dao"
@@ -42,6 +43,35 @@ type Expression struct {
 	Expression string
 	// description.
 	Description string
+	// policy of computed results dispatched, dispatched on each change if nil.
+	Policy *ExpressionPolicy `json:",omitempty"`
+}
+
+// ExpressionPolicy limits how often computed results of the expression dispatched,
+// results held dispatched when the window ends, latest result only.
+type ExpressionPolicy struct {
+	// Debounce dispatch the result after no changes for the duration.
+	Debounce time.Duration
+	// MinInterval dispatch results at most once in the interval.
+	MinInterval time.Duration
+	// Deadband dispatch numeric results only if changed beyond the deadband since last dispatched.
+	Deadband float64
+}
+
+// Validate check policy, debounce and min interval exclusive.
+func (p *ExpressionPolicy) Validate() error {
+	switch {
+	case p.Debounce < 0 || p.MinInterval < 0 || p.Deadband < 0:
+		return errors.New("policy must not be negative")
+	case p.Debounce > 0 && p.MinInterval > 0:
+		return errors.New("debounce and min_interval exclusive")
+	}
+	return nil
+}
+
+// Empty reports whether no limits declared.
+func (p *ExpressionPolicy) Empty() bool {
+	return nil == p || *p == ExpressionPolicy{}
 }
 
 func NewExpression(owner, entityID, name, path, expr, desc string) *Expression {
@@ -0,0 +1,140 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	logf "github.com/tkeel-io/core/pkg/logfield"
+	"github.com/tkeel-io/core/pkg/repository/dao"
+	"github.com/tkeel-io/kit/log"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+)
+
+const (
+	FunctionPrefix = "/core/v1/functions"
+
+	// value types of function params and results.
+	FunctionTypeInt    = "int"
+	FunctionTypeFloat  = "float"
+	FunctionTypeBool   = "bool"
+	FunctionTypeString = "string"
+)
+
+// FunctionSpec declares a function exported by the module, callable from expressions and mappers.
+type FunctionSpec struct {
+	// name of function called in expressions.
+	Name string `json:"name"`
+	// name of function exported by the module, same as name if empty.
+	Export string   `json:"export,omitempty"`
+	Params []string `json:"params"`
+	Result string   `json:"result"`
+}
+
+var _ dao.Resource = (*Function)(nil)
+
+// Function is a WebAssembly module uploaded by operators, registers functions declared.
+type Function struct {
+	// module identifier.
+	ID          string `json:"id"`
+	Owner       string `json:"owner"`
+	Description string `json:"description"`
+	// WebAssembly binary of the module.
+	Module    []byte          `json:"module"`
+	Functions []*FunctionSpec `json:"functions"`
+	CreatedAt int64           `json:"created_at"`
+	UpdatedAt int64           `json:"updated_at"`
+}
+
+func (f *Function) EncodeKey() ([]byte, error) {
+	if f.ID == "" {
+		return nil, errors.Errorf("Function ID is empty")
+	}
+
+	keyString := fmt.Sprintf("%s/%s", FunctionPrefix, f.ID)
+	return []byte(keyString), nil
+}
+
+func (f *Function) Encode() ([]byte, error) {
+	bytes, err := json.Marshal(f)
+	return bytes, errors.Wrap(err, "encode Function")
+}
+
+func (f *Function) Decode(key, bytes []byte) error {
+	if bytes != nil {
+		err := json.Unmarshal(bytes, f)
+		return errors.Wrap(err, "decode Function")
+	}
+
+	///core/v1/functions/crc
+	keys := strings.Split(string(key), "/")
+	if len(keys) != 5 {
+		return errors.Errorf("error:decode Function from key[%s]", string(key))
+	}
+	f.ID = keys[4]
+	return nil
+}
+
+func (r *repo) PutFunction(ctx context.Context, fn *Function) error {
+	err := r.dao.PutResource(ctx, fn)
+	return errors.Wrap(err, "put function repository")
+}
+
+func (r *repo) GetFunction(ctx context.Context, fn *Function) (*Function, error) {
+	_, err := r.dao.GetResource(ctx, fn)
+	return fn, errors.Wrap(err, "get function repository")
+}
+
+func (r *repo) DelFunction(ctx context.Context, fn *Function) error {
+	err := r.dao.DelResource(ctx, fn)
+	return errors.Wrap(err, "del function repository")
+}
+
+func (r *repo) ListFunction(ctx context.Context, rev int64) ([]*Function, error) {
+	ress, err := r.dao.ListResource(ctx, rev, FunctionPrefix+"/",
+		func(key, raw []byte) (dao.Resource, error) {
+			var res Function
+			err := res.Decode(key, raw)
+			return &res, errors.Wrap(err, "decode function")
+		})
+
+	var fns []*Function
+	for index := range ress {
+		if fn, ok := ress[index].(*Function); ok {
+			fns = append(fns, fn)
+		}
+	}
+	return fns, errors.Wrap(err, "list function repository")
+}
+
+func (r *repo) RangeFunction(ctx context.Context, rev int64, handler RangeFunctionFunc) {
+	r.dao.RangeResource(ctx, rev, FunctionPrefix+"/", func(kvs []*mvccpb.KeyValue) {
+		var fns []*Function
+		for index := range kvs {
+			var fn Function
+			err := fn.Decode(kvs[index].Key, kvs[index].Value)
+			if nil != err {
+				log.L().Error("decode function", logf.Key(string(kvs[index].Key)), logf.Error(err))
+				continue
+			}
+			fns = append(fns, &fn)
+		}
+		handler(fns)
+	})
+}
+
+func (r *repo) WatchFunction(ctx context.Context, rev int64, handler WatchFunctionFunc) {
+	r.dao.WatchResource(ctx, rev, FunctionPrefix+"/", func(et dao.EnventType, kv *mvccpb.KeyValue) {
+		fn := &Function{}
+		if err := fn.Decode(kv.Key, kv.Value); nil != err {
+			log.L().Error("decode function", logf.Key(string(kv.Key)), logf.Error(err))
+		}
+		handler(et, fn)
+	})
+}
+
+type (
+	RangeFunctionFunc func([]*Function)
+	WatchFunctionFunc func(dao.EnventType, *Function)
+)
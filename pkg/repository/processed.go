@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	ProcessedStorePrefix = "CORE.PROCESSED"
+)
+
+// processedResource is the window of event ids applied to the entity, stored beside the entity.
+type processedResource struct {
+	id   string
+	data []byte
+}
+
+func (p *processedResource) EncodeKey() ([]byte, error) {
+	return []byte(ProcessedStorePrefix + "." + p.id), nil
+}
+
+func (p *processedResource) Encode() ([]byte, error) {
+	return p.data, nil
+}
+
+func (p *processedResource) Decode(key, bytes []byte) error {
+	p.data = bytes
+	return nil
+}
+
+func (r *repo) PutProcessed(ctx context.Context, eid string, data []byte) error {
+	err := r.dao.StoreResource(ctx, &processedResource{id: eid, data: data})
+	return errors.Wrap(err, "put processed repository")
+}
+
+func (r *repo) GetProcessed(ctx context.Context, eid string) ([]byte, error) {
+	ret, err := r.dao.GetStoreResource(ctx, &processedResource{id: eid})
+	if nil != err {
+		return nil, errors.Wrap(err, "get processed repository")
+	}
+
+	res, _ := ret.(*processedResource)
+	return res.data, nil
+}
+
+func (r *repo) DelProcessed(ctx context.Context, eid string) error {
+	err := r.dao.RemoveStoreResource(ctx, &processedResource{id: eid})
+	return errors.Wrap(err, "del processed repository")
+}
@@ -0,0 +1,199 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+	logf "github.com/tkeel-io/core/pkg/logfield"
+	"github.com/tkeel-io/core/pkg/repository/dao"
+	"github.com/tkeel-io/kit/log"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+)
+
+const (
+	// RelationshipPrefix edges keyed by source entity, /core/v1/relationships/{from}/{type}/{to}.
+	RelationshipPrefix = "/core/v1/relationships"
+	// RelationshipIndexPrefix reverse index keyed by target entity, /core/v1/relationindexes/{to}/{type}/{from}.
+	RelationshipIndexPrefix = "/core/v1/relationindexes"
+
+	// RelationshipCascadeDelete delete target entity when source entity deleted.
+	RelationshipCascadeDelete = "delete"
+	// RelationshipTypeTemplate edge from template to entity created from the template.
+	RelationshipTypeTemplate = "template"
+)
+
+type ListRelationshipReq struct {
+	EntityID string
+	// owner of relationships, all owners if empty.
+	Owner string
+	// relationship type, all types if empty.
+	Type string
+	// list edges targeting the entity if reverse, otherwise edges sourced from the entity.
+	Reverse bool
+}
+
+var _ dao.Resource = (*Relationship)(nil)
+
+// Relationship is a typed directed edge from source entity to target entity.
+type Relationship struct {
+	Type        string `json:"type"`
+	From        string `json:"from"`
+	To          string `json:"to"`
+	Owner       string `json:"owner"`
+	Description string `json:"description"`
+	// cascade rule applied to target entity when source entity deleted, edges only removed if empty.
+	Cascade   string `json:"cascade,omitempty"`
+	CreatedAt int64  `json:"created_at"`
+}
+
+func (r *Relationship) EncodeKey() ([]byte, error) {
+	if r.From == "" || r.Type == "" || r.To == "" {
+		return nil, errors.Errorf("Relationship from, type or to is empty")
+	}
+
+	keyString := fmt.Sprintf("%s/%s/%s/%s", RelationshipPrefix,
+		url.PathEscape(r.From), url.PathEscape(r.Type), url.PathEscape(r.To))
+	return []byte(keyString), nil
+}
+
+func (r *Relationship) Encode() ([]byte, error) {
+	bytes, err := json.Marshal(r)
+	return bytes, errors.Wrap(err, "encode Relationship")
+}
+
+func (r *Relationship) Decode(key, bytes []byte) error {
+	if bytes != nil {
+		err := json.Unmarshal(bytes, r)
+		return errors.Wrap(err, "decode Relationship")
+	}
+
+	///core/v1/relationships/device123/contains/device234
+	keys := strings.Split(string(key), "/")
+	if len(keys) != 7 {
+		return errors.Errorf("error:decode Relationship from key[%s]", string(key))
+	}
+	r.From, _ = url.PathUnescape(keys[4])
+	r.Type, _ = url.PathUnescape(keys[5])
+	r.To, _ = url.PathUnescape(keys[6])
+	return nil
+}
+
+// relationshipIndex indexes relationship by target entity.
+type relationshipIndex struct {
+	*Relationship
+}
+
+func (r relationshipIndex) EncodeKey() ([]byte, error) {
+	if r.From == "" || r.Type == "" || r.To == "" {
+		return nil, errors.Errorf("Relationship from, type or to is empty")
+	}
+
+	keyString := fmt.Sprintf("%s/%s/%s/%s", RelationshipIndexPrefix,
+		url.PathEscape(r.To), url.PathEscape(r.Type), url.PathEscape(r.From))
+	return []byte(keyString), nil
+}
+
+func (r relationshipIndex) Decode(key, bytes []byte) error {
+	if bytes != nil {
+		err := json.Unmarshal(bytes, r.Relationship)
+		return errors.Wrap(err, "decode Relationship")
+	}
+
+	///core/v1/relationindexes/device234/contains/device123
+	keys := strings.Split(string(key), "/")
+	if len(keys) != 7 {
+		return errors.Errorf("error:decode Relationship from key[%s]", string(key))
+	}
+	r.To, _ = url.PathUnescape(keys[4])
+	r.Type, _ = url.PathUnescape(keys[5])
+	r.From, _ = url.PathUnescape(keys[6])
+	return nil
+}
+
+// ListRelationshipPrefix returns prefix of edges sourced from entity, or targeting entity if reverse.
+func ListRelationshipPrefix(entityID, typ string, reverse bool) string {
+	prefix := RelationshipPrefix
+	if reverse {
+		prefix = RelationshipIndexPrefix
+	}
+
+	prefix = fmt.Sprintf("%s/%s/", prefix, url.PathEscape(entityID))
+	if typ != "" {
+		prefix += url.PathEscape(typ) + "/"
+	}
+	return prefix
+}
+
+// PutRelationship store edge and the reverse index of the edge.
+func (r *repo) PutRelationship(ctx context.Context, rel *Relationship) error {
+	if err := r.dao.PutResource(ctx, rel); nil != err {
+		return errors.Wrap(err, "put relationship repository")
+	}
+	err := r.dao.PutResource(ctx, relationshipIndex{rel})
+	return errors.Wrap(err, "put relationship index repository")
+}
+
+func (r *repo) GetRelationship(ctx context.Context, rel *Relationship) (*Relationship, error) {
+	_, err := r.dao.GetResource(ctx, rel)
+	return rel, errors.Wrap(err, "get relationship repository")
+}
+
+func (r *repo) DelRelationship(ctx context.Context, rel *Relationship) error {
+	if err := r.dao.DelResource(ctx, rel); nil != err {
+		return errors.Wrap(err, "del relationship repository")
+	}
+	err := r.dao.DelResource(ctx, relationshipIndex{rel})
+	return errors.Wrap(err, "del relationship index repository")
+}
+
+func (r *repo) ListRelationship(ctx context.Context, rev int64, req *ListRelationshipReq) ([]*Relationship, error) {
+	prefix := ListRelationshipPrefix(req.EntityID, req.Type, req.Reverse)
+	ress, err := r.dao.ListResource(ctx, rev, prefix,
+		func(key, raw []byte) (dao.Resource, error) {
+			var res dao.Resource = &Relationship{}
+			if req.Reverse {
+				res = relationshipIndex{&Relationship{}}
+			}
+			err := res.Decode(key, raw)
+			return res, errors.Wrap(err, "decode relationship")
+		})
+
+	var rels []*Relationship
+	for index := range ress {
+		var rel *Relationship
+		switch res := ress[index].(type) {
+		case *Relationship:
+			rel = res
+		case relationshipIndex:
+			rel = res.Relationship
+		default:
+			continue
+		}
+		if req.Owner == "" || req.Owner == rel.Owner {
+			rels = append(rels, rel)
+		}
+	}
+	return rels, errors.Wrap(err, "list relationship repository")
+}
+
+// WatchRelationship watch edges, edges deleted decoded from keys.
+func (r *repo) WatchRelationship(ctx context.Context, rev int64, handler WatchRelationshipFunc) {
+	r.dao.WatchResource(ctx, rev, RelationshipPrefix+"/", func(et dao.EnventType, kv *mvccpb.KeyValue) {
+		var value []byte
+		if len(kv.Value) > 0 {
+			value = kv.Value
+		}
+
+		rel := &Relationship{}
+		if err := rel.Decode(kv.Key, value); nil != err {
+			log.L().Error("decode relationship", logf.Key(string(kv.Key)), logf.Error(err))
+			return
+		}
+		handler(et, rel)
+	})
+}
+
+type WatchRelationshipFunc func(dao.EnventType, *Relationship)
@@ -0,0 +1,46 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRelationship_Decode(t *testing.T) {
+	rel := &Relationship{Type: "contains", From: "site/1", To: "gateway1", Cascade: RelationshipCascadeDelete}
+	key, err := rel.EncodeKey()
+	assert.Nil(t, err)
+	assert.Equal(t, RelationshipPrefix+"/site%2F1/contains/gateway1", string(key))
+
+	bytes, err := rel.Encode()
+	assert.Nil(t, err)
+
+	var ret Relationship
+	assert.Nil(t, ret.Decode(key, bytes))
+	assert.Equal(t, rel, &ret)
+
+	ret = Relationship{}
+	assert.Nil(t, ret.Decode(key, nil))
+	assert.Equal(t, Relationship{Type: "contains", From: "site/1", To: "gateway1"}, ret)
+
+	_, err = (&Relationship{From: "site1"}).EncodeKey()
+	assert.NotNil(t, err)
+}
+
+func TestRelationshipIndex_Decode(t *testing.T) {
+	index := relationshipIndex{&Relationship{Type: "contains", From: "site1", To: "gateway1"}}
+	key, err := index.EncodeKey()
+	assert.Nil(t, err)
+	assert.Equal(t, RelationshipIndexPrefix+"/gateway1/contains/site1", string(key))
+	assert.Equal(t, ListRelationshipPrefix("gateway1", "contains", true), string(key[:len(key)-len("site1")]))
+
+	ret := relationshipIndex{&Relationship{}}
+	assert.Nil(t, ret.Decode(key, nil))
+	assert.Equal(t, index.Relationship, ret.Relationship)
+}
+
+func TestListRelationshipPrefix(t *testing.T) {
+	assert.Equal(t, RelationshipPrefix+"/site1/", ListRelationshipPrefix("site1", "", false))
+	assert.Equal(t, RelationshipPrefix+"/site1/contains/", ListRelationshipPrefix("site1", "contains", false))
+	assert.Equal(t, RelationshipIndexPrefix+"/device1/", ListRelationshipPrefix("device1", "", true))
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"sync"
 
 	"github.com/tkeel-io/core/pkg/repository/dao"
 )
@@ -10,6 +11,8 @@ var _ IRepository = (*repo)(nil)
 
 type repo struct {
 	dao dao.IDao
+	// dlock serializes updates of dead letter indexes.
+	dlock sync.Mutex
 }
 
 func New(dao dao.IDao) IRepository {
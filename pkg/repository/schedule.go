@@ -0,0 +1,151 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	logf "github.com/tkeel-io/core/pkg/logfield"
+	"github.com/tkeel-io/core/pkg/repository/dao"
+	"github.com/tkeel-io/kit/log"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+)
+
+const (
+	SchedulePrefix = "/core/v1/schedules"
+
+	// ScheduleActionPatch apply patches to entity, the default action.
+	ScheduleActionPatch = "patch"
+	// ScheduleActionDelete delete entity, used by entity ttl.
+	ScheduleActionDelete = "delete"
+)
+
+type ListScheduleReq struct {
+	EntityID string
+}
+
+// SchedulePatch is a patch applied to entity when schedule fired.
+type SchedulePatch struct {
+	Path     string `json:"path"`
+	Operator string `json:"operator"`
+	// patch value, encoded in json.
+	Value []byte `json:"value"`
+	// source path of move and copy operations.
+	From string `json:"from,omitempty"`
+}
+
+var _ dao.Resource = (*Schedule)(nil)
+
+// Schedule is a pending patch of entity, fired at the due time.
+type Schedule struct {
+	// schedule identifier.
+	ID          string `json:"id"`
+	EntityID    string `json:"entity_id"`
+	Owner       string `json:"owner"`
+	Source      string `json:"source"`
+	Description string `json:"description"`
+	// action of schedule, patch if empty.
+	Action string `json:"action,omitempty"`
+	// cron expression of recurrence, fired once if empty.
+	Cron string `json:"cron"`
+	// time zone which cron evaluated in, UTC if empty.
+	Timezone string `json:"timezone"`
+	// next due time, unix milliseconds.
+	NextTime  int64            `json:"next_time"`
+	Patches   []*SchedulePatch `json:"patches"`
+	CreatedAt int64            `json:"created_at"`
+}
+
+func (s *Schedule) EncodeKey() ([]byte, error) {
+	if s.ID == "" {
+		return nil, errors.Errorf("Schedule ID is empty")
+	}
+
+	keyString := fmt.Sprintf("%s/%s", SchedulePrefix, s.ID)
+	return []byte(keyString), nil
+}
+
+func (s *Schedule) Encode() ([]byte, error) {
+	bytes, err := json.Marshal(s)
+	return bytes, errors.Wrap(err, "encode Schedule")
+}
+
+func (s *Schedule) Decode(key, bytes []byte) error {
+	if bytes != nil {
+		err := json.Unmarshal(bytes, s)
+		return errors.Wrap(err, "decode Schedule")
+	}
+
+	///core/v1/schedules/sch-1234
+	keys := strings.Split(string(key), "/")
+	if len(keys) != 5 {
+		return errors.Errorf("error:decode Schedule from key[%s]", string(key))
+	}
+	s.ID = keys[4]
+	return nil
+}
+
+func (r *repo) PutSchedule(ctx context.Context, schedule *Schedule) error {
+	err := r.dao.PutResource(ctx, schedule)
+	return errors.Wrap(err, "put schedule repository")
+}
+
+func (r *repo) GetSchedule(ctx context.Context, schedule *Schedule) (*Schedule, error) {
+	_, err := r.dao.GetResource(ctx, schedule)
+	return schedule, errors.Wrap(err, "get schedule repository")
+}
+
+func (r *repo) DelSchedule(ctx context.Context, schedule *Schedule) error {
+	err := r.dao.DelResource(ctx, schedule)
+	return errors.Wrap(err, "del schedule repository")
+}
+
+func (r *repo) ListSchedule(ctx context.Context, rev int64, req *ListScheduleReq) ([]*Schedule, error) {
+	ress, err := r.dao.ListResource(ctx, rev, SchedulePrefix,
+		func(key, raw []byte) (dao.Resource, error) {
+			var res Schedule
+			err := res.Decode(key, raw)
+			return &res, errors.Wrap(err, "decode schedule")
+		})
+
+	var schedules []*Schedule
+	for index := range ress {
+		schedule, ok := ress[index].(*Schedule)
+		if ok && (req.EntityID == "" || req.EntityID == schedule.EntityID) {
+			schedules = append(schedules, schedule)
+		}
+	}
+	return schedules, errors.Wrap(err, "list schedule repository")
+}
+
+func (r *repo) RangeSchedule(ctx context.Context, rev int64, handler RangeScheduleFunc) {
+	r.dao.RangeResource(ctx, rev, SchedulePrefix, func(kvs []*mvccpb.KeyValue) {
+		var schedules []*Schedule
+		for index := range kvs {
+			var schedule Schedule
+			err := schedule.Decode(kvs[index].Key, kvs[index].Value)
+			if nil != err {
+				log.L().Error("decode schedule", logf.Key(string(kvs[index].Key)), logf.Error(err))
+				continue
+			}
+			schedules = append(schedules, &schedule)
+		}
+		handler(schedules)
+	})
+}
+
+func (r *repo) WatchSchedule(ctx context.Context, rev int64, handler WatchScheduleFunc) {
+	r.dao.WatchResource(ctx, rev, SchedulePrefix, func(et dao.EnventType, kv *mvccpb.KeyValue) {
+		schedule := &Schedule{}
+		if err := schedule.Decode(kv.Key, kv.Value); nil != err {
+			log.L().Error("decode schedule", logf.Key(string(kv.Key)), logf.Error(err))
+		}
+		handler(et, schedule)
+	})
+}
+
+type (
+	RangeScheduleFunc func([]*Schedule)
+	WatchScheduleFunc func(dao.EnventType, *Schedule)
+)
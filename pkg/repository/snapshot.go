@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	SnapshotStorePrefix = "CORE.SNAPSHOT"
+)
+
+// snapshotResource is the manifest of runtime snapshot, or a chunk of snapshot if chunk set.
+type snapshotResource struct {
+	id    string
+	chunk string
+	data  []byte
+}
+
+func (s *snapshotResource) EncodeKey() ([]byte, error) {
+	if s.chunk != "" {
+		return []byte(SnapshotStorePrefix + "." + s.id + ".CHUNK." + s.chunk), nil
+	}
+	return []byte(SnapshotStorePrefix + "." + s.id), nil
+}
+
+func (s *snapshotResource) Encode() ([]byte, error) {
+	return s.data, nil
+}
+
+func (s *snapshotResource) Decode(key, bytes []byte) error {
+	s.data = bytes
+	return nil
+}
+
+func (r *repo) PutSnapshot(ctx context.Context, id string, data []byte) error {
+	err := r.dao.StoreResource(ctx, &snapshotResource{id: id, data: data})
+	return errors.Wrap(err, "put snapshot repository")
+}
+
+func (r *repo) GetSnapshot(ctx context.Context, id string) ([]byte, error) {
+	ret, err := r.dao.GetStoreResource(ctx, &snapshotResource{id: id})
+	if nil != err {
+		return nil, errors.Wrap(err, "get snapshot repository")
+	}
+
+	res, _ := ret.(*snapshotResource)
+	return res.data, nil
+}
+
+func (r *repo) PutSnapshotChunk(ctx context.Context, id, chunkID string, data []byte) error {
+	err := r.dao.StoreResource(ctx, &snapshotResource{id: id, chunk: chunkID, data: data})
+	return errors.Wrap(err, "put snapshot chunk repository")
+}
+
+func (r *repo) GetSnapshotChunk(ctx context.Context, id, chunkID string) ([]byte, error) {
+	ret, err := r.dao.GetStoreResource(ctx, &snapshotResource{id: id, chunk: chunkID})
+	if nil != err {
+		return nil, errors.Wrap(err, "get snapshot chunk repository")
+	}
+
+	res, _ := ret.(*snapshotResource)
+	return res.data, nil
+}
+
+func (r *repo) DelSnapshotChunk(ctx context.Context, id, chunkID string) error {
+	err := r.dao.RemoveStoreResource(ctx, &snapshotResource{id: id, chunk: chunkID})
+	return errors.Wrap(err, "del snapshot chunk repository")
+}
@@ -18,6 +18,8 @@ const (
 type ListSubscriptionReq struct {
 	Owner    string
 	EntityID string
+	// subscription identifier, subscriptions of owner listed if empty.
+	ID string
 }
 
 var _ dao.Resource = (*Subscription)(nil)
@@ -44,8 +46,10 @@ func NewSubscription(ID, Owner, Mode, Source, Filter, Target, Topic, PubsubName
 	return &Subscription{}
 }
 
+// ListSubscriptionPrefix returns prefix of subscriptions of owner, subscriptions keyed
+// by subscription id before source entity id, so that entity filtered after listed.
 func ListSubscriptionPrefix(Owner, EntityID string) string {
-	keyString := fmt.Sprintf("%s/%s",
+	keyString := fmt.Sprintf("%s/%s/",
 		SubscriptionPrefix, Owner)
 	return keyString
 }
@@ -106,7 +110,10 @@ func (r *repo) HasSubscription(ctx context.Context, expr *Subscription) (bool, e
 
 func (r *repo) ListSubscription(ctx context.Context, rev int64, req *ListSubscriptionReq) ([]*Subscription, error) {
 	// construct prefix.
-	prefix := ListSubscriptionPrefix(req.EntityID, req.Owner)
+	prefix := ListSubscriptionPrefix(req.Owner, req.EntityID)
+	if req.ID != "" {
+		prefix += req.ID + "/"
+	}
 	ress, err := r.dao.ListResource(ctx, rev, prefix,
 		func(key, raw []byte) (dao.Resource, error) {
 			var res Subscription // escape.
@@ -117,7 +124,9 @@ func (r *repo) ListSubscription(ctx context.Context, rev int64, req *ListSubscri
 	var exprs []*Subscription
 	for index := range ress {
 		if expr, ok := ress[index].(*Subscription); ok {
-			exprs = append(exprs, expr)
+			if req.EntityID == "" || req.EntityID == expr.SourceEntityID {
+				exprs = append(exprs, expr)
+			}
 			continue
 		}
 		// panic.
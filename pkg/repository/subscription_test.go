@@ -38,3 +38,10 @@ func Test_repo_PutSubscription(t *testing.T) {
 		})
 	}
 }
+
+func TestListSubscriptionPrefix(t *testing.T) {
+	prefix := ListSubscriptionPrefix("admin", "device123")
+	if prefix != "/core/v1/subscription/admin/" {
+		t.Errorf("ListSubscriptionPrefix() = %s", prefix)
+	}
+}
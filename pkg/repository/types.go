@@ -11,6 +11,14 @@ type IRepository interface {
 	GetEntity(ctx context.Context, eid string) ([]byte, error)
 	DelEntity(ctx context.Context, eid string) error
 	HasEntity(ctx context.Context, eid string) (bool, error)
+	PutProcessed(ctx context.Context, eid string, data []byte) error
+	GetProcessed(ctx context.Context, eid string) ([]byte, error)
+	DelProcessed(ctx context.Context, eid string) error
+	PutSnapshot(ctx context.Context, id string, data []byte) error
+	GetSnapshot(ctx context.Context, id string) ([]byte, error)
+	PutSnapshotChunk(ctx context.Context, id, chunkID string, data []byte) error
+	GetSnapshotChunk(ctx context.Context, id, chunkID string) ([]byte, error)
+	DelSnapshotChunk(ctx context.Context, id, chunkID string) error
 	PutExpression(ctx context.Context, expr Expression) error
 	GetExpression(ctx context.Context, expr Expression) (Expression, error)
 	DelExpression(ctx context.Context, expr Expression) error
@@ -23,6 +31,28 @@ type IRepository interface {
 	GetSubscription(ctx context.Context, expr *Subscription) (*Subscription, error)
 	DelSubscription(ctx context.Context, expr *Subscription) error
 	HasSubscription(ctx context.Context, expr *Subscription) (bool, error)
+	ListSubscription(ctx context.Context, rev int64, req *ListSubscriptionReq) ([]*Subscription, error)
 	RangeSubscription(ctx context.Context, rev int64, handler RangeSubscriptionFunc)
 	WatchSubscription(ctx context.Context, rev int64, handler WatchSubscriptionFunc)
+	PutDeadLetter(ctx context.Context, letter *DeadLetter) error
+	GetDeadLetter(ctx context.Context, letter *DeadLetter) (*DeadLetter, error)
+	DelDeadLetter(ctx context.Context, letter *DeadLetter) error
+	ListDeadLetter(ctx context.Context, req *ListDeadLetterReq) ([]*DeadLetter, error)
+	PutSchedule(ctx context.Context, schedule *Schedule) error
+	GetSchedule(ctx context.Context, schedule *Schedule) (*Schedule, error)
+	DelSchedule(ctx context.Context, schedule *Schedule) error
+	ListSchedule(ctx context.Context, rev int64, req *ListScheduleReq) ([]*Schedule, error)
+	RangeSchedule(ctx context.Context, rev int64, handler RangeScheduleFunc)
+	WatchSchedule(ctx context.Context, rev int64, handler WatchScheduleFunc)
+	PutRelationship(ctx context.Context, rel *Relationship) error
+	GetRelationship(ctx context.Context, rel *Relationship) (*Relationship, error)
+	DelRelationship(ctx context.Context, rel *Relationship) error
+	ListRelationship(ctx context.Context, rev int64, req *ListRelationshipReq) ([]*Relationship, error)
+	WatchRelationship(ctx context.Context, rev int64, handler WatchRelationshipFunc)
+	PutFunction(ctx context.Context, fn *Function) error
+	GetFunction(ctx context.Context, fn *Function) (*Function, error)
+	DelFunction(ctx context.Context, fn *Function) error
+	ListFunction(ctx context.Context, rev int64) ([]*Function, error)
+	RangeFunction(ctx context.Context, rev int64, handler RangeFunctionFunc)
+	WatchFunction(ctx context.Context, rev int64, handler WatchFunctionFunc)
 }
@@ -2,38 +2,59 @@ package runtime
 
 import (
 	"context"
+	"sync"
 
 	"github.com/pkg/errors"
 	logf "github.com/tkeel-io/core/pkg/logfield"
+	"github.com/tkeel-io/core/pkg/metrics"
 	"github.com/tkeel-io/core/pkg/repository"
 	"github.com/tkeel-io/kit/log"
 )
 
 type EntityCache interface {
 	Load(ctx context.Context, id string) (Entity, error)
-	Snapshot() error
+	// Snapshot returns the raw states of all cached entities.
+	Snapshot() (map[string][]byte, error)
+	// Restore warms the cache from raw states of entities.
+	Restore(entities map[string][]byte) error
 }
 
+// eCache cache entities owned by other runtimes, entities in cache are
+// read only, so evicted entities are dropped without persistent.
 type eCache struct {
+	id         string
+	lock       sync.Mutex
+	limit      CacheLimit
+	index      *lru
 	entities   map[string]Entity
 	repository repository.IRepository
 }
 
-var _defaultCache = &eCache{
-	repository: nil,
-	entities:   make(map[string]Entity),
-}
-
-func NewCache(repo repository.IRepository) EntityCache {
-	_defaultCache.repository = repo
-	return _defaultCache
+func NewCache(id string, repo repository.IRepository, limit CacheLimit) EntityCache {
+	return &eCache{
+		id:         id,
+		lock:       sync.Mutex{},
+		limit:      limit,
+		index:      newLRU(),
+		entities:   make(map[string]Entity),
+		repository: repo,
+	}
 }
 
 func (ec *eCache) Load(ctx context.Context, id string) (Entity, error) {
-	if state, ok := ec.entities[id]; ok {
+	ec.lock.Lock()
+	state, ok := ec.entities[id]
+	if ok {
+		ec.index.Touch(id)
+	}
+	ec.lock.Unlock()
+	if ok {
+		metrics.CollectorEntityCache.WithLabelValues(ec.id, metrics.CacheTypeRemote, metrics.CacheResultHit).Inc()
 		return state, nil
 	}
 
+	metrics.CollectorEntityCache.WithLabelValues(ec.id, metrics.CacheTypeRemote, metrics.CacheResultMiss).Inc()
+
 	// load from state storage.
 	jsonData, err := ec.repository.GetEntity(context.TODO(), id)
 	if nil != err {
@@ -51,10 +72,51 @@ func (ec *eCache) Load(ctx context.Context, id string) (Entity, error) {
 	}
 
 	// cache entity.
-	ec.entities[id] = en
+	ec.lock.Lock()
+	ec.set(id, en, int64(len(jsonData)))
+	ec.lock.Unlock()
 	return en, errors.Wrap(err, "load cache entity")
 }
 
-func (ec *eCache) Snapshot() error {
-	panic("implement me")
+func (ec *eCache) Snapshot() (map[string][]byte, error) {
+	ec.lock.Lock()
+	defer ec.lock.Unlock()
+	entities := make(map[string][]byte, len(ec.entities))
+	for id, en := range ec.entities {
+		entities[id] = en.Raw()
+	}
+	return entities, nil
+}
+
+func (ec *eCache) Restore(entities map[string][]byte) error {
+	ec.lock.Lock()
+	defer ec.lock.Unlock()
+	for id, raw := range entities {
+		en, err := NewEntity(id, raw)
+		if nil != err {
+			log.L().Warn("restore cache entity",
+				logf.Eid(id), logf.Reason(err.Error()))
+			return errors.Wrap(err, "restore cache entity")
+		}
+
+		ec.set(id, en, int64(len(raw)))
+	}
+	return nil
+}
+
+// set cache entity and evict the least recently used entities if over budget.
+func (ec *eCache) set(id string, en Entity, size int64) {
+	ec.entities[id] = en
+	ec.index.Set(id, size)
+	for ec.limit.exceeded(ec.index.Len(), ec.index.Size()) {
+		oldest, ok := ec.index.Oldest()
+		if !ok || oldest == id {
+			break
+		}
+
+		ec.index.Remove(oldest)
+		delete(ec.entities, oldest)
+		metrics.CollectorEntityCache.WithLabelValues(ec.id, metrics.CacheTypeRemote, metrics.CacheResultEvict).Inc()
+	}
+	metrics.CollectorEntityCacheEntries.WithLabelValues(ec.id, metrics.CacheTypeRemote).Set(float64(len(ec.entities)))
 }
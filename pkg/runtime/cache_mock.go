@@ -18,6 +18,8 @@ package runtime
 
 import (
 	"context"
+
+	"github.com/pkg/errors"
 )
 
 type cacheMock struct {
@@ -36,6 +38,21 @@ func (ec *cacheMock) Load(ctx context.Context, id string) (Entity, error) {
 	panic("load cache entity")
 }
 
-func (ec *cacheMock) Snapshot() error {
-	panic("implement me")
+func (ec *cacheMock) Snapshot() (map[string][]byte, error) {
+	entities := make(map[string][]byte, len(ec.entities))
+	for id, en := range ec.entities {
+		entities[id] = en.Raw()
+	}
+	return entities, nil
+}
+
+func (ec *cacheMock) Restore(entities map[string][]byte) error {
+	for id, raw := range entities {
+		en, err := NewEntity(id, raw)
+		if nil != err {
+			return errors.Wrap(err, "restore cache entity")
+		}
+		ec.entities[id] = en
+	}
+	return nil
 }
@@ -0,0 +1,71 @@
+package runtime
+
+import (
+	"context"
+	"time"
+
+	v1 "github.com/tkeel-io/core/api/core/v1"
+	logf "github.com/tkeel-io/core/pkg/logfield"
+	"github.com/tkeel-io/core/pkg/repository"
+	"github.com/tkeel-io/core/pkg/util"
+	"github.com/tkeel-io/kit/log"
+)
+
+const defaultDeadLetterPrefix = "dl-"
+
+// messageKey is the context key of kafka message which the event consumed from.
+type messageKey struct{}
+
+type message struct {
+	Partition int32
+	Offset    int64
+}
+
+func withMessage(ctx context.Context, msg *message) context.Context {
+	return context.WithValue(ctx, messageKey{}, msg)
+}
+
+func messageFrom(ctx context.Context) *message {
+	if msg, ok := ctx.Value(messageKey{}).(*message); ok {
+		return msg
+	}
+	return &message{Partition: -1, Offset: -1}
+}
+
+// handleDeadLetter record event failed in runtime, so that it can be inspected and replayed,
+// events with callback are excluded, the failure has been responded to the caller.
+func (r *Runtime) handleDeadLetter(ctx context.Context, ev v1.Event, feed *Feed) {
+	if nil == feed.Err || ev.CallbackAddr() != "" {
+		return
+	}
+
+	bytes, err := v1.Marshal(ev)
+	if nil != err {
+		log.L().Error("encode dead letter", logf.RID(r.id),
+			logf.Eid(ev.Entity()), logf.ID(ev.ID()), logf.Error(err))
+	}
+
+	msg := messageFrom(ctx)
+	r.putDeadLetter(ctx, &repository.DeadLetter{
+		EntityID:  ev.Entity(),
+		EventID:   ev.ID(),
+		Handler:   feed.Handler,
+		Error:     feed.Err.Error(),
+		Partition: msg.Partition,
+		Offset:    msg.Offset,
+		Event:     bytes,
+	})
+}
+
+func (r *Runtime) putDeadLetter(ctx context.Context, letter *repository.DeadLetter) {
+	letter.ID = util.UUID(defaultDeadLetterPrefix)
+	letter.RuntimeID = r.id
+	letter.Timestamp = time.Now().UnixNano() / 1e6
+	log.L().Warn("dead letter", logf.RID(r.id), logf.Eid(letter.EntityID),
+		logf.ID(letter.EventID), logf.String("handler", letter.Handler), logf.Reason(letter.Error))
+
+	if err := r.repository.PutDeadLetter(ctx, letter); nil != err {
+		log.L().Error("put dead letter", logf.RID(r.id),
+			logf.Eid(letter.EntityID), logf.ID(letter.EventID), logf.Error(err))
+	}
+}
@@ -0,0 +1,71 @@
+package runtime
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Shopify/sarama"
+	"github.com/stretchr/testify/assert"
+	v1 "github.com/tkeel-io/core/api/core/v1"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"github.com/tkeel-io/core/pkg/placement"
+	"github.com/tkeel-io/core/pkg/repository"
+)
+
+// deadLetterRepo records dead letters put.
+type deadLetterRepo struct {
+	repository.IRepository
+	letters []*repository.DeadLetter
+}
+
+func (r *deadLetterRepo) PutDeadLetter(ctx context.Context, letter *repository.DeadLetter) error {
+	r.letters = append(r.letters, letter)
+	return nil
+}
+
+func TestRuntime_handleDeadLetter(t *testing.T) {
+	placement.Initialize()
+	placement.Global().Append(placement.Info{ID: "core-0", Flag: true})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	repo := &deadLetterRepo{IRepository: newRebalanceRepo(t)}
+	rt := NewRuntime(ctx, EntityResource{}, "core-0", &forwardDispatcher{}, repo)
+	en, err := NewEntity("iotd-1", []byte(`{"id":"iotd-1","version":1,"properties":{"temp":20}}`))
+	assert.Nil(t, err)
+	rt.setEntity("iotd-1", en, 0)
+
+	// failure responded to caller.
+	rt.HandleEvent(ctx, newTxEvent("", "req-1", "",
+		&v1.PatchData{Path: "properties.temp", Operator: "unknown", Value: []byte("30")}))
+	assert.Len(t, repo.letters, 0)
+
+	// failure without callback.
+	ev := newTxEvent("", "req-2", "",
+		&v1.PatchData{Path: "properties.temp", Operator: "unknown", Value: []byte("30")})
+	ev.Callback = ""
+	rt.HandleEvent(withMessage(ctx, &message{Partition: 1, Offset: 100}), ev)
+	assert.Len(t, repo.letters, 1)
+	letter := repo.letters[0]
+	assert.Equal(t, "iotd-1", letter.EntityID)
+	assert.Equal(t, "ev-req-2", letter.EventID)
+	assert.Equal(t, "entity", letter.Handler)
+	assert.Equal(t, xerrors.ErrPatchPathInvalid.Error(), letter.Error)
+	assert.Equal(t, int64(100), letter.Offset)
+
+	var replay v1.ProtoEvent
+	assert.Nil(t, v1.Unmarshal(letter.Event, &replay))
+	assert.Equal(t, "iotd-1", replay.Entity())
+
+	// undecodable message.
+	assert.Nil(t, rt.DeliveredEvent(ctx, &sarama.ConsumerMessage{Partition: 2, Offset: 7, Value: []byte("invalid")}, nil))
+	assert.Len(t, repo.letters, 2)
+	assert.Equal(t, handlerDecode, repo.letters[1].Handler)
+	assert.Equal(t, []byte("invalid"), repo.letters[1].Event)
+}
+
+func Test_handlerName(t *testing.T) {
+	rt := &Runtime{}
+	assert.Equal(t, "handlePersistent", handlerName(&handlerImpl{fn: rt.handlePersistent}))
+	assert.Equal(t, "entity", handlerName(DefaultEntity("iotd-1").(Handler)))
+}
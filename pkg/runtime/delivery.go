@@ -0,0 +1,293 @@
+package runtime
+
+import (
+	"context"
+
+	"github.com/Shopify/sarama"
+	"github.com/pkg/errors"
+	v1 "github.com/tkeel-io/core/api/core/v1"
+	logf "github.com/tkeel-io/core/pkg/logfield"
+	"github.com/tkeel-io/core/pkg/metrics"
+	"github.com/tkeel-io/core/pkg/repository"
+	"github.com/tkeel-io/kit/log"
+)
+
+const (
+	defaultWorkers   = 8
+	defaultQueueSize = 1024
+)
+
+// DoneFunc called once message handled, with the offset before which
+// all messages of the partition have been handled.
+type DoneFunc func(committed int64)
+
+// delivery is a message decoded and queued in mailbox.
+type delivery struct {
+	ctx   context.Context
+	msg   *sarama.ConsumerMessage
+	event v1.Event
+	done  DoneFunc
+	// held by a transaction, offset committed once replayed.
+	held bool
+}
+
+// deliveryKey is the context key of delivery which the event handled from.
+type deliveryKey struct{}
+
+func withDelivery(ctx context.Context, d *delivery) context.Context {
+	return context.WithValue(ctx, deliveryKey{}, d)
+}
+
+// deliveryFrom returns delivery of the event, events not delivered from kafka carry no message.
+func deliveryFrom(ctx context.Context, ev v1.Event) *delivery {
+	if d, ok := ctx.Value(deliveryKey{}).(*delivery); ok && d.event == ev {
+		return d
+	}
+	return &delivery{ctx: ctx, event: ev}
+}
+
+// mailbox queues events of an entity, handled in order by one worker at a time.
+type mailbox struct {
+	id    string
+	queue []*delivery
+}
+
+// offsetTracker track messages in flight of a partition, messages handled out of order,
+// committed is the offset before which all messages have been handled.
+type offsetTracker struct {
+	last      int64
+	committed int64
+	inflight  []int64
+	handled   map[int64]struct{}
+}
+
+func newOffsetTracker(committed int64) *offsetTracker {
+	return &offsetTracker{
+		last:      committed,
+		committed: committed,
+		handled:   make(map[int64]struct{}),
+	}
+}
+
+// deliver track offset in flight, returns false if offset has been delivered.
+func (t *offsetTracker) deliver(offset int64) bool {
+	if offset <= t.last {
+		return false
+	}
+
+	t.last = offset
+	t.inflight = append(t.inflight, offset)
+	return true
+}
+
+// complete mark offset handled, returns the committed offset.
+func (t *offsetTracker) complete(offset int64) int64 {
+	t.handled[offset] = struct{}{}
+	for len(t.inflight) > 0 {
+		head := t.inflight[0]
+		if _, ok := t.handled[head]; !ok {
+			break
+		}
+
+		delete(t.handled, head)
+		t.committed = head
+		t.inflight = t.inflight[1:]
+	}
+	return t.committed
+}
+
+// WithWorkers bound goroutines handling events of the runtime.
+func WithWorkers(workers int) Option {
+	return func(r *Runtime) {
+		if workers > 0 {
+			r.workers = workers
+		}
+	}
+}
+
+// WithQueueSize bound messages queued in the runtime, delivering blocks while queue is full.
+func WithQueueSize(size int) Option {
+	return func(r *Runtime) {
+		if size > 0 {
+			r.queueSize = size
+		}
+	}
+}
+
+// DeliveredEvent queue message into the mailbox of the entity, blocks while the queue is full.
+// done called after message handled, messages of different entities handled concurrently.
+func (r *Runtime) DeliveredEvent(ctx context.Context, msg *sarama.ConsumerMessage, done DoneFunc) error {
+	select {
+	case <-ctx.Done():
+		return errors.Wrap(ctx.Err(), "deliver message")
+	case <-r.ctx.Done():
+		return errors.Wrap(r.ctx.Err(), "deliver message")
+	case r.slots <- struct{}{}:
+	}
+
+	// skip messages reflected in restored snapshot or in flight.
+	if !r.track(msg.Partition, msg.Offset) {
+		log.L().Debug("skip handled message", logf.RID(r.id),
+			logf.Partition(msg.Partition), logf.Offset(msg.Offset))
+		<-r.slots
+		r.ack(msg.Partition, done)
+		return nil
+	}
+
+	var ev v1.ProtoEvent
+	msgCtx := withMessage(context.Background(), &message{Partition: msg.Partition, Offset: msg.Offset})
+	if err := v1.Unmarshal(msg.Value, &ev); nil != err {
+		log.L().Error("decode Event", logf.Error(err),
+			logf.Message(string(msg.Value)), logf.RID(r.id))
+		r.putDeadLetter(msgCtx, &repository.DeadLetter{
+			Handler:   handlerDecode,
+			Error:     err.Error(),
+			Partition: msg.Partition,
+			Offset:    msg.Offset,
+			Event:     msg.Value,
+		})
+		r.complete(&delivery{msg: msg, done: done})
+		return nil
+	}
+
+	d := &delivery{msg: msg, event: &ev, done: done}
+	d.ctx = withDelivery(msgCtx, d)
+	r.enqueue(d)
+	return nil
+}
+
+// mailboxID returns the entity which event queued by, cache events queued by sender.
+func mailboxID(ev v1.Event) string {
+	if v1.ETCache == ev.Type() {
+		return ev.Attr(v1.MetaSender)
+	}
+	return ev.Entity()
+}
+
+func (r *Runtime) enqueue(d *delivery) {
+	id := mailboxID(d.event)
+	r.qlock.Lock()
+	mb, running := r.mailboxes[id]
+	if !running {
+		mb = &mailbox{id: id}
+		r.mailboxes[id] = mb
+	}
+	mb.queue = append(mb.queue, d)
+	r.queued++
+	r.collectQueue()
+	r.qlock.Unlock()
+
+	// mailbox scheduled already.
+	if !running {
+		r.ready <- mb
+	}
+}
+
+func (r *Runtime) work() {
+	for {
+		select {
+		case <-r.ctx.Done():
+			return
+		case mb := <-r.ready:
+			r.serve(mb)
+		}
+	}
+}
+
+// serve handle the head of mailbox, reschedule mailbox if more events queued.
+func (r *Runtime) serve(mb *mailbox) {
+	r.qlock.Lock()
+	d := mb.queue[0]
+	mb.queue = mb.queue[1:]
+	r.queued--
+	r.collectQueue()
+	r.qlock.Unlock()
+
+	// tasks executed exclusively, see Execute.
+	r.exec.RLock()
+	r.HandleEvent(d.ctx, d.event)
+	held := d.held
+	r.exec.RUnlock()
+
+	r.qlock.Lock()
+	empty := len(mb.queue) == 0
+	if empty {
+		delete(r.mailboxes, mb.id)
+		r.collectQueue()
+	}
+	r.qlock.Unlock()
+
+	if !empty {
+		r.ready <- mb
+	}
+
+	// release slot of message held, so that the transaction can be finished,
+	// offset committed after replayed, the message redelivered if crashed.
+	if held {
+		<-r.slots
+		return
+	}
+	r.complete(d)
+}
+
+// complete release queue slot and commit offset of handled message.
+func (r *Runtime) complete(d *delivery) {
+	<-r.slots
+	r.commit(d)
+}
+
+// commit offset of handled message, once history of the message flushed.
+func (r *Runtime) commit(d *delivery) {
+	r.qlock.Lock()
+	committed := r.trackers[d.msg.Partition].complete(d.msg.Offset)
+	r.qlock.Unlock()
+
+	r.afterHistory(func() {
+		committed = r.setOffset(d.msg.Partition, committed)
+		if nil != d.done {
+			d.done(committed)
+		}
+	})
+}
+
+// track offset of delivered message, returns false if the message handled or in flight.
+func (r *Runtime) track(partition int32, offset int64) bool {
+	r.lock.RLock()
+	last, has := r.offsets[partition]
+	r.lock.RUnlock()
+
+	r.qlock.Lock()
+	defer r.qlock.Unlock()
+	tracker, ok := r.trackers[partition]
+	if !ok {
+		if !has {
+			last = offset - 1
+		}
+		tracker = newOffsetTracker(last)
+		r.trackers[partition] = tracker
+	}
+	return tracker.deliver(offset)
+}
+
+func (r *Runtime) ack(partition int32, done DoneFunc) {
+	r.qlock.Lock()
+	committed := r.trackers[partition].committed
+	r.qlock.Unlock()
+	if nil != done {
+		done(committed)
+	}
+}
+
+// inUse reports whether events of the entity queued or being handled.
+func (r *Runtime) inUse(id string) bool {
+	r.qlock.Lock()
+	defer r.qlock.Unlock()
+	_, ok := r.mailboxes[id]
+	return ok
+}
+
+// collectQueue must be called with qlock held.
+func (r *Runtime) collectQueue() {
+	metrics.CollectorRuntimeQueueDepth.WithLabelValues(r.id).Set(float64(r.queued))
+	metrics.CollectorRuntimeMailboxes.WithLabelValues(r.id).Set(float64(len(r.mailboxes)))
+}
@@ -0,0 +1,167 @@
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/stretchr/testify/assert"
+	v1 "github.com/tkeel-io/core/api/core/v1"
+	"github.com/tkeel-io/core/pkg/placement"
+	xjson "github.com/tkeel-io/core/pkg/util/json"
+)
+
+func newDeliveryMessage(t *testing.T, offset int64, entityID string, value int) *sarama.ConsumerMessage {
+	bytes, err := v1.Marshal(&v1.ProtoEvent{
+		Id: fmt.Sprintf("ev-%d", offset),
+		Metadata: map[string]string{
+			v1.MetaType:     string(v1.ETEntity),
+			v1.MetaEntityID: entityID,
+		},
+		Data: &v1.ProtoEvent_Patches{
+			Patches: &v1.PatchDatas{Patches: []*v1.PatchData{{
+				Path:     "properties.seq",
+				Operator: xjson.OpAdd.String(),
+				Value:    []byte(fmt.Sprintf("%d", value)),
+			}}},
+		},
+	})
+	assert.Nil(t, err)
+	return &sarama.ConsumerMessage{Partition: 0, Offset: offset, Value: bytes}
+}
+
+func Test_offsetTracker(t *testing.T) {
+	tracker := newOffsetTracker(9)
+	assert.False(t, tracker.deliver(9))
+	assert.True(t, tracker.deliver(10))
+	assert.True(t, tracker.deliver(11))
+	assert.True(t, tracker.deliver(12))
+	assert.False(t, tracker.deliver(11))
+
+	// handled out of order, committed until contiguous.
+	assert.Equal(t, int64(9), tracker.complete(12))
+	assert.Equal(t, int64(10), tracker.complete(10))
+	assert.Equal(t, int64(12), tracker.complete(11))
+	assert.Len(t, tracker.handled, 0)
+}
+
+func TestRuntime_DeliveredEvent(t *testing.T) {
+	placement.Initialize()
+	placement.Global().Append(placement.Info{ID: "core-0", Flag: true})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	rt := NewRuntime(ctx, EntityResource{}, "core-0", &dispatcherMock{}, newRebalanceRepo(t),
+		WithWorkers(4), WithQueueSize(4))
+	for i := 0; i < 4; i++ {
+		id := fmt.Sprintf("iotd-%d", i)
+		en, err := NewEntity(id, []byte(`{"properties":{"seq":[]}}`))
+		assert.Nil(t, err)
+		rt.setEntity(id, en, 0)
+	}
+
+	var lock sync.Mutex
+	var wg sync.WaitGroup
+	committed := int64(-1)
+	for offset := int64(0); offset < 40; offset++ {
+		wg.Add(1)
+		msg := newDeliveryMessage(t, offset, fmt.Sprintf("iotd-%d", offset%4), int(offset/4))
+		assert.Nil(t, rt.DeliveredEvent(ctx, msg, func(offset int64) {
+			lock.Lock()
+			defer lock.Unlock()
+			assert.GreaterOrEqual(t, offset, committed)
+			committed = offset
+			wg.Done()
+		}))
+	}
+	wg.Wait()
+
+	assert.Equal(t, int64(39), committed)
+	assert.Equal(t, map[int32]int64{0: 39}, rt.Offsets())
+	for i := 0; i < 4; i++ {
+		en, has := rt.getEntity(fmt.Sprintf("iotd-%d", i))
+		assert.True(t, has)
+		assert.Equal(t, "[0,1,2,3,4,5,6,7,8,9]", en.Get("properties.seq").String())
+	}
+
+	// redelivered messages skipped.
+	done := make(chan int64, 1)
+	assert.Nil(t, rt.DeliveredEvent(ctx, newDeliveryMessage(t, 3, "iotd-3", 100), func(offset int64) { done <- offset }))
+	assert.Equal(t, int64(39), <-done)
+}
+
+func TestRuntime_DeliveredEventBackpressure(t *testing.T) {
+	placement.Initialize()
+	placement.Global().Append(placement.Info{ID: "core-0", Flag: true})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	rt := NewRuntime(ctx, EntityResource{}, "core-0", &dispatcherMock{}, newRebalanceRepo(t),
+		WithWorkers(1), WithQueueSize(1))
+
+	// hold workers by an exclusive task.
+	running, release := make(chan struct{}), make(chan struct{})
+	go rt.Execute(func() {
+		close(running)
+		<-release
+	})
+	<-running
+
+	done := make(chan int64, 2)
+	assert.Nil(t, rt.DeliveredEvent(ctx, newDeliveryMessage(t, 0, "iotd-0", 0), func(offset int64) { done <- offset }))
+
+	// queue full, delivering blocks until timeout.
+	timeout, cancelTimeout := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancelTimeout()
+	assert.NotNil(t, rt.DeliveredEvent(timeout, newDeliveryMessage(t, 1, "iotd-1", 0), nil))
+
+	close(release)
+	assert.Equal(t, int64(0), <-done)
+	assert.Nil(t, rt.DeliveredEvent(ctx, newDeliveryMessage(t, 1, "iotd-1", 0), func(offset int64) { done <- offset }))
+	assert.Equal(t, int64(1), <-done)
+}
+
+func TestRuntime_DeliveredEventHeld(t *testing.T) {
+	placement.Initialize()
+	placement.Global().Append(placement.Info{ID: "core-0", Flag: true})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	rt := NewRuntime(ctx, EntityResource{}, "core-0", &dispatcherMock{}, newRebalanceRepo(t),
+		WithWorkers(1), WithQueueSize(1))
+	en, err := NewEntity("iotd-1", []byte(`{"id":"iotd-1","properties":{"temp":20}}`))
+	assert.Nil(t, err)
+	rt.setEntity("iotd-1", en, 0)
+
+	deliver := func(offset int64, ev *v1.ProtoEvent) int64 {
+		bytes, err := v1.Marshal(ev)
+		assert.Nil(t, err)
+		done := make(chan int64, 1)
+		assert.Nil(t, rt.DeliveredEvent(ctx, &sarama.ConsumerMessage{Partition: 0, Offset: offset, Value: bytes},
+			func(committed int64) { done <- committed }))
+		select {
+		case committed := <-done:
+			return committed
+		case <-time.After(100 * time.Millisecond):
+			return -1
+		}
+	}
+
+	assert.Equal(t, int64(0), deliver(0, newTxEvent("tx-1", "req-1", v1.TxPrepare,
+		&v1.PatchData{Path: "properties.temp", Operator: "replace", Value: []byte("50")})))
+
+	// offset of message held uncommitted, slot released for the commit.
+	assert.Equal(t, int64(-1), deliver(1, newTxEvent("", "req-2", "",
+		&v1.PatchData{Path: "properties.temp", Operator: "replace", Value: []byte("60")})))
+	assert.Equal(t, map[int32]int64{0: 0}, rt.Offsets())
+
+	// committed after held message replayed.
+	assert.Equal(t, int64(2), deliver(2, newTxEvent("tx-1", "req-3", v1.TxCommit)))
+	assert.Equal(t, map[int32]int64{0: 2}, rt.Offsets())
+	en, has := rt.getEntity("iotd-1")
+	assert.True(t, has)
+	assert.Equal(t, "60", en.Get("properties.temp").String())
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
@@ -32,6 +33,8 @@ const (
 	FieldProperties  string = "properties"
 	FieldRawData     string = "properties.rawData"
 	FieldKeyWords    string = "search_model"
+	FieldWindows     string = "windows"
+	FieldFanIns      string = "fanins"
 	// FieldEntitySource string = "entity_source".
 
 )
@@ -44,6 +47,9 @@ type entity struct {
 	id              string
 	state           tdtl.Collect
 	pathConstructor PathConstructor
+	// lock guards state, state replaced by a modified copy so that
+	// entity can be read by other workers while handling events.
+	lock sync.RWMutex
 }
 
 func DefaultEntity(id string) Entity {
@@ -77,31 +83,86 @@ func (e *entity) Get(path string) tdtl.Node {
 			return ret
 		}
 
-		ret = e.state.Get(path)
+		ret = e.get(path)
 		schemeCache.Set(e.id, path, ret)
 		return ret
 	}
+	return e.get(path)
+}
+
+func (e *entity) get(path string) tdtl.Node {
+	e.lock.RLock()
+	defer e.lock.RUnlock()
 	return e.state.Get(path)
 }
 
+func (e *entity) copyState() *tdtl.Collect {
+	e.lock.RLock()
+	defer e.lock.RUnlock()
+	return e.state.Copy()
+}
+
+func (e *entity) setState(cc *tdtl.Collect) {
+	e.lock.Lock()
+	e.state = *cc
+	e.lock.Unlock()
+}
+
 func (e *entity) Handle(ctx context.Context, feed *Feed) *Feed { //nolint
 	if nil != feed.Err {
 		return feed
 	}
 
+	// check version precondition.
+	if err := checkVersion(e, feed.Event); nil != err {
+		log.L().Warn("update entity", logf.Eid(e.id), logf.Reason(err.Error()),
+			logf.String("if_version", feed.Event.Attr(v1.MetaIfVersion)), logf.Event(feed.Event))
+		feed.Err = err
+		feed.Patches = []Patch{}
+		feed.State = e.Raw()
+		return feed
+	}
+
 	changes := []Patch{}
 	pc := feed.Event.Attr(v1.MetaPathConstructor)
 
-	cc := e.state.Copy()
+	cc := e.copyState()
 	cleanSchemaCache := false
 	for _, patch := range feed.Patches {
 		if isFieldScheme(patch.Path) {
 			cleanSchemaCache = true
 		}
+
+		// scheme of template merged into scheme, overrides preserved.
+		if FieldTemplateScheme == patch.Path && xjson.OpReplace == patch.Op {
+			if inheritScheme(cc, patch.Value, bornTemplate == feed.Event.Attr(v1.MetaBorn)) {
+				cleanSchemaCache = true
+				changes = append(changes,
+					Patch{Op: xjson.OpReplace, Path: FieldScheme, Value: cc.Get(FieldScheme)})
+			}
+			continue
+		}
 		switch patch.Op {
 		case xjson.OpAdd:
 			cc.Append(patch.Path, patch.Value)
 		case xjson.OpCopy:
+			// copy without from path only clip properties.
+			if patch.From == "" {
+				break
+			}
+			fallthrough
+		case xjson.OpTest, xjson.OpMove:
+			// abort all patches if any operation failed.
+			ret, err := applyJSONPatch(cc, patch)
+			if nil != err {
+				log.L().Warn("update entity", logf.Eid(e.id), logf.Reason(err.Error()),
+					logf.Any("patches", feed.Patches), logf.Event(feed.Event))
+				feed.Err = err
+				feed.Patches = []Patch{}
+				feed.State = e.Raw()
+				return feed
+			}
+			cc = ret
 		case xjson.OpMerge:
 			var err error
 			if patch.Value.Type() != tdtl.Null {
@@ -135,7 +196,10 @@ func (e *entity) Handle(ctx context.Context, feed *Feed) *Feed { //nolint
 					logf.Any("patches", feed.Patches), logf.Event(feed.Event))
 			}
 		default:
-			return &Feed{Err: xerrors.ErrPatchPathInvalid}
+			feed.Err = xerrors.ErrPatchPathInvalid
+			feed.Patches = []Patch{}
+			feed.State = e.Raw()
+			return feed
 		}
 
 		if nil != cc.Error() {
@@ -152,6 +216,19 @@ func (e *entity) Handle(ctx context.Context, feed *Feed) *Feed { //nolint
 					Path: strings.Join([]string{patch.Path, string(key)}, "."),
 				})
 			})
+		case xjson.OpTest:
+		case xjson.OpMove:
+			changes = append(changes,
+				Patch{Op: xjson.OpRemove, Path: patch.From},
+				Patch{Op: xjson.OpReplace, Path: patch.Path, Value: cc.Get(patch.Path)})
+		case xjson.OpCopy:
+			if patch.From != "" {
+				changes = append(changes,
+					Patch{Op: xjson.OpReplace, Path: patch.Path, Value: cc.Get(patch.Path)})
+				break
+			}
+			changes = append(changes,
+				Patch{Op: patch.Op, Path: patch.Path, Value: patch.Value})
 		default:
 			changes = append(changes,
 				Patch{Op: patch.Op, Path: patch.Path, Value: patch.Value})
@@ -159,11 +236,14 @@ func (e *entity) Handle(ctx context.Context, feed *Feed) *Feed { //nolint
 	}
 
 	if cc.Error() == nil {
-		e.state = *cc
-		version := e.Version()
-		if version%100 == 99 {
-			e.cleanTelemetry()
+		// prune telemetry and raw data exceeding retention, removals recorded as changes.
+		if pruned := retain(cc, time.Now()); len(pruned) > 0 {
+			log.L().Debug("retention pruned", logf.Eid(e.id), logf.Value(pruned))
+			for _, path := range pruned {
+				changes = append(changes, Patch{Op: xjson.OpRemove, Path: path})
+			}
 		}
+		e.setState(cc)
 		if cleanSchemaCache {
 			schemeCache.Delete(e.id)
 		}
@@ -181,6 +261,70 @@ func (e *entity) Handle(ctx context.Context, feed *Feed) *Feed { //nolint
 	return feed
 }
 
+// checkVersion check the version precondition carried by event.
+func checkVersion(en Entity, ev v1.Event) error {
+	ifVersion := ev.Attr(v1.MetaIfVersion)
+	if ifVersion == "" || ifVersion == v1.IfVersionAny {
+		return nil
+	}
+
+	version, err := strconv.ParseInt(ifVersion, 10, 64)
+	if nil != err {
+		return errors.Wrap(xerrors.ErrInvalidRequest, "parse if version")
+	} else if version != en.Version() {
+		return xerrors.ErrEntityVersionConflict
+	}
+	return nil
+}
+
+// applyJSONPatch apply RFC 6902 test, move and copy operation on state.
+func applyJSONPatch(cc *tdtl.JSONNode, patch Patch) (*tdtl.JSONNode, error) {
+	op := jsonpatch.Operation{
+		"op":   rawMessage(patch.Op.String()),
+		"path": rawMessage(jsonPointer(patch.Path)),
+	}
+
+	switch patch.Op {
+	case xjson.OpTest:
+		value := jsonpatch.JsonRawMessage(patch.Value.Raw())
+		op["value"] = &value
+	default:
+		if !xjson.IsValidPath(patch.From) {
+			return nil, xerrors.ErrPatchPathInvalid
+		}
+		op["from"] = rawMessage(jsonPointer(patch.From))
+	}
+
+	bytes, err := jsonpatch.Patch{op}.Apply(cc.Raw())
+	if nil != err {
+		log.L().Debug("apply json patch", logf.Path(patch.Path),
+			logf.String("op", patch.Op.String()), logf.Reason(err.Error()))
+		if patch.Op == xjson.OpTest {
+			return nil, xerrors.ErrPatchTestFailed
+		}
+		return nil, xerrors.ErrPatchPathInvalid
+	}
+	return tdtl.New(bytes), nil
+}
+
+func rawMessage(s string) *jsonpatch.JsonRawMessage {
+	bytes, _ := json.Marshal(s)
+	raw := jsonpatch.JsonRawMessage(bytes)
+	return &raw
+}
+
+// jsonPointer convert entity path into json pointer, e.g. properties.a[0] -> /properties/a/0.
+func jsonPointer(path string) string {
+	path = strings.ReplaceAll(path, "[", ".")
+	path = strings.ReplaceAll(path, "]", "")
+	segments := strings.Split(path, ".")
+	for index := range segments {
+		segments[index] = strings.ReplaceAll(segments[index], "~", "~0")
+		segments[index] = strings.ReplaceAll(segments[index], "/", "~1")
+	}
+	return "/" + strings.Join(segments, "/")
+}
+
 func merge(cc *tdtl.JSONNode, patch Patch, e Entity, feed *Feed) error {
 	tc := cc.Get(patch.Path)
 	if tc.Type() == tdtl.Null {
@@ -225,26 +369,27 @@ func merge1(cc *tdtl.JSONNode, patch Patch, e Entity, feed *Feed) error {
 }
 
 func (e *entity) Raw() []byte {
-	return e.state.Copy().Raw()
+	return e.copyState().Raw()
 }
 
 func (e *entity) Copy() Entity {
-	cp := e.state.Copy()
+	cp := e.copyState()
 	return &entity{
-		id:    e.id,
-		state: *cp,
+		id:              e.id,
+		state:           *cp,
+		pathConstructor: e.pathConstructor,
 	}
 }
 
 func (e *entity) Basic() *tdtl.Collect {
-	basic := e.state.Copy()
+	basic := e.copyState()
 	basic.Set("scheme", tdtl.New([]byte("{}")))
 	basic.Set("properties", tdtl.New([]byte("{}")))
 	return basic
 }
 
 func (e *entity) Tiled() tdtl.Node {
-	basic := e.state.Copy()
+	basic := e.copyState()
 	basic.Del(FieldScheme)
 	basic.Del(FieldProperties)
 	result := basic.Merge(tdtl.New(e.Properties().Raw()))
@@ -252,71 +397,53 @@ func (e *entity) Tiled() tdtl.Node {
 }
 
 func (e *entity) Type() string {
-	return e.state.Get(FieldType).String()
+	return e.get(FieldType).String()
 }
 
 func (e *entity) Owner() string {
-	return e.state.Get(FieldOwner).String()
+	return e.get(FieldOwner).String()
 }
 
 func (e *entity) Source() string {
-	return e.state.Get(FieldSource).String()
+	return e.get(FieldSource).String()
 }
 
 func (e *entity) Version() int64 {
-	version := e.state.Get(FieldVersion).String()
+	version := e.get(FieldVersion).String()
 	i, _ := strconv.ParseInt(version, 10, 64)
 	return i
 }
 
 func (e *entity) LastTime() int64 {
-	lastTime := e.state.Get(FieldLastTime).String()
+	lastTime := e.get(FieldLastTime).String()
 	i, _ := strconv.ParseInt(lastTime, 10, 64)
 	return i
 }
 
 func (e *entity) TemplateID() string {
-	return e.state.Get(FieldTemplate).String()
+	return e.get(FieldTemplate).String()
 }
 
 func (e *entity) Properties() tdtl.Node {
-	return e.state.Get("properties")
+	return e.get("properties")
 }
 
 func (e *entity) Scheme() tdtl.Node {
-	return e.state.Get("scheme")
+	return e.get("scheme")
 }
 
 func (e *entity) GetProp(key string) tdtl.Node {
-	return e.state.Get("properties." + key)
+	return e.get("properties." + key)
 }
 
 func (e *entity) Update() {
+	cc := e.copyState()
 	// update entity version.
 	lastTime := time.Now().UnixNano() / 1e6
-	e.state.Set(FieldVersion, tdtl.NewInt64(e.Version()+1))
+	cc.Set(FieldVersion, tdtl.NewInt64(e.Version()+1))
 	// update entity last_time.
-	e.state.Set(FieldLastTime, tdtl.NewInt64(lastTime))
-}
-
-func (e *entity) cleanTelemetry() {
-	delKeys := map[string]bool{}
-	tdtl.New(e.GetProp("telemetry").Raw()).
-		Foreach(func(key []byte, value *tdtl.Collect) {
-			delKeys[string(key)] = true
-		})
-	tdtl.New(e.Get("scheme.telemetry.define.fields").Raw()).
-		Foreach(func(key []byte, value *tdtl.Collect) {
-			delKeys[string(key)] = false // don't delete
-		})
-
-	cc := e.state.Copy()
-	for k, del := range delKeys {
-		if del {
-			cc.Del("properties.telemetry." + k)
-		}
-	}
-	e.state = *cc
+	cc.Set(FieldLastTime, tdtl.NewInt64(lastTime))
+	e.setState(cc)
 }
 
 func pathConstructor(pc v1.PathConstructor, destVal, setVal []byte, path string) (_ []byte, _ string, err error) {
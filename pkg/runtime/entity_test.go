@@ -22,6 +22,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	v1 "github.com/tkeel-io/core/api/core/v1"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
 	xjson "github.com/tkeel-io/core/pkg/util/json"
 	"github.com/tkeel-io/tdtl"
 )
@@ -192,3 +193,134 @@ func Test_isFieldScheme(t *testing.T) {
 		})
 	}
 }
+
+func TestEntity_HandleIfVersion(t *testing.T) {
+	en, err := NewEntity("en-123", []byte(`{"version": 3, "properties": {"temp": 20}}`))
+	assert.Nil(t, err)
+
+	newFeed := func(ifVersion string) *Feed {
+		return &Feed{
+			Event: &v1.ProtoEvent{
+				Metadata: map[string]string{v1.MetaIfVersion: ifVersion},
+			},
+			Patches: []Patch{{
+				Path:  "properties.temp",
+				Value: tdtl.New("50"),
+				Op:    xjson.OpReplace,
+			}},
+		}
+	}
+
+	ctx := context.Background()
+	got := en.Handle(ctx, newFeed("2"))
+	assert.Equal(t, xerrors.ErrEntityVersionConflict, got.Err)
+	assert.Equal(t, "20", tdtl.New(got.State).Get("properties.temp").String())
+	assert.Equal(t, int64(3), en.Version())
+
+	got = en.Handle(ctx, newFeed("3"))
+	assert.Nil(t, got.Err)
+	assert.Equal(t, "50", tdtl.New(got.State).Get("properties.temp").String())
+	assert.Equal(t, int64(4), en.Version())
+
+	// stale version after updated.
+	got = en.Handle(ctx, newFeed("3"))
+	assert.Equal(t, xerrors.ErrEntityVersionConflict, got.Err)
+
+	// any version of entity existing.
+	got = en.Handle(ctx, newFeed(v1.IfVersionAny))
+	assert.Nil(t, got.Err)
+	assert.Equal(t, int64(5), en.Version())
+}
+
+func TestEntity_HandleJSONPatch(t *testing.T) {
+	en, err := NewEntity("en-123", []byte(`{"version": 1, "properties": {"temp": 20, "metrics": {"cpu": 0.5}, "tags": ["a", "b"]}}`))
+	assert.Nil(t, err)
+
+	handle := func(patches ...Patch) *Feed {
+		return en.Handle(context.Background(), &Feed{Event: &v1.ProtoEvent{}, Patches: patches})
+	}
+
+	t.Run("test failed abort all patches", func(t *testing.T) {
+		got := handle(
+			Patch{Op: xjson.OpReplace, Path: "properties.temp", Value: tdtl.New("50")},
+			Patch{Op: xjson.OpTest, Path: "properties.temp", Value: tdtl.New("30")})
+		assert.Equal(t, xerrors.ErrPatchTestFailed, got.Err)
+		assert.Equal(t, "20", tdtl.New(got.State).Get("properties.temp").String())
+		assert.Equal(t, int64(1), en.Version())
+	})
+
+	t.Run("test missing path", func(t *testing.T) {
+		got := handle(Patch{Op: xjson.OpTest, Path: "properties.humidity", Value: tdtl.New("30")})
+		assert.Equal(t, xerrors.ErrPatchTestFailed, got.Err)
+	})
+
+	t.Run("test and move", func(t *testing.T) {
+		got := handle(
+			Patch{Op: xjson.OpTest, Path: "properties.metrics", Value: tdtl.New(`{"cpu": 0.5}`)},
+			Patch{Op: xjson.OpMove, Path: "properties.stats", From: "properties.metrics"})
+		assert.Nil(t, got.Err)
+		state := tdtl.New(got.State)
+		assert.Equal(t, tdtl.Null, state.Get("properties.metrics").Type())
+		assert.Equal(t, "0.5", state.Get("properties.stats.cpu").String())
+		assert.Equal(t, []Patch{
+			{Op: xjson.OpRemove, Path: "properties.metrics"},
+			{Op: xjson.OpReplace, Path: "properties.stats", Value: state.Get("properties.stats")},
+		}, got.Changes)
+	})
+
+	t.Run("copy from array element", func(t *testing.T) {
+		got := handle(Patch{Op: xjson.OpCopy, Path: "properties.tag", From: "properties.tags[1]"})
+		assert.Nil(t, got.Err)
+		assert.Equal(t, "b", tdtl.New(got.State).Get("properties.tag").String())
+		assert.Equal(t, "b", tdtl.New(got.State).Get("properties.tags[1]").String())
+	})
+
+	t.Run("copy without from", func(t *testing.T) {
+		version := en.Version()
+		got := handle(Patch{Op: xjson.OpCopy, Path: "properties.temp", Value: tdtl.New("null")})
+		assert.Nil(t, got.Err)
+		assert.Equal(t, "20", tdtl.New(got.State).Get("properties.temp").String())
+		assert.Equal(t, version+1, en.Version())
+	})
+
+	t.Run("copy string value without from", func(t *testing.T) {
+		got := handle(Patch{Op: xjson.OpCopy, Path: "properties.temp", Value: tdtl.New(`"properties.tags[1]"`)})
+		assert.Nil(t, got.Err)
+		assert.Equal(t, "20", tdtl.New(got.State).Get("properties.temp").String())
+	})
+
+	t.Run("move from missing path", func(t *testing.T) {
+		got := handle(Patch{Op: xjson.OpMove, Path: "properties.x", From: "properties.y"})
+		assert.Equal(t, xerrors.ErrPatchPathInvalid, got.Err)
+	})
+}
+
+func Test_jsonPointer(t *testing.T) {
+	assert.Equal(t, "/properties/a/b", jsonPointer("properties.a.b"))
+	assert.Equal(t, "/properties/a/0/b", jsonPointer("properties.a[0].b"))
+	assert.Equal(t, "/properties/a~1b/c~0", jsonPointer("properties.a/b.c~"))
+}
+
+func TestRuntime_deleteIfVersion(t *testing.T) {
+	rt := NewRuntime(context.Background(), EntityResource{}, "core-0", &forwardDispatcher{}, newRebalanceRepo(t))
+	newEvent := func(ifVersion string) v1.Event {
+		return &v1.ProtoEvent{
+			Id: "ev-1",
+			Metadata: map[string]string{
+				v1.MetaType:      string(v1.ETSystem),
+				v1.MetaEntityID:  "en-404",
+				v1.MetaIfVersion: ifVersion,
+			},
+			Data: &v1.ProtoEvent_SystemData{
+				SystemData: &v1.SystemData{Operator: string(v1.OpDelete)},
+			},
+		}
+	}
+
+	// precondition of entity not exists failed.
+	_, feed := rt.PrepareEvent(context.Background(), newEvent(v1.IfVersionAny))
+	assert.Equal(t, xerrors.ErrEntityVersionConflict, feed.Err)
+
+	_, feed = rt.PrepareEvent(context.Background(), newEvent("3"))
+	assert.Equal(t, xerrors.ErrEntityVersionConflict, feed.Err)
+}
@@ -0,0 +1,98 @@
+package runtime
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	logf "github.com/tkeel-io/core/pkg/logfield"
+	"github.com/tkeel-io/core/pkg/metrics"
+	"github.com/tkeel-io/kit/log"
+)
+
+func (r *Runtime) getEntity(id string) (Entity, bool) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	en, ok := r.entities[id]
+	return en, ok
+}
+
+func (r *Runtime) setEntity(id string, en Entity, size int64) {
+	r.lock.Lock()
+	r.entities[id] = en
+	r.entityIndex.Set(id, size)
+	count := len(r.entities)
+	r.lock.Unlock()
+	metrics.CollectorEntityCacheEntries.WithLabelValues(r.id, metrics.CacheTypeEntity).Set(float64(count))
+}
+
+func (r *Runtime) deleteEntity(id string) {
+	r.lock.Lock()
+	delete(r.entities, id)
+	delete(r.dirty, id)
+	r.entityIndex.Remove(id)
+	count := len(r.entities)
+	r.lock.Unlock()
+	metrics.CollectorEntityCacheEntries.WithLabelValues(r.id, metrics.CacheTypeEntity).Set(float64(count))
+}
+
+// persistentEntity persistent entity, entity keep dirty until persistent succeed.
+func (r *Runtime) persistentEntity(ctx context.Context, en Entity, feed *Feed) error {
+	var err error
+	raw := en.Raw()
+	if nil != r.entityResourcer.PersistentEntity {
+		err = r.entityResourcer.PersistentEntity(ctx, en, feed)
+	} else {
+		err = r.repository.PutEntity(ctx, en.ID(), raw)
+	}
+	if nil == err {
+		err = r.persistProcessed(ctx, en.ID())
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if nil != err {
+		log.L().Error("persistent entity", logf.RID(r.id), logf.Eid(en.ID()), logf.Error(err))
+		r.dirty[en.ID()] = struct{}{}
+		return errors.Wrap(err, "persistent entity")
+	}
+
+	delete(r.dirty, en.ID())
+	if _, ok := r.entities[en.ID()]; ok {
+		r.entityIndex.Set(en.ID(), int64(len(raw)))
+	}
+	return nil
+}
+
+// evictEntities evict the least recently used entities while over budget,
+// dirty entities are persisted before evicted.
+func (r *Runtime) evictEntities(ctx context.Context) {
+	for {
+		r.lock.RLock()
+		exceeded := r.entityLimit.exceeded(r.entityIndex.Len(), r.entityIndex.Size())
+		oldest, ok := r.entityIndex.Oldest()
+		en, has := r.entities[oldest]
+		_, dirty := r.dirty[oldest]
+		r.lock.RUnlock()
+		if !exceeded || !ok {
+			return
+		}
+
+		// entity in use by other workers, retry next time.
+		if r.inUse(oldest) {
+			return
+		}
+
+		if has && dirty {
+			if err := r.persistentEntity(ctx, en, &Feed{EntityID: oldest, State: en.Raw()}); nil != err {
+				// keep dirty entity in memory, retry next time.
+				log.L().Warn("evict entity, persistent dirty entity",
+					logf.RID(r.id), logf.Eid(oldest), logf.Reason(err.Error()))
+				return
+			}
+		}
+
+		log.L().Debug("evict entity", logf.RID(r.id), logf.Eid(oldest))
+		r.deleteEntity(oldest)
+		metrics.CollectorEntityCache.WithLabelValues(r.id, metrics.CacheTypeEntity, metrics.CacheResultEvict).Inc()
+	}
+}
@@ -0,0 +1,88 @@
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/tkeel-io/core/pkg/placement"
+)
+
+func TestRuntime_evictEntities(t *testing.T) {
+	placement.Initialize()
+	placement.Global().Append(placement.Info{ID: "core-0", Flag: true})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	repo := newRebalanceRepo(t)
+	for index := 0; index < 3; index++ {
+		entityID := fmt.Sprintf("iotd-%d", index)
+		assert.Nil(t, repo.PutEntity(ctx, entityID, []byte(`{"properties":{"temp":20}}`)))
+	}
+
+	failed := true
+	persisted := []string{}
+	rt := NewRuntime(ctx, EntityResource{
+		PersistentEntity: func(ctx context.Context, en Entity, feed *Feed) error {
+			if failed {
+				return errors.New("store unavailable")
+			}
+			persisted = append(persisted, en.ID())
+			return nil
+		},
+	}, "core-0", &dispatcherMock{}, repo, WithEntityLimit(CacheLimit{MaxEntries: 2}))
+
+	en0, err := rt.LoadEntity("iotd-0")
+	assert.Nil(t, err)
+	_, err = rt.LoadEntity("iotd-1")
+	assert.Nil(t, err)
+
+	// iotd-0 become dirty and the most recently used.
+	assert.NotNil(t, rt.persistentEntity(ctx, en0, &Feed{EntityID: "iotd-0"}))
+	_, err = rt.LoadEntity("iotd-0")
+	assert.Nil(t, err)
+
+	// clean iotd-1 evicted without persistent.
+	_, err = rt.LoadEntity("iotd-2")
+	assert.Nil(t, err)
+	rt.evictEntities(ctx)
+	assert.NotContains(t, rt.entities, "iotd-1")
+	assert.Len(t, rt.entities, 2)
+
+	// dirty iotd-0 kept while persistent failed.
+	_, err = rt.LoadEntity("iotd-1")
+	assert.Nil(t, err)
+	rt.evictEntities(ctx)
+	assert.Contains(t, rt.entities, "iotd-0")
+	assert.Len(t, rt.entities, 3)
+
+	// dirty iotd-0 persisted before evicted.
+	failed = false
+	rt.evictEntities(ctx)
+	assert.Equal(t, []string{"iotd-0"}, persisted)
+	assert.NotContains(t, rt.entities, "iotd-0")
+	assert.Len(t, rt.entities, 2)
+}
+
+func TestCache_evict(t *testing.T) {
+	ctx := context.Background()
+	repo := newRebalanceRepo(t)
+	for index := 0; index < 3; index++ {
+		entityID := fmt.Sprintf("iotd-%d", index)
+		assert.Nil(t, repo.PutEntity(ctx, entityID, []byte(`{"properties":{"temp":20}}`)))
+	}
+
+	cache := NewCache("core-0", repo, CacheLimit{MaxEntries: 2})
+	for _, entityID := range []string{"iotd-0", "iotd-1", "iotd-0", "iotd-2"} {
+		_, err := cache.Load(ctx, entityID)
+		assert.Nil(t, err)
+	}
+
+	entities, err := cache.Snapshot()
+	assert.Nil(t, err)
+	assert.Len(t, entities, 2)
+	assert.Contains(t, entities, "iotd-0")
+	assert.Contains(t, entities, "iotd-2")
+}
@@ -0,0 +1,516 @@
+package runtime
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	v1 "github.com/tkeel-io/core/api/core/v1"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	logf "github.com/tkeel-io/core/pkg/logfield"
+	"github.com/tkeel-io/core/pkg/mapper/expression"
+	"github.com/tkeel-io/core/pkg/metrics"
+	"github.com/tkeel-io/core/pkg/placement"
+	"github.com/tkeel-io/core/pkg/repository"
+	"github.com/tkeel-io/core/pkg/util"
+	xjson "github.com/tkeel-io/core/pkg/util/json"
+	"github.com/tkeel-io/kit/log"
+	"github.com/tkeel-io/tdtl"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// exprFanIns are members of fan-ins called by the expression, kept by runtime of the target entity,
+// persisted into the target entity so that members survive restarts.
+type exprFanIns struct {
+	Expression string                         `json:"expression"`
+	Members    map[string]*expression.Members `json:"members"`
+}
+
+// fanInPath returns path of expression members in the target entity.
+func fanInPath(expr repository.Expression) string {
+	return FieldFanIns + "." + strings.ReplaceAll(expr.Path, ".", "/")
+}
+
+// loadFanIns returns members of the expression, restored from the target entity if not kept,
+// members of the expression changed dropped.
+func (r *Runtime) loadFanIns(expr repository.Expression) *exprFanIns {
+	if state, has := r.fanIns[expr.ID]; has && state.Expression == expr.Expression {
+		return state
+	}
+
+	state := &exprFanIns{}
+	if en, err := r.LoadEntity(expr.EntityID); nil != err {
+		log.L().Warn("restore expression members", logf.ID(expr.ID),
+			logf.Eid(expr.EntityID), logf.Reason(err.Error()))
+	} else if raw := en.Get(fanInPath(expr)).Raw(); len(raw) > 0 {
+		if err = json.Unmarshal(raw, state); nil != err {
+			log.L().Warn("restore expression members", logf.ID(expr.ID),
+				logf.Eid(expr.EntityID), logf.Reason(err.Error()))
+		}
+	}
+
+	if state.Expression != expr.Expression || nil == state.Members {
+		state = &exprFanIns{Expression: expr.Expression, Members: make(map[string]*expression.Members)}
+	}
+
+	if nil == r.fanIns {
+		r.fanIns = make(map[string]*exprFanIns)
+	}
+	r.fanIns[expr.ID] = state
+	return state
+}
+
+// fanInFuncs returns funcs merged with fan-in aggregations over members of the expression.
+func (r *Runtime) fanInFuncs(expr repository.Expression, funcs map[string]tdtl.ContextFunc) map[string]tdtl.ContextFunc {
+	merged := expression.FanInFuncs(func(key string) *expression.Window {
+		r.flock.Lock()
+		defer r.flock.Unlock()
+		if members, has := r.loadFanIns(expr).Members[key]; has {
+			return members.Stats()
+		}
+		return nil
+	})
+
+	for name, fn := range funcs {
+		merged[name] = fn
+	}
+	return merged
+}
+
+// fanInPatch returns patch persisting members of the expression, nil if no members kept.
+func (r *Runtime) fanInPatch(expr repository.Expression) *v1.PatchData {
+	r.flock.Lock()
+	state, has := r.fanIns[expr.ID]
+	if !has {
+		r.flock.Unlock()
+		return nil
+	}
+	bytes, err := json.Marshal(state)
+	r.flock.Unlock()
+
+	if nil != err {
+		log.L().Warn("persist expression members", logf.ID(expr.ID),
+			logf.Eid(expr.EntityID), logf.Reason(err.Error()))
+		return nil
+	}
+
+	return &v1.PatchData{
+		Operator: xjson.OpReplace.String(),
+		Path:     fanInPath(expr),
+		Value:    bytes,
+	}
+}
+
+// setFanInExpr register expression calling fan-ins, entities in the runtime selected forwarded.
+func (r *Runtime) setFanInExpr(exprInfo ExpressionInfo) {
+	r.flock.Lock()
+	defer r.flock.Unlock()
+	if len(exprInfo.fanIns) == 0 {
+		delete(r.fanInExprs, exprInfo.ID)
+		return
+	}
+
+	if nil == r.fanInExprs {
+		r.fanInExprs = make(map[string]ExpressionInfo)
+	}
+	r.fanInExprs[exprInfo.ID] = exprInfo
+}
+
+// removeFanIns drop expression and members kept of the expression.
+func (r *Runtime) removeFanIns(exprID string) {
+	r.flock.Lock()
+	delete(r.fanInExprs, exprID)
+	delete(r.fanIns, exprID)
+	r.flock.Unlock()
+}
+
+func (r *Runtime) listFanInExprs() []ExpressionInfo {
+	r.flock.Lock()
+	defer r.flock.Unlock()
+	exprs := make([]ExpressionInfo, 0, len(r.fanInExprs))
+	for _, exprInfo := range r.fanInExprs {
+		exprs = append(exprs, exprInfo)
+	}
+	return exprs
+}
+
+// handleFanIn forward values of entity selected to runtimes of fan-in expressions,
+// entity left if no longer selected or deleted.
+func (r *Runtime) handleFanIn(ctx context.Context, feed *Feed) *Feed {
+	if nil != feed.Err || nil == feed.Event {
+		return feed
+	}
+
+	exprs := r.listFanInExprs()
+	if len(exprs) == 0 {
+		return feed
+	}
+
+	en, err := NewEntity(feed.EntityID, feed.State)
+	if nil != err {
+		log.L().Warn("handle fan-in", logf.Eid(feed.EntityID), logf.Reason(err.Error()))
+		return feed
+	}
+
+	// entity created or deleted, or attributes selected by changed.
+	var created, deleted, reselect bool
+	if ev, ok := feed.Event.(v1.SystemEvent); ok && v1.ETSystem == feed.Event.Type() {
+		created = v1.OpCreate == v1.SystemOp(ev.Action().Operator)
+		deleted = v1.OpDelete == v1.SystemOp(ev.Action().Operator)
+	}
+	for _, change := range feed.Changes {
+		switch change.Path {
+		case FieldType, FieldOwner, FieldTemplate:
+			reselect = true
+		}
+	}
+
+	for _, exprInfo := range exprs {
+		var patches []*v1.PatchData
+		if deleted {
+			patches = leftPatches(exprInfo, en)
+		} else {
+			patches = r.memberPatches(ctx, exprInfo, en, feed.Changes, created || reselect, reselect)
+		}
+		r.dispatchFanIn(ctx, exprInfo, en.ID(), feed.TTL, patches)
+	}
+	return feed
+}
+
+// memberPatches returns patches of fan-ins the entity joined or changed,
+// fan-ins not selecting the entity left if leave.
+func (r *Runtime) memberPatches(ctx context.Context, exprInfo ExpressionInfo, en Entity, changes []Patch, reselect, leave bool) []*v1.PatchData {
+	var patches []*v1.PatchData
+	for _, fanIn := range exprInfo.fanIns {
+		if !reselect && !touched(changes, fanIn.Path) {
+			continue
+		}
+
+		if r.selects(ctx, fanIn.Selector, en) {
+			if val := en.Get(fanIn.Path); tdtl.Undefined != val.Type() && tdtl.Null != val.Type() {
+				patches = append(patches, &v1.PatchData{
+					Operator: xjson.OpReplace.String(),
+					Path:     fanIn.Key(),
+					Value:    val.Raw(),
+				})
+				continue
+			}
+		} else if !leave {
+			continue
+		}
+
+		patches = append(patches, &v1.PatchData{
+			Operator: xjson.OpRemove.String(),
+			Path:     fanIn.Key(),
+		})
+	}
+	return patches
+}
+
+// leftPatches returns patches of fan-ins the entity deleted left, relationships removed along.
+func leftPatches(exprInfo ExpressionInfo, en Entity) []*v1.PatchData {
+	var patches []*v1.PatchData
+	for _, fanIn := range exprInfo.fanIns {
+		if fanIn.Selector.Match(en.Type(), en.TemplateID(), en.Owner()) {
+			patches = append(patches, &v1.PatchData{
+				Operator: xjson.OpRemove.String(),
+				Path:     fanIn.Key(),
+			})
+		}
+	}
+	return patches
+}
+
+func touched(changes []Patch, path string) bool {
+	for _, change := range changes {
+		if strings.HasPrefix(path+".", change.Path+".") ||
+			strings.HasPrefix(change.Path+".", path+".") {
+			return true
+		}
+	}
+	return false
+}
+
+// selects reports whether entity selected by the selector.
+func (r *Runtime) selects(ctx context.Context, s expression.Selector, en Entity) bool {
+	if !s.Match(en.Type(), en.TemplateID(), en.Owner()) {
+		return false
+	} else if s.RelatedFrom == "" {
+		return true
+	}
+	return r.related(ctx, &repository.Relationship{From: s.RelatedFrom, Type: s.RelatedType, To: en.ID()})
+}
+
+func relationKey(rel *repository.Relationship) string {
+	return rel.From + "/" + rel.Type + "/" + rel.To
+}
+
+// related reports whether the relationship exists, relationships cached until changed.
+func (r *Runtime) related(ctx context.Context, rel *repository.Relationship) bool {
+	key := relationKey(rel)
+	r.flock.Lock()
+	related, has := r.relations[key]
+	r.flock.Unlock()
+	if has {
+		return related
+	}
+
+	ret, err := r.repository.GetRelationship(ctx, &repository.Relationship{From: rel.From, Type: rel.Type, To: rel.To})
+	if nil != err && !errors.Is(err, xerrors.ErrResourceNotFound) {
+		log.L().Warn("get relationship", logf.Eid(rel.To), logf.Type(rel.Type),
+			logf.String("from", rel.From), logf.Reason(err.Error()))
+		return false
+	}
+
+	// key of resource matched by prefix.
+	related = nil == err && ret.From == rel.From && ret.Type == rel.Type && ret.To == rel.To
+	r.setRelation(rel, related)
+	return related
+}
+
+func (r *Runtime) setRelation(rel *repository.Relationship, related bool) {
+	r.flock.Lock()
+	if nil == r.relations {
+		r.relations = make(map[string]bool)
+	}
+	r.relations[relationKey(rel)] = related
+	r.flock.Unlock()
+}
+
+// OnRelationshipChanged re-select target entity of relationship by fan-ins selecting relationships.
+func (r *Runtime) OnRelationshipChanged(ctx context.Context, rel *repository.Relationship, related bool) {
+	r.setRelation(rel, related)
+	for _, exprInfo := range r.listFanInExprs() {
+		for _, fanIn := range exprInfo.fanIns {
+			if fanIn.Selector.RelatedFrom == rel.From && fanIn.Selector.RelatedType == rel.Type {
+				r.JoinFanIns(ctx, exprInfo, rel.To)
+				break
+			}
+		}
+	}
+}
+
+// JoinFanIns forward values of the entity if selected by fan-ins of the expression, otherwise left.
+func (r *Runtime) JoinFanIns(ctx context.Context, exprInfo ExpressionInfo, entityID string) {
+	en, err := r.LoadEntity(entityID)
+	if nil != err {
+		log.L().Warn("join fan-ins", logf.ID(exprInfo.ID),
+			logf.Eid(entityID), logf.Reason(err.Error()))
+		return
+	}
+
+	patches := r.memberPatches(ctx, exprInfo, en, nil, true, true)
+	r.dispatchFanIn(ctx, exprInfo, entityID, 0, patches)
+}
+
+func (r *Runtime) dispatchFanIn(ctx context.Context, exprInfo ExpressionInfo, entityID string, ttl int, patches []*v1.PatchData) {
+	if len(patches) == 0 {
+		return
+	}
+
+	log.L().Debug("dispatch fan-in", logf.ID(exprInfo.ID),
+		logf.Eid(entityID), logf.Target(exprInfo.EntityID), logf.Value(patches))
+	r.dispatcher.Dispatch(ctx, &v1.ProtoEvent{
+		Id:        util.IG().EvID(),
+		Timestamp: time.Now().UnixNano(),
+		Metadata: map[string]string{
+			v1.MetaType:         string(v1.ETFanIn),
+			v1.MetaBorn:         "handleFanIn",
+			v1.MetaEntityID:     exprInfo.EntityID,
+			v1.MetaSender:       entityID,
+			v1.MetaExpressionID: exprInfo.ID,
+			v1.MetaTTL:          strconv.Itoa(ttl + 1),
+		},
+		Data: &v1.ProtoEvent_Patches{
+			Patches: &v1.PatchDatas{
+				Patches: patches,
+			},
+		},
+	})
+}
+
+func (r *Runtime) handleFanInEvent(ctx context.Context, ev v1.Event) (*Execer, *Feed) {
+	e, _ := ev.(v1.PatchEvent)
+	state := DefaultEntity(ev.Entity())
+	execer := &Execer{
+		state:     state,
+		preFuncs:  []Handler{},
+		execFunc:  &handlerImpl{fn: r.handleMembers},
+		postFuncs: []Handler{},
+	}
+	return execer, &Feed{
+		TTL:      ev.TTL(),
+		Event:    ev,
+		State:    state.Raw(),
+		EntityID: ev.Entity(),
+		Patches:  conv(e.Patches()),
+	}
+}
+
+// handleMembers update members of the expression, and evaluate the expression incrementally.
+func (r *Runtime) handleMembers(ctx context.Context, feed *Feed) *Feed {
+	exprID := feed.Event.Attr(v1.MetaExpressionID)
+	senderID := feed.Event.Attr(v1.MetaSender)
+	log.L().Debug("handle fan-in members", logf.ID(exprID),
+		logf.Eid(feed.EntityID), logf.Sender(senderID))
+
+	r.flock.Lock()
+	exprInfo, has := r.fanInExprs[exprID]
+	r.flock.Unlock()
+	if !has || exprInfo.EntityID != feed.EntityID {
+		log.L().Warn("handle fan-in members, expression not exists",
+			logf.ID(exprID), logf.Eid(feed.EntityID), logf.Sender(senderID))
+		return feed
+	}
+
+	if r.maxTTL > 0 && feed.TTL >= r.maxTTL {
+		log.L().Warn("handle fan-in members, exceeding max ttl", logf.Eid(feed.EntityID),
+			logf.RID(r.id), logf.Any("ttl", feed.TTL), logf.Any("max_ttl", r.maxTTL))
+		metrics.CollectorComputedDropped.WithLabelValues(r.id, feed.EntityID).Inc()
+		return feed
+	}
+
+	r.flock.Lock()
+	state := r.loadFanIns(exprInfo.Expression)
+	for _, patch := range feed.Patches {
+		members, has := state.Members[patch.Path]
+		if !has {
+			members = &expression.Members{}
+			state.Members[patch.Path] = members
+		}
+
+		// members without numbers not aggregated.
+		val, isNumber := toFloat(patch.Value)
+		if xjson.OpRemove == patch.Op || !isNumber {
+			members.Remove(senderID)
+			continue
+		}
+		members.Set(senderID, val)
+	}
+	r.flock.Unlock()
+
+	// members persisted even if the result undefined.
+	result, err := r.evalExpression(ctx, exprInfo.Expression)
+	if nil != err {
+		log.L().Warn("eval expression", logf.ID(exprID),
+			logf.Eid(feed.EntityID), logf.Reason(err.Error()))
+		result = nil
+	}
+
+	// members persisted along with the result dispatched.
+	if nil != result && !r.admit(exprInfo.Expression, result, feed.TTL) {
+		return feed
+	}
+
+	r.dispatcher.Dispatch(ctx, &v1.ProtoEvent{
+		Id:        util.IG().EvID(),
+		Timestamp: time.Now().UnixNano(),
+		Metadata: map[string]string{
+			v1.MetaType:        string(v1.ETEntity),
+			v1.MetaBorn:        "handleMembers",
+			v1.MetaPartitionID: r.ID(),
+			v1.MetaEntityID:    exprInfo.EntityID,
+			v1.MetaTTL:         strconv.Itoa(feed.TTL + 1),
+		},
+		Data: &v1.ProtoEvent_Patches{
+			Patches: &v1.PatchDatas{
+				Patches: r.computedPatches(exprInfo.Expression, result),
+			},
+		},
+	})
+	return feed
+}
+
+func toFloat(n *tdtl.Collect) (float64, bool) {
+	switch n.Type() {
+	case tdtl.Int, tdtl.Float, tdtl.Number:
+		val, err := strconv.ParseFloat(string(n.Raw()), 64)
+		return val, nil == err
+	}
+	return 0, false
+}
+
+const selectPageSize = 100
+
+// discoverMembers join entities of runtimes in the node selected by fan-ins of the expression,
+// entities selected later joined when created or changed.
+func (n *Node) discoverMembers(ctx context.Context, exprInfo ExpressionInfo) {
+	members := make(map[string]bool)
+	for _, fanIn := range exprInfo.fanIns {
+		entityIDs, err := n.selectEntities(ctx, fanIn.Selector)
+		if nil != err {
+			log.L().Warn("discover fan-in members", logf.ID(exprInfo.ID),
+				logf.Eid(exprInfo.EntityID), logf.Reason(err.Error()))
+		}
+		for _, entityID := range entityIDs {
+			members[entityID] = true
+		}
+	}
+
+	for entityID := range members {
+		if rt, has := n.runtimes[placement.Global().Select(entityID).ID]; has {
+			rt.JoinFanIns(ctx, exprInfo, entityID)
+		}
+	}
+}
+
+// selectEntities returns entities selected, targets of relationships listed,
+// otherwise entities searched by type, template and owner.
+func (n *Node) selectEntities(ctx context.Context, s expression.Selector) ([]string, error) {
+	var entityIDs []string
+	if s.RelatedFrom != "" {
+		repo := n.resourceManager.Repo()
+		rels, err := repo.ListRelationship(ctx, repo.GetLastRevision(ctx),
+			&repository.ListRelationshipReq{EntityID: s.RelatedFrom, Type: s.RelatedType})
+		for _, rel := range rels {
+			entityIDs = append(entityIDs, rel.To)
+		}
+		return entityIDs, errors.Wrap(err, "select entities, list relationships")
+	}
+
+	searcher := n.resourceManager.Search()
+	if nil == searcher {
+		return nil, nil
+	}
+
+	var conds []*v1.SearchCondition
+	for field, value := range map[string]string{FieldType: s.Type, FieldTemplate: s.Template, FieldOwner: s.Owner} {
+		if value != "" {
+			conds = append(conds, &v1.SearchCondition{Field: field, Operator: "$eq", Value: structpb.NewStringValue(value)})
+		}
+	}
+
+	for page := int32(1); ; page++ {
+		resp, err := searcher.Search(ctx, &v1.SearchRequest{
+			Condition: conds,
+			PageNum:   page,
+			PageSize:  selectPageSize,
+		})
+		if nil != err {
+			return entityIDs, errors.Wrap(err, "select entities, search entities")
+		}
+
+		for _, item := range resp.Items {
+			if entityID := item.GetStructValue().GetFields()[FieldID].GetStringValue(); entityID != "" {
+				entityIDs = append(entityIDs, entityID)
+			}
+		}
+
+		if len(resp.Items) < selectPageSize || int64(page)*selectPageSize >= resp.Total {
+			return entityIDs, nil
+		}
+	}
+}
+
+// joinMembers discover members in background if the expression calls fan-ins.
+func (n *Node) joinMembers(exprInfos map[string]*ExpressionInfo) {
+	for _, exprInfo := range exprInfos {
+		// expression parsed for each runtime calls the same fan-ins.
+		if len(exprInfo.fanIns) > 0 {
+			go n.discoverMembers(n.ctx, *exprInfo)
+		}
+		break
+	}
+}
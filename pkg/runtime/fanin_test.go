@@ -0,0 +1,112 @@
+package runtime
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "github.com/tkeel-io/core/api/core/v1"
+	"github.com/tkeel-io/core/pkg/placement"
+	"github.com/tkeel-io/core/pkg/repository"
+	xjson "github.com/tkeel-io/core/pkg/util/json"
+	"github.com/tkeel-io/core/pkg/util/path"
+)
+
+func TestRuntime_fanIn(t *testing.T) {
+	placement.Initialize()
+	placement.Global().Append(placement.Info{ID: "core/1234", Flag: true})
+
+	newEntity := func(id, raw string) Entity {
+		en, err := NewEntity(id, []byte(raw))
+		assert.Nil(t, err)
+		return en
+	}
+
+	// device1 contained by site1, device2 not.
+	repo := &relationshipRepo{rels: make(map[string]*repository.Relationship)}
+	assert.Nil(t, repo.PutRelationship(context.Background(),
+		&repository.Relationship{Type: "contains", From: "site1", To: "device1"}))
+
+	dispatcher := &forwardDispatcher{}
+	rt := &Runtime{
+		id:          "core/1234",
+		dispatcher:  dispatcher,
+		repository:  repo,
+		enCache:     NewCacheMock(map[string]Entity{}),
+		entityIndex: newLRU(),
+		expressions: map[string]ExpressionInfo{},
+		subTree:     path.NewRefTree(),
+		evalTree:    path.New(),
+		entities: map[string]Entity{
+			"site1":   newEntity("site1", `{"id": "site1", "properties": {}}`),
+			"device1": newEntity("device1", `{"id": "device1", "type": "device", "properties": {"power": 10}}`),
+			"device2": newEntity("device2", `{"id": "device2", "type": "device", "properties": {"power": 5}}`),
+		},
+	}
+
+	expr := repository.NewExpression("admin", "site1", "", "properties.power",
+		`sum_of('type=device,related=site1/contains', 'properties.power')`, "")
+	exprInfos, err := parseExpression(*expr, 1)
+	assert.Nil(t, err)
+	for _, exprInfo := range exprInfos {
+		assert.Len(t, exprInfo.fanIns, 1)
+		rt.AppendExpression(*exprInfo)
+	}
+
+	ctx := context.Background()
+	changed := func(id, raw string, changes ...string) {
+		feed := &Feed{
+			EntityID: id,
+			State:    []byte(raw),
+			Event:    &v1.ProtoEvent{Metadata: map[string]string{v1.MetaType: string(v1.ETEntity)}},
+		}
+		for _, change := range changes {
+			feed.Changes = append(feed.Changes, Patch{Op: xjson.OpReplace, Path: change})
+		}
+		rt.handleFanIn(ctx, feed)
+	}
+
+	// device2 not selected.
+	changed("device2", `{"id": "device2", "type": "device", "properties": {"power": 5}}`, "properties.power")
+	assert.Len(t, dispatcher.events, 0)
+
+	// device1 joined.
+	changed("device1", `{"id": "device1", "type": "device", "properties": {"power": 10}}`, "properties.power")
+	assert.Len(t, dispatcher.events, 1)
+	ev := dispatcher.events[0]
+	assert.Equal(t, v1.ETFanIn, ev.Type())
+	assert.Equal(t, "site1", ev.Entity())
+	assert.Equal(t, "device1", ev.Attr(v1.MetaSender))
+	patches := ev.(v1.PatchEvent).Patches()
+	assert.Len(t, patches, 1)
+	assert.Equal(t, xjson.OpReplace.String(), patches[0].Operator)
+	assert.Equal(t, "10", string(patches[0].Value))
+
+	// members updated, the expression evaluated.
+	_, feed := rt.PrepareEvent(ctx, ev)
+	rt.handleMembers(ctx, feed)
+	assert.Len(t, dispatcher.events, 2)
+	ev = dispatcher.events[1]
+	assert.Equal(t, v1.ETEntity, ev.Type())
+	assert.Equal(t, "site1", ev.Entity())
+	patches = ev.(v1.PatchEvent).Patches()
+	assert.Len(t, patches, 2)
+	assert.Equal(t, "properties.power", patches[0].Path)
+	assert.Equal(t, "10.000000", string(patches[0].Value))
+	assert.Equal(t, "fanins.properties/power", patches[1].Path)
+
+	// device1 left if type changed.
+	changed("device1", `{"id": "device1", "type": "sensor", "properties": {"power": 10}}`, "type")
+	assert.Len(t, dispatcher.events, 3)
+	patches = dispatcher.events[2].(v1.PatchEvent).Patches()
+	assert.Len(t, patches, 1)
+	assert.Equal(t, xjson.OpRemove.String(), patches[0].Operator)
+
+	_, feed = rt.PrepareEvent(ctx, dispatcher.events[2])
+	rt.handleMembers(ctx, feed)
+	patches = dispatcher.events[3].(v1.PatchEvent).Patches()
+	assert.Equal(t, "0.000000", string(patches[0].Value))
+
+	rt.RemoveExpression(expr.ID)
+	assert.Nil(t, rt.fanInPatch(*expr))
+}
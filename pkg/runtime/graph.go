@@ -0,0 +1,188 @@
+package runtime
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	logf "github.com/tkeel-io/core/pkg/logfield"
+	"github.com/tkeel-io/core/pkg/mapper"
+	"github.com/tkeel-io/core/pkg/repository"
+	"github.com/tkeel-io/core/pkg/util/path"
+	"github.com/tkeel-io/kit/log"
+)
+
+// Dependency is an edge of the expression dependency graph, the target path computed from the source path.
+type Dependency struct {
+	ExpressionID string
+	EntityID     string
+	Source       string
+	Target       string
+}
+
+// Graph is the dependency graph of expressions, vertices are property paths of entities.
+type Graph struct {
+	// map[expressionID][]Dependency.
+	edges map[string][]Dependency
+}
+
+func NewGraph() *Graph {
+	return &Graph{edges: make(map[string][]Dependency)}
+}
+
+// dependencies returns edges of the expression from the eval endpoints mounted, expressions not evaluated compute nothing.
+func dependencies(expr repository.Expression) ([]Dependency, error) {
+	exprInfos, err := parseExpression(expr, 0)
+	if nil != err {
+		return nil, err
+	}
+
+	// expression without path computes into the entity.
+	target := expr.EntityID
+	if expr.Path != "" {
+		target = path.FmtWatchKey(expr.EntityID, expr.Path)
+	}
+
+	var deps []Dependency
+	for _, exprInfo := range exprInfos {
+		for _, item := range exprInfo.evalEndpoints {
+			deps = append(deps, Dependency{ExpressionID: expr.ID,
+				EntityID: expr.EntityID, Source: item.path, Target: target})
+		}
+	}
+
+	sort.Slice(deps, func(i, j int) bool {
+		return deps[i].Source < deps[j].Source
+	})
+	return deps, nil
+}
+
+// Load add expressions appended before into the graph without checking.
+func (g *Graph) Load(exprs []*repository.Expression) {
+	for _, expr := range exprs {
+		deps, err := dependencies(*expr)
+		if nil != err {
+			log.L().Warn("load expression dependencies", logf.ID(expr.ID),
+				logf.Eid(expr.EntityID), logf.Expr(expr.Expression), logf.Reason(err.Error()))
+			continue
+		}
+		g.edges[expr.ID] = deps
+	}
+}
+
+// Append add the expression into the graph, rejected if a cycle through the expression created.
+func (g *Graph) Append(expr repository.Expression) error {
+	deps, err := dependencies(expr)
+	if nil != err {
+		return errors.Wrap(xerrors.ErrExpressionInvalid, err.Error())
+	}
+
+	old, has := g.edges[expr.ID]
+	g.edges[expr.ID] = deps
+	if cycle := g.cycle(expr.ID); len(cycle) > 0 {
+		if has {
+			g.edges[expr.ID] = old
+		} else {
+			delete(g.edges, expr.ID)
+		}
+		return errors.Wrapf(xerrors.ErrExpressionCycle, "expression %s, cycle %s",
+			expr.ID, strings.Join(cycle, " -> "))
+	}
+	return nil
+}
+
+func (g *Graph) Remove(exprID string) {
+	delete(g.edges, exprID)
+}
+
+// Dependencies returns edges sorted by source, edges from or into the entity if entityID specified.
+func (g *Graph) Dependencies(entityID string) []Dependency {
+	deps := []Dependency{}
+	for _, edges := range g.edges {
+		for _, dep := range edges {
+			if entityID == "" || dep.EntityID == entityID ||
+				mapper.NewWatchKey(dep.Source).EntityID == entityID {
+				deps = append(deps, dep)
+			}
+		}
+	}
+
+	sort.Slice(deps, func(i, j int) bool {
+		if deps[i].Source != deps[j].Source {
+			return deps[i].Source < deps[j].Source
+		}
+		return deps[i].Target < deps[j].Target
+	})
+	return deps
+}
+
+// cycle returns paths of a cycle through the expression, empty if none.
+func (g *Graph) cycle(exprID string) []string {
+	deps := g.edges[exprID]
+	if len(deps) == 0 {
+		return nil
+	}
+
+	ids := make([]string, 0, len(g.edges))
+	for id := range g.edges {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	// breadth first from the target of the expression, until the expression reached again.
+	type step struct {
+		path string
+		prev *step
+	}
+
+	visited := make(map[string]bool)
+	queue := []*step{{path: deps[0].Target}}
+	for len(queue) > 0 {
+		curr := queue[0]
+		queue = queue[1:]
+		for _, id := range ids {
+			if visited[id] {
+				continue
+			}
+			for _, dep := range g.edges[id] {
+				if !overlap(dep.Source, curr.path) {
+					continue
+				}
+
+				if id == exprID {
+					cycle := []string{dep.Target}
+					for s := curr; s != nil; s = s.prev {
+						cycle = append(cycle, s.path)
+					}
+					for i, j := 0, len(cycle)-1; i < j; i, j = i+1, j-1 {
+						cycle[i], cycle[j] = cycle[j], cycle[i]
+					}
+					return cycle
+				}
+
+				visited[id] = true
+				queue = append(queue, &step{path: dep.Target, prev: curr})
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// overlap reports whether changes of one path change the other, one is prefix of the other or wildcards matched.
+func overlap(a, b string) bool {
+	segsA := strings.Split(a, path.Separator)
+	segsB := strings.Split(b, path.Separator)
+	for index := 0; index < len(segsA) && index < len(segsB); index++ {
+		switch {
+		case segsA[index] == path.WildcardSome || segsB[index] == path.WildcardSome:
+			return true
+		case segsA[index] == path.WildcardOne || segsB[index] == path.WildcardOne:
+			continue
+		case segsA[index] != segsB[index]:
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,90 @@
+package runtime
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "github.com/tkeel-io/core/api/core/v1"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"github.com/tkeel-io/core/pkg/placement"
+	"github.com/tkeel-io/core/pkg/repository"
+	tkeelJson "github.com/tkeel-io/core/pkg/util/json"
+	"github.com/tkeel-io/core/pkg/util/path"
+	"github.com/tkeel-io/tdtl"
+)
+
+func TestGraph(t *testing.T) {
+	placement.Initialize()
+	placement.Global().Append(placement.Info{ID: "core/1234", Flag: true})
+
+	graph := NewGraph()
+	graph.Load([]*repository.Expression{
+		repository.NewExpression("admin", "device2", "", "properties.b", "device1.properties.a", ""),
+		repository.NewExpression("admin", "device3", "", "properties.c", "device2.properties.b.x + 1", ""),
+	})
+
+	// acyclic, reads the entity itself.
+	assert.Nil(t, graph.Append(*repository.NewExpression("admin", "device1", "", "properties.f", "device1.properties.a * 1.8", "")))
+	assert.Nil(t, graph.Append(*repository.NewExpression("admin", "device4", "", "properties.d", "device3.properties.c", "")))
+
+	// device1.a -> device2.b -> device3.c -> device1.a.
+	err := graph.Append(*repository.NewExpression("admin", "device1", "", "properties.a", "device3.properties.c", ""))
+	assert.ErrorIs(t, err, xerrors.ErrExpressionCycle)
+	assert.Contains(t, err.Error(), "device1.properties.a -> device2.properties.b -> device3.properties.c -> device1.properties.a")
+
+	// self loop, wildcard.
+	assert.ErrorIs(t, graph.Append(*repository.NewExpression("admin", "device5", "", "properties.n", "device5.properties.n + 1", "")), xerrors.ErrExpressionCycle)
+	assert.ErrorIs(t, graph.Append(*repository.NewExpression("admin", "device3", "", "properties.all", "device3.*", "")), xerrors.ErrExpressionCycle)
+
+	// rejected expressions not appended.
+	assert.Len(t, graph.Dependencies(""), 4)
+	assert.Equal(t, []Dependency{
+		{ExpressionID: "/core/v1/expressions/admin/device3/properties.c", EntityID: "device3",
+			Source: "device2.properties.b.x", Target: "device3.properties.c"},
+		{ExpressionID: "/core/v1/expressions/admin/device4/properties.d", EntityID: "device4",
+			Source: "device3.properties.c", Target: "device4.properties.d"},
+	}, graph.Dependencies("device3"))
+
+	// cycle broken.
+	graph.Remove("/core/v1/expressions/admin/device3/properties.c")
+	assert.Nil(t, graph.Append(*repository.NewExpression("admin", "device1", "", "properties.a", "device3.properties.c", "")))
+}
+
+func TestRuntime_handleComputedMaxTTL(t *testing.T) {
+	placement.Initialize()
+	placement.Global().Append(placement.Info{ID: "core/1234", Flag: true})
+	en, err := NewEntity("device1", []byte(`{"id": "device1", "properties": {"a": 10}}`))
+	assert.Nil(t, err)
+
+	dispatcher := &forwardDispatcher{}
+	rt := &Runtime{
+		dispatcher:  dispatcher,
+		enCache:     NewCacheMock(map[string]Entity{"device1": en}),
+		expressions: map[string]ExpressionInfo{},
+		subTree:     path.NewRefTree(),
+		evalTree:    path.New(),
+		maxTTL:      4,
+	}
+	exprInfos, err := parseExpression(*repository.NewExpression("admin", "device2", "", "properties.b", "device1.properties.a", ""), 1)
+	assert.Nil(t, err)
+	for _, exprInfo := range exprInfos {
+		rt.AppendExpression(*exprInfo)
+	}
+
+	feed := &Feed{
+		TTL:      3,
+		Event:    &v1.ProtoEvent{},
+		EntityID: "device1",
+		Changes:  []Patch{{Op: tkeelJson.OpReplace, Path: "properties.a", Value: tdtl.New(10)}},
+	}
+
+	rt.handleComputed(context.Background(), feed)
+	assert.Len(t, dispatcher.events, 1)
+	assert.Equal(t, 4, dispatcher.events[0].TTL())
+
+	// computed events exceeding max ttl dropped.
+	feed.TTL = 4
+	rt.handleComputed(context.Background(), feed)
+	assert.Len(t, dispatcher.events, 1)
+}
@@ -0,0 +1,174 @@
+package runtime
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	v1 "github.com/tkeel-io/core/api/core/v1"
+	"github.com/tkeel-io/core/pkg/history"
+	logf "github.com/tkeel-io/core/pkg/logfield"
+	"github.com/tkeel-io/kit/log"
+	"github.com/tkeel-io/tdtl"
+)
+
+const (
+	// historyQueueSize bound records queued, handling events blocks while the queue is full.
+	historyQueueSize = 1024
+	// historyBatchSize bound records appended in a batch.
+	historyBatchSize = 128
+	// historyFlushInterval bound the time records queued before flushed.
+	historyFlushInterval = 100 * time.Millisecond
+	// historyRetryInterval bound the backoff of retrying a failed batch.
+	historyRetryInterval = 5 * time.Second
+)
+
+// historyItem is a record queued, or an offset committed once records queued before flushed.
+type historyItem struct {
+	entry  *history.Entry
+	commit func()
+}
+
+// WithHistory record changes of entities into history.
+func WithHistory(h history.History) Option {
+	return func(r *Runtime) {
+		r.history = h
+	}
+}
+
+// handleHistory record changes of entity made by the event.
+func (r *Runtime) handleHistory(ctx context.Context, feed *Feed) *Feed {
+	if nil == r.history || len(feed.Changes) == 0 {
+		return feed
+	}
+
+	ev := feed.Event
+	version, _ := strconv.ParseInt(tdtl.New(feed.State).Get(FieldVersion).String(), 10, 64)
+	record := &history.Record{
+		EntityID:  feed.EntityID,
+		Version:   version,
+		Timestamp: time.Now().UnixNano() / 1e6,
+		EventID:   ev.ID(),
+		Born:      ev.Attr(v1.MetaBorn),
+		Owner:     ev.Attr(v1.MetaOwner),
+		Source:    ev.Attr(v1.MetaSource),
+		Patches:   make([]history.Patch, 0, len(feed.Changes)),
+	}
+
+	for _, change := range feed.Changes {
+		patch := history.Patch{Op: change.Op.String(), Path: change.Path}
+		if nil != change.Value && nil == change.Value.Error() && len(change.Value.Raw()) > 0 {
+			patch.Value = change.Value.Raw()
+		}
+		record.Patches = append(record.Patches, patch)
+	}
+
+	// record full state if the changes can not be replayed exactly.
+	checkpoint := ev.Type() == v1.ETSystem ||
+		v1.PathConstructor(ev.Attr(v1.MetaPathConstructor)) == v1.PCScheme
+	r.queueHistory(&historyItem{entry: &history.Entry{
+		Record:     record,
+		State:      append([]byte(nil), feed.State...),
+		Checkpoint: checkpoint,
+	}})
+	return feed
+}
+
+func (r *Runtime) startHistory() {
+	if nil == r.history {
+		return
+	}
+
+	r.histories = make(chan *historyItem, historyQueueSize)
+	go r.flushHistory()
+}
+
+func (r *Runtime) queueHistory(item *historyItem) {
+	select {
+	case <-r.ctx.Done():
+	case r.histories <- item:
+	}
+}
+
+// afterHistory commit offset after records queued flushed, so that
+// messages are redelivered if records lost, committed at once if history disabled.
+func (r *Runtime) afterHistory(commit func()) {
+	if nil == r.histories {
+		commit()
+		return
+	}
+	r.queueHistory(&historyItem{commit: commit})
+}
+
+// flushHistory append records queued in batches, then commit offsets waiting for the records.
+func (r *Runtime) flushHistory() {
+	ticker := time.NewTicker(historyFlushInterval)
+	defer ticker.Stop()
+
+	var (
+		entries []*history.Entry
+		commits []func()
+	)
+
+	flush := func() bool {
+		if len(entries) > 0 && !r.appendHistory(entries) {
+			return false
+		}
+
+		for _, commit := range commits {
+			commit()
+		}
+		entries, commits = nil, nil
+		return true
+	}
+
+	for {
+		select {
+		case <-r.ctx.Done():
+			// records unflushed dropped, offsets waiting uncommitted.
+			return
+		case <-ticker.C:
+			if !flush() {
+				return
+			}
+		case item := <-r.histories:
+			switch {
+			case nil != item.entry:
+				entries = append(entries, item.entry)
+			case len(entries) == 0:
+				item.commit()
+			default:
+				commits = append(commits, item.commit)
+			}
+
+			if len(entries) >= historyBatchSize && !flush() {
+				return
+			}
+		}
+	}
+}
+
+// appendHistory append the batch, retried with backoff until appended, so that offsets
+// waiting for the records never committed if lost, false if the runtime stopped.
+// handling events blocks on the full queue while retrying.
+func (r *Runtime) appendHistory(entries []*history.Entry) bool {
+	backoff := historyFlushInterval
+	for {
+		err := r.history.AppendBatch(r.ctx, entries)
+		if nil == err {
+			return true
+		}
+
+		log.L().Error("record entity history", logf.RID(r.id),
+			logf.Int("records", len(entries)), logf.Any("retry", backoff), logf.Error(err))
+		select {
+		case <-r.ctx.Done():
+			return false
+		case <-time.After(backoff):
+		}
+
+		if backoff *= 2; backoff > historyRetryInterval {
+			backoff = historyRetryInterval
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package runtime
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "github.com/tkeel-io/core/api/core/v1"
+	"github.com/tkeel-io/core/pkg/history"
+	"github.com/tkeel-io/core/pkg/placement"
+	"github.com/tkeel-io/core/pkg/resource"
+	"github.com/tkeel-io/core/pkg/resource/store"
+	"github.com/tkeel-io/tdtl"
+	"go.uber.org/atomic"
+)
+
+// waitHistory wait records queued flushed.
+func waitHistory(rt *Runtime) {
+	flushed := make(chan struct{})
+	rt.afterHistory(func() { close(flushed) })
+	<-flushed
+}
+
+// blockingStore blocks writes until released.
+type blockingStore struct {
+	store.Store
+	release chan struct{}
+}
+
+func (s *blockingStore) Set(ctx context.Context, key string, data []byte) error {
+	<-s.release
+	return s.Store.Set(ctx, key, data)
+}
+
+// failingStore fails writes until released.
+type failingStore struct {
+	store.Store
+	failed atomic.Int32
+	ok     atomic.Bool
+}
+
+func (s *failingStore) Set(ctx context.Context, key string, data []byte) error {
+	if !s.ok.Load() {
+		s.failed.Inc()
+		return errors.New("store unavailable")
+	}
+	return s.Store.Set(ctx, key, data)
+}
+
+func TestRuntime_handleHistory(t *testing.T) {
+	placement.Initialize()
+	placement.Global().Append(placement.Info{ID: "core-0", Flag: true})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	h := history.New(store.NewStore(resource.Metadata{Name: "memory"}), 2, 10)
+	rt := NewRuntime(ctx, EntityResource{}, "core-0", &forwardDispatcher{}, newRebalanceRepo(t), WithHistory(h))
+	en, err := NewEntity("iotd-1", []byte(`{"id":"iotd-1","version":1,"properties":{"temp":20}}`))
+	assert.Nil(t, err)
+	rt.setEntity("iotd-1", en, 0)
+
+	for reqID, temp := range []string{"30", "40", "50"} {
+		ev := newTxEvent("", string(rune('a'+reqID)), "",
+			&v1.PatchData{Path: "properties.temp", Operator: "replace", Value: []byte(temp)})
+		ev.Metadata[v1.MetaOwner] = "admin"
+		rt.HandleEvent(ctx, ev)
+	}
+	waitHistory(rt)
+
+	records, total, err := h.List(ctx, "iotd-1", 0, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), total)
+	assert.Equal(t, "admin", records[0].Owner)
+	assert.Equal(t, "ev-c", records[0].EventID)
+	assert.Equal(t, "properties.temp", records[0].Patches[0].Path)
+
+	state, record, err := h.Get(ctx, "iotd-1", history.AsOf{Version: records[1].Version})
+	assert.Nil(t, err)
+	assert.Equal(t, "ev-b", record.EventID)
+	assert.Equal(t, "40", tdtl.New(state).Get("properties.temp").String())
+}
+
+func TestRuntime_historyCommit(t *testing.T) {
+	placement.Initialize()
+	placement.Global().Append(placement.Info{ID: "core-0", Flag: true})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s := &blockingStore{Store: store.NewStore(resource.Metadata{Name: "memory"}), release: make(chan struct{})}
+	h := history.New(s, 2, 10)
+	rt := NewRuntime(ctx, EntityResource{}, "core-0", &dispatcherMock{}, newRebalanceRepo(t), WithHistory(h))
+	en, err := NewEntity("iotd-0", []byte(`{"id":"iotd-0","version":1,"properties":{"seq":[]}}`))
+	assert.Nil(t, err)
+	rt.setEntity("iotd-0", en, 0)
+
+	done := make(chan int64, 1)
+	assert.Nil(t, rt.DeliveredEvent(ctx, newDeliveryMessage(t, 0, "iotd-0", 1), func(offset int64) { done <- offset }))
+
+	// offset uncommitted until the record flushed.
+	select {
+	case <-done:
+		t.Fatal("offset committed before history flushed")
+	case <-time.After(2 * historyFlushInterval):
+	}
+	assert.Len(t, rt.Offsets(), 0)
+
+	close(s.release)
+	assert.Equal(t, int64(0), <-done)
+	assert.Equal(t, map[int32]int64{0: 0}, rt.Offsets())
+	records, total, err := h.List(ctx, "iotd-0", 0, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), total)
+	assert.Equal(t, "ev-0", records[0].EventID)
+}
+
+func TestRuntime_historyRetry(t *testing.T) {
+	placement.Initialize()
+	placement.Global().Append(placement.Info{ID: "core-0", Flag: true})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s := &failingStore{Store: store.NewStore(resource.Metadata{Name: "memory"})}
+	h := history.New(s, 2, 10)
+	rt := NewRuntime(ctx, EntityResource{}, "core-0", &dispatcherMock{}, newRebalanceRepo(t), WithHistory(h))
+	en, err := NewEntity("iotd-0", []byte(`{"id":"iotd-0","version":1,"properties":{"seq":[]}}`))
+	assert.Nil(t, err)
+	rt.setEntity("iotd-0", en, 0)
+
+	done := make(chan int64, 1)
+	assert.Nil(t, rt.DeliveredEvent(ctx, newDeliveryMessage(t, 0, "iotd-0", 1), func(offset int64) { done <- offset }))
+
+	// offset uncommitted while appending failed.
+	select {
+	case <-done:
+		t.Fatal("offset committed with history lost")
+	case <-time.After(4 * historyFlushInterval):
+	}
+	assert.True(t, s.failed.Load() > 1)
+	assert.Len(t, rt.Offsets(), 0)
+
+	s.ok.Store(true)
+	assert.Equal(t, int64(0), <-done)
+	records, total, err := h.List(ctx, "iotd-0", 0, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), total)
+	assert.Equal(t, "ev-0", records[0].EventID)
+}
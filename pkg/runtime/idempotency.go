@@ -0,0 +1,213 @@
+package runtime
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	v1 "github.com/tkeel-io/core/api/core/v1"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	logf "github.com/tkeel-io/core/pkg/logfield"
+	"github.com/tkeel-io/kit/log"
+)
+
+const (
+	// processedWindow bound event ids recorded for each entity.
+	processedWindow = 128
+	// processedEntities bound entities tracked, the least recently applied dropped.
+	processedEntities = 65536
+)
+
+// processedIndex track event ids applied to entities, kept out of entity state and
+// persisted beside the entity, windows of deleted entities retained so that retried deletes are skipped.
+type processedIndex struct {
+	index   *lru
+	windows map[string][]string
+	// windows changed since persisted.
+	changed map[string]struct{}
+}
+
+func newProcessedIndex() *processedIndex {
+	return &processedIndex{
+		index:   newLRU(),
+		windows: make(map[string][]string),
+		changed: make(map[string]struct{}),
+	}
+}
+
+func (p *processedIndex) has(eid, id string) bool {
+	for _, processed := range p.windows[eid] {
+		if processed == id {
+			return true
+		}
+	}
+	return false
+}
+
+// record append event id into window of entity, the oldest dropped if window exceeded.
+func (p *processedIndex) record(eid, id string) {
+	if p.has(eid, id) {
+		return
+	}
+
+	ids := append(p.windows[eid], id)
+	if len(ids) > processedWindow {
+		ids = append([]string(nil), ids[len(ids)-processedWindow:]...)
+	}
+
+	p.windows[eid] = ids
+	p.changed[eid] = struct{}{}
+	p.touch(eid)
+}
+
+// restore set window of entity loaded from state store, window held by the runtime is newer.
+func (p *processedIndex) restore(eid string, ids []string) {
+	if _, has := p.windows[eid]; has || len(ids) == 0 {
+		return
+	}
+
+	p.windows[eid] = ids
+	p.touch(eid)
+}
+
+// take returns window of entity if changed since persisted, marked persisted.
+func (p *processedIndex) take(eid string) ([]string, bool) {
+	if _, has := p.changed[eid]; !has {
+		return nil, false
+	}
+
+	delete(p.changed, eid)
+	return append([]string(nil), p.windows[eid]...), true
+}
+
+func (p *processedIndex) touch(eid string) {
+	p.index.Set(eid, 0)
+	for p.index.Len() > processedEntities {
+		oldest, _ := p.index.Oldest()
+		p.index.Remove(oldest)
+		delete(p.windows, oldest)
+		delete(p.changed, oldest)
+	}
+}
+
+func isDeleteEvent(ev v1.Event) bool {
+	sev, ok := ev.(v1.SystemEvent)
+	return ok && v1.ETSystem == ev.Type() &&
+		sev.Action() != nil && sev.Action().Operator == string(v1.OpDelete)
+}
+
+// idempotent returns whether the event applied at most once, reads are not recorded.
+func idempotent(ev v1.Event, feed *Feed) bool {
+	if v1.ETEntity == ev.Type() {
+		return len(feed.Patches) > 0
+	}
+	return isDeleteEvent(ev)
+}
+
+// handleDuplicate skip event which has been applied, respond with current state.
+func (r *Runtime) handleDuplicate(ctx context.Context, ev v1.Event) bool {
+	if ev.ID() == "" || (v1.ETEntity != ev.Type() && !isDeleteEvent(ev)) {
+		return false
+	}
+
+	// window of entity loaded from state store with the entity.
+	if _, cached := r.getEntity(ev.Entity()); !cached {
+		r.LoadEntity(ev.Entity()) //nolint
+	}
+
+	if !r.isProcessed(ev.Entity(), ev.ID()) {
+		return false
+	}
+
+	// entity of duplicated delete has gone, respond with default state.
+	state := DefaultEntity(ev.Entity())
+	if en, err := r.LoadEntity(ev.Entity()); nil == err && v1.ETEntity == ev.Type() {
+		state = en
+	}
+
+	log.L().Info("skip duplicate event", logf.RID(r.id),
+		logf.Eid(ev.Entity()), logf.ID(ev.ID()), logf.Header(ev.Attributes()))
+	r.handleCallback(ctx, &Feed{Event: ev, EntityID: ev.Entity(), State: state.Raw()})
+	return true
+}
+
+func (r *Runtime) isProcessed(eid, id string) bool {
+	r.plock.Lock()
+	defer r.plock.Unlock()
+	return r.processed.has(eid, id)
+}
+
+func (r *Runtime) recordProcessed(eid, id string) {
+	r.plock.Lock()
+	r.processed.record(eid, id)
+	r.plock.Unlock()
+}
+
+func (r *Runtime) restoreProcessed(eid string, ids []string) {
+	r.plock.Lock()
+	r.processed.restore(eid, ids)
+	r.plock.Unlock()
+}
+
+// persistProcessed store window of entity changed since persisted, called after entity
+// state persisted, so that events skipped after reloaded never lost their changes.
+func (r *Runtime) persistProcessed(ctx context.Context, eid string) error {
+	r.plock.Lock()
+	ids, changed := r.processed.take(eid)
+	r.plock.Unlock()
+	if !changed {
+		return nil
+	}
+
+	bytes, err := json.Marshal(ids)
+	if nil == err {
+		err = r.repository.PutProcessed(ctx, eid, bytes)
+	}
+
+	if nil != err {
+		r.plock.Lock()
+		if _, has := r.processed.windows[eid]; has {
+			r.processed.changed[eid] = struct{}{}
+		}
+		r.plock.Unlock()
+		return errors.Wrap(err, "persistent processed events")
+	}
+	return nil
+}
+
+// loadProcessed load window of entity loaded from state store.
+func (r *Runtime) loadProcessed(ctx context.Context, eid string) {
+	bytes, err := r.repository.GetProcessed(ctx, eid)
+	if nil != err {
+		if !errors.Is(err, xerrors.ErrResourceNotFound) && !errors.Is(err, xerrors.ErrEntityNotFound) {
+			log.L().Warn("load processed events", logf.Eid(eid), logf.Reason(err.Error()))
+		}
+		return
+	}
+
+	var ids []string
+	if err = json.Unmarshal(bytes, &ids); nil != err {
+		log.L().Warn("load processed events", logf.Eid(eid), logf.Reason(err.Error()))
+		return
+	}
+	r.restoreProcessed(eid, ids)
+}
+
+// removeProcessed remove window of entity deleted from state store, window held by the runtime retained.
+func (r *Runtime) removeProcessed(ctx context.Context, eid string) {
+	if err := r.repository.DelProcessed(ctx, eid); nil != err {
+		log.L().Warn("remove processed events", logf.Eid(eid), logf.Reason(err.Error()))
+	}
+}
+
+// processedEvents returns windows of entities held by the runtime.
+func (r *Runtime) processedEvents(eids []string) map[string][]string {
+	r.plock.Lock()
+	defer r.plock.Unlock()
+	windows := make(map[string][]string)
+	for _, eid := range eids {
+		if ids, ok := r.processed.windows[eid]; ok {
+			windows[eid] = append([]string(nil), ids...)
+		}
+	}
+	return windows
+}
@@ -0,0 +1,139 @@
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "github.com/tkeel-io/core/api/core/v1"
+	"github.com/tkeel-io/core/pkg/placement"
+	"github.com/tkeel-io/core/pkg/types"
+)
+
+func TestRuntime_handleDuplicate(t *testing.T) {
+	placement.Initialize()
+	placement.Global().Append(placement.Info{ID: "core-0", Flag: true})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	dispatcher := &forwardDispatcher{}
+	rt := NewRuntime(ctx, EntityResource{}, "core-0", dispatcher, newRebalanceRepo(t))
+	en, err := NewEntity("iotd-1", []byte(`{"id":"iotd-1","version":1,"properties":{"count":[]}}`))
+	assert.Nil(t, err)
+	rt.setEntity("iotd-1", en, 0)
+
+	ev := newTxEvent("", "req-1", "",
+		&v1.PatchData{Path: "properties.count", Operator: "add", Value: []byte("1")})
+	rt.HandleEvent(ctx, ev)
+	assert.Equal(t, "[1]", en.Get("properties.count").String())
+	assert.Equal(t, int64(2), en.Version())
+
+	// redelivered event skipped, respond with current state.
+	rt.HandleEvent(ctx, newTxEvent("", "req-1", "",
+		&v1.PatchData{Path: "properties.count", Operator: "add", Value: []byte("1")}))
+	assert.Equal(t, "[1]", en.Get("properties.count").String())
+	assert.Equal(t, int64(2), en.Version())
+	resp := lastResponse(dispatcher, "req-1")
+	assert.Equal(t, string(types.StatusOK), resp.Attr(v1.MetaResponseStatus))
+
+	// events without patches not recorded.
+	rt.HandleEvent(ctx, newTxEvent("", "req-2", ""))
+	rt.HandleEvent(ctx, newTxEvent("", "req-2", ""))
+	assert.Equal(t, []string{"ev-req-1"}, rt.processed.windows["iotd-1"])
+	assert.NotContains(t, string(en.Raw()), "ev-req-1")
+}
+
+func TestRuntime_handleDuplicateReloaded(t *testing.T) {
+	placement.Initialize()
+	placement.Global().Append(placement.Info{ID: "core-0", Flag: true})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	repo := newRebalanceRepo(t)
+	assert.Nil(t, repo.PutEntity(ctx, "iotd-1", []byte(`{"id":"iotd-1","version":1,"properties":{"count":[]}}`)))
+	rt := NewRuntime(ctx, EntityResource{}, "core-0", &forwardDispatcher{}, repo)
+	rt.HandleEvent(ctx, newTxEvent("", "req-1", "",
+		&v1.PatchData{Path: "properties.count", Operator: "add", Value: []byte("1")}))
+
+	// window persisted beside the entity, not in entity state.
+	raw, err := repo.GetEntity(ctx, "iotd-1")
+	assert.Nil(t, err)
+	assert.NotContains(t, string(raw), "ev-req-1")
+	processed, err := repo.GetProcessed(ctx, "iotd-1")
+	assert.Nil(t, err)
+	assert.Equal(t, `["ev-req-1"]`, string(processed))
+
+	// event redelivered to a restarted runtime skipped.
+	dispatcher := &forwardDispatcher{}
+	restarted := NewRuntime(ctx, EntityResource{}, "core-0", dispatcher, repo)
+	restarted.HandleEvent(ctx, newTxEvent("", "req-1", "",
+		&v1.PatchData{Path: "properties.count", Operator: "add", Value: []byte("1")}))
+	assert.Equal(t, string(types.StatusOK), lastResponse(dispatcher, "req-1").Attr(v1.MetaResponseStatus))
+	en, err := restarted.LoadEntity("iotd-1")
+	assert.Nil(t, err)
+	assert.Equal(t, "[1]", en.Get("properties.count").String())
+	assert.Equal(t, int64(2), en.Version())
+}
+
+func TestRuntime_handleDuplicateDelete(t *testing.T) {
+	placement.Initialize()
+	placement.Global().Append(placement.Info{ID: "core-0", Flag: true})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	dispatcher := &forwardDispatcher{}
+	rt := NewRuntime(ctx, EntityResource{
+		FlushHandler:  func(context.Context, Entity, *Feed) error { return nil },
+		RemoveHandler: func(context.Context, Entity, *Feed) error { return nil },
+	}, "core-0", dispatcher, newRebalanceRepo(t))
+	en, err := NewEntity("iotd-1", []byte(`{"id":"iotd-1","version":1,"properties":{}}`))
+	assert.Nil(t, err)
+	rt.setEntity("iotd-1", en, 0)
+
+	newEvent := func(reqID string) v1.Event {
+		return &v1.ProtoEvent{
+			Id:       "delete-key",
+			Callback: "callback",
+			Metadata: map[string]string{
+				v1.MetaType:      string(v1.ETSystem),
+				v1.MetaEntityID:  "iotd-1",
+				v1.MetaRequestID: reqID,
+				v1.MetaIfVersion: v1.IfVersionAny,
+			},
+			Data: &v1.ProtoEvent_SystemData{
+				SystemData: &v1.SystemData{Operator: string(v1.OpDelete)},
+			},
+		}
+	}
+
+	rt.HandleEvent(ctx, newEvent("req-1"))
+	assert.Equal(t, string(types.StatusOK), lastResponse(dispatcher, "req-1").Attr(v1.MetaResponseStatus))
+	assert.NotContains(t, rt.entities, "iotd-1")
+
+	// retried delete with the same idempotency key succeed, not precondition failed.
+	rt.HandleEvent(ctx, newEvent("req-2"))
+	assert.Equal(t, string(types.StatusOK), lastResponse(dispatcher, "req-2").Attr(v1.MetaResponseStatus))
+}
+
+func Test_processedIndex(t *testing.T) {
+	index := newProcessedIndex()
+	assert.False(t, index.has("iotd-1", "ev-0"))
+	for i := 0; i < processedWindow+10; i++ {
+		index.record("iotd-1", fmt.Sprintf("ev-%d", i))
+	}
+
+	ids := index.windows["iotd-1"]
+	assert.Len(t, ids, processedWindow)
+	assert.Equal(t, "ev-10", ids[0])
+	assert.Equal(t, fmt.Sprintf("ev-%d", processedWindow+9), ids[processedWindow-1])
+	assert.False(t, index.has("iotd-1", "ev-9"))
+	assert.True(t, index.has("iotd-1", "ev-10"))
+
+	// windows of the least recently applied entities dropped.
+	for i := 0; i < processedEntities; i++ {
+		index.record(fmt.Sprintf("en-%d", i), "ev-0")
+	}
+	assert.False(t, index.has("iotd-1", "ev-10"))
+	assert.Len(t, index.windows, processedEntities)
+}
@@ -0,0 +1,82 @@
+package runtime
+
+import "container/list"
+
+// CacheLimit is the budget of an entity cache, unlimited if zero.
+type CacheLimit struct {
+	MaxEntries int
+	MaxBytes   int64
+}
+
+func (l CacheLimit) exceeded(entries int, bytes int64) bool {
+	return (l.MaxEntries > 0 && entries > l.MaxEntries) ||
+		(l.MaxBytes > 0 && bytes > l.MaxBytes)
+}
+
+type lruItem struct {
+	key  string
+	size int64
+}
+
+// lru track the recency and size of cached entities, not thread safe.
+type lru struct {
+	size  int64
+	list  *list.List
+	items map[string]*list.Element
+}
+
+func newLRU() *lru {
+	return &lru{
+		list:  list.New(),
+		items: make(map[string]*list.Element),
+	}
+}
+
+// Set insert or update key as the most recently used.
+func (l *lru) Set(key string, size int64) {
+	if elem, ok := l.items[key]; ok {
+		item, _ := elem.Value.(*lruItem)
+		l.size += size - item.size
+		item.size = size
+		l.list.MoveToFront(elem)
+		return
+	}
+
+	l.size += size
+	l.items[key] = l.list.PushFront(&lruItem{key: key, size: size})
+}
+
+// Touch mark key as the most recently used.
+func (l *lru) Touch(key string) {
+	if elem, ok := l.items[key]; ok {
+		l.list.MoveToFront(elem)
+	}
+}
+
+func (l *lru) Remove(key string) {
+	if elem, ok := l.items[key]; ok {
+		item, _ := elem.Value.(*lruItem)
+		l.size -= item.size
+		l.list.Remove(elem)
+		delete(l.items, key)
+	}
+}
+
+// Oldest returns the least recently used key.
+func (l *lru) Oldest() (string, bool) {
+	elem := l.list.Back()
+	if elem == nil {
+		return "", false
+	}
+
+	item, _ := elem.Value.(*lruItem)
+	return item.key, true
+}
+
+func (l *lru) Len() int {
+	return l.list.Len()
+}
+
+func (l *lru) Size() int64 {
+	return l.size
+}
@@ -3,6 +3,7 @@ package runtime
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -14,6 +15,8 @@ import (
 	"github.com/pkg/errors"
 	"github.com/tkeel-io/core/pkg/dispatch"
 	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"github.com/tkeel-io/core/pkg/function"
+	"github.com/tkeel-io/core/pkg/history"
 	logf "github.com/tkeel-io/core/pkg/logfield"
 	"github.com/tkeel-io/core/pkg/mapper/expression"
 	"github.com/tkeel-io/core/pkg/placement"
@@ -27,6 +30,24 @@ import (
 
 type NodeConf struct {
 	Sources []string
+	// SnapshotInterval interval of runtime snapshots, disabled if zero.
+	SnapshotInterval time.Duration
+	// EntityLimit budget of entities owned by each runtime.
+	EntityLimit CacheLimit
+	// CacheLimit budget of entities cached from other runtimes.
+	CacheLimit CacheLimit
+	// History records changes of entities, disabled if nil.
+	History history.History
+	// Workers goroutines handling events of each runtime.
+	Workers int
+	// QueueSize messages queued in each runtime, delivering blocks while queue is full.
+	QueueSize int
+	// Retention default retention of entity telemetry and raw data, DefaultRetention if zero.
+	Retention Retention
+	// Handlers plugins registered and enabled in the pipeline of runtimes.
+	Handlers []HandlerConf
+	// MaxTTL hops of computed events, computed events exceeding dropped.
+	MaxTTL int
 }
 
 type Node struct {
@@ -62,9 +83,14 @@ func NewNode(ctx context.Context, resourceManager types.ResourceManager, dispatc
 // 4. start KafkaReceived.
 func (n *Node) Start(cfg NodeConf) error {
 	log.L().Info("start node...")
+	SetDefaultRetention(cfg.Retention)
+	pipeline, err := NewPipeline(cfg.Handlers)
+	if nil != err {
+		return errors.Wrap(err, "create pipeline")
+	}
+	log.L().Info("pipeline handlers enabled", logf.Any("handlers", pipeline.Handlers()))
 
 	// 1. 创建 KafkaSource & runtime
-	var err error
 	var sourceIns *xkafka.Pubsub
 	for index := range cfg.Sources {
 		if sourceIns, err = xkafka.NewKafkaPubsub(cfg.Sources[index]); nil != err {
@@ -76,11 +102,23 @@ func (n *Node) Start(cfg NodeConf) error {
 		log.L().Info("create runtime instance",
 			logf.ID(runtimeID), logf.Source(cfg.Sources[index]))
 		entityResouce := EntityResource{PersistentEntity: n.PersistentEntity, FlushHandler: n.FlushEntity, RemoveHandler: n.RemoveEntity}
-		runtime := NewRuntime(n.ctx, entityResouce, runtimeID, n.dispatch, n.resourceManager.Repo())
+		runtime := NewRuntime(n.ctx, entityResouce, runtimeID, n.dispatch, n.resourceManager.Repo(),
+			WithEntityLimit(cfg.EntityLimit), WithCacheLimit(cfg.CacheLimit), WithHistory(cfg.History),
+			WithWorkers(cfg.Workers), WithQueueSize(cfg.QueueSize), WithPipeline(pipeline), WithMaxTTL(cfg.MaxTTL))
 		n.runtimes[runtimeID] = runtime
 		placement.Global().Append(placement.Info{ID: sourceIns.ID(), Flag: true})
 	}
 
+	// rebalance runtimes when placement changed.
+	placement.Global().OnChanged(n.onPlacementChanged)
+
+	// restore runtimes from snapshots.
+	for _, rt := range n.runtimes {
+		if err = rt.Restore(n.ctx); nil != err {
+			log.L().Warn("restore runtime, cold start", logf.RID(rt.ID()), logf.Reason(err.Error()))
+		}
+	}
+
 	// 2. list resource
 	var elapsed util.ElapsedTime
 	n.listMetadata()
@@ -94,6 +132,11 @@ func (n *Node) Start(cfg NodeConf) error {
 			return errors.Wrap(err, "consume source")
 		}
 	}
+	// 5. snapshot runtimes periodically.
+	if cfg.SnapshotInterval > 0 {
+		go n.snapshotLoop(cfg.SnapshotInterval)
+	}
+
 	// watch metadata.
 	log.L().Debug("start node completed", logf.Elapsedms(elapsed.ElapsedMilli()))
 	//
@@ -136,7 +179,24 @@ func (n *Node) Start(cfg NodeConf) error {
 	return nil
 }
 
+// HandleMessage deliver message to runtime and wait until handled.
 func (n *Node) HandleMessage(ctx context.Context, msg *sarama.ConsumerMessage) error {
+	done := make(chan struct{})
+	if err := n.DeliverMessage(ctx, msg, func(int64) { close(done) }); nil != err {
+		return err
+	}
+
+	select {
+	case <-ctx.Done():
+		return errors.Wrap(ctx.Err(), "handle message")
+	case <-done:
+	}
+	return nil
+}
+
+// DeliverMessage deliver message to runtime, done called after message handled,
+// implements kafka.AsyncReceiver.
+func (n *Node) DeliverMessage(ctx context.Context, msg *sarama.ConsumerMessage, done func(int64)) error {
 	rid := msg.Topic
 	if _, has := n.runtimes[rid]; !has {
 		log.L().Error("runtime instance not exists.", logf.ID(rid),
@@ -146,7 +206,41 @@ func (n *Node) HandleMessage(ctx context.Context, msg *sarama.ConsumerMessage) e
 
 	// load runtime spec.
 	rt := n.runtimes[rid]
-	rt.DeliveredEvent(context.Background(), msg)
+	return errors.Wrap(rt.DeliveredEvent(ctx, msg, done), "deliver message")
+}
+
+// Offsets returns handled offsets of the runtime, implements kafka.OffsetProvider.
+func (n *Node) Offsets(topic string) map[int32]int64 {
+	if rt, has := n.runtimes[topic]; has {
+		return rt.Offsets()
+	}
+	return nil
+}
+
+func (n *Node) snapshotLoop(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-n.ctx.Done():
+			return
+		case <-ticker.C:
+			n.snapshot(n.ctx, "")
+		}
+	}
+}
+
+// snapshot runtimes, all runtimes if runtimeID is empty.
+func (n *Node) snapshot(ctx context.Context, runtimeID string) error {
+	for id, rt := range n.runtimes {
+		if runtimeID != "" && runtimeID != id {
+			continue
+		}
+
+		if err := rt.Snapshot(ctx); nil != err {
+			return errors.Wrap(err, "snapshot runtime")
+		}
+	}
 	return nil
 }
 
@@ -181,6 +275,7 @@ func (n *Node) listMetadata() {
 					runtime.AppendExpression(*exprIns)
 				}
 			}
+			n.joinMembers(exprInfos)
 		}
 	})
 
@@ -192,11 +287,7 @@ func (n *Node) listMetadata() {
 			runtimeInfo := placement.Global().Select(entityID)
 			runtime, ok := n.runtimes[runtimeInfo.ID]
 			if ok {
-				_, ok := runtime.entitySubscriptions[entityID]
-				if !ok {
-					runtime.entitySubscriptions[entityID] = make(map[string]*repository.Subscription)
-				}
-				runtime.entitySubscriptions[entityID][sub.ID] = sub
+				runtime.setSubscription(sub)
 			}
 		}
 	})
@@ -239,11 +330,23 @@ func (n *Node) watchMetadata() {
 						rt.AppendExpression(*exprItem)
 					}
 				}
+				n.joinMembers(exprInfos)
 			default:
 				log.L().Error("watch metadata changed, invalid event type")
 			}
 		})
 
+	go repo.WatchRelationship(context.Background(), n.revision,
+		func(et dao.EnventType, rel *repository.Relationship) {
+			log.L().Debug("sync relationship", logf.Eid(rel.From),
+				logf.Type(rel.Type), logf.Target(rel.To), logf.Any("event", et))
+
+			// target of relationship may be selected by fan-ins.
+			if rt, has := n.runtimes[placement.Global().Select(rel.To).ID]; has {
+				rt.OnRelationshipChanged(n.ctx, rel, dao.PUT == et)
+			}
+		})
+
 	go repo.WatchSubscription(context.Background(), n.revision,
 		func(et dao.EnventType, sub *repository.Subscription) {
 			switch et {
@@ -253,9 +356,7 @@ func (n *Node) watchMetadata() {
 				runtimeInfo := placement.Global().Select(entityID)
 				runtime, ok := n.runtimes[runtimeInfo.ID]
 				if ok {
-					if subscription, ok := runtime.entitySubscriptions[sub.SourceEntityID]; ok {
-						delete(subscription, sub.ID)
-					}
+					runtime.removeSubscription(sub)
 				}
 			case dao.PUT:
 				log.L().Debug("sync PUT Subscription", logf.String("subID", sub.ID), logf.Owner(sub.Owner))
@@ -263,11 +364,7 @@ func (n *Node) watchMetadata() {
 				runtimeInfo := placement.Global().Select(entityID)
 				runtime, ok := n.runtimes[runtimeInfo.ID]
 				if ok {
-					_, ok := runtime.entitySubscriptions[entityID]
-					if !ok {
-						runtime.entitySubscriptions[entityID] = make(map[string]*repository.Subscription)
-					}
-					runtime.entitySubscriptions[entityID][sub.ID] = sub
+					runtime.setSubscription(sub)
 				}
 			default:
 				log.L().Error("watch metadata changed, invalid event type")
@@ -276,19 +373,39 @@ func (n *Node) watchMetadata() {
 }
 
 func parseExpression(expr repository.Expression, version int) (map[string]*ExpressionInfo, error) {
-	exprIns, err := expression.NewExpr(expr.Expression, nil)
+	exprIns, err := expression.NewExpr(expr.Expression, function.Funcs())
 	if nil != err {
 		return nil, errors.Wrap(err, "parse expression")
 	}
 
+	fanIns, err := expression.FanIns(expr.Expression)
+	if nil != err {
+		return nil, errors.Wrap(err, "parse expression fan-ins")
+	}
+
 	targetRuntimeInfo := placement.Global().Select(expr.EntityID)
 	exprInfos := map[string]*ExpressionInfo{
 		targetRuntimeInfo.ID: {
 			version:    version,
 			Expression: expr,
+			windowed:   expression.Windowed(expr.Expression),
+			fanIns:     fanIns,
 		},
 	}
 
+	// entities selected by fan-ins may be in any runtime.
+	if len(fanIns) > 0 {
+		for _, info := range placement.Global().List() {
+			if _, has := exprInfos[info.ID]; !has {
+				exprInfos[info.ID] = &ExpressionInfo{
+					version:    version,
+					Expression: expr,
+					fanIns:     fanIns,
+				}
+			}
+		}
+	}
+
 	for sourceEntityID, paths := range exprIns.Sources() {
 		sourceRuntimeInfo := placement.Global().Select(sourceEntityID)
 		if _, has := exprInfos[sourceRuntimeInfo.ID]; !has {
@@ -319,6 +436,52 @@ func parseExpression(expr repository.Expression, version int) (map[string]*Expre
 	return exprInfos, nil
 }
 
+const (
+	TreeSub  = "subTree"
+	TreeEval = "evalTree"
+)
+
+// Mount is an endpoint of expression mounted into the subTree or evalTree of runtime.
+type Mount struct {
+	Runtime      string
+	Tree         string
+	Path         string
+	WildcardPath string
+	Target       string
+	Delivery     string
+}
+
+// PlanExpression returns endpoints runtimes mount if the expression appended.
+func PlanExpression(expr repository.Expression) ([]*Mount, error) {
+	exprInfos, err := parseExpression(expr, 0)
+	if nil != err {
+		return nil, err
+	}
+
+	var mounts []*Mount
+	for runtimeID, exprInfo := range exprInfos {
+		for index := range exprInfo.subEndpoints {
+			item := &exprInfo.subEndpoints[index]
+			mounts = append(mounts, &Mount{Runtime: runtimeID, Tree: TreeSub, Path: item.path,
+				WildcardPath: item.WildcardPath(), Target: item.target, Delivery: item.deliveryID})
+		}
+		for _, item := range exprInfo.evalEndpoints {
+			mounts = append(mounts, &Mount{Runtime: runtimeID, Tree: TreeEval, Path: item.path,
+				WildcardPath: item.WildcardPath(), Target: item.target})
+		}
+	}
+
+	sort.Slice(mounts, func(i, j int) bool {
+		if mounts[i].Runtime != mounts[j].Runtime {
+			return mounts[i].Runtime < mounts[j].Runtime
+		} else if mounts[i].Tree != mounts[j].Tree {
+			return mounts[i].Tree < mounts[j].Tree
+		}
+		return mounts[i].Path < mounts[j].Path
+	})
+	return mounts, nil
+}
+
 // exprKey return unique expression identifier.
 func exprKey(expr *repository.Expression) string { //nolint
 	return expr.EntityID + expr.Path
@@ -357,6 +520,12 @@ func (n *Node) Debug(req *go_restful.Request, resp *go_restful.Response) {
 	case "subtree":
 		ret := n.runtimes[runtimeID]
 		resp.Write([]byte(ret.subTree.String()))
+	case "snapshot":
+		if err := n.snapshot(req.Request.Context(), runtimeID); nil != err {
+			resp.WriteErrorString(500, err.Error())
+			return
+		}
+		resp.WriteAsJson("snapshot completed")
 	case "evaltree":
 		ret := n.runtimes[runtimeID]
 		resp.Write([]byte(ret.evalTree.String()))
@@ -7,6 +7,11 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	v1 "github.com/tkeel-io/core/api/core/v1"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"github.com/tkeel-io/core/pkg/placement"
+	"github.com/tkeel-io/core/pkg/repository"
+	"github.com/tkeel-io/core/pkg/types"
 	"github.com/tkeel-io/tdtl"
 )
 
@@ -220,3 +225,185 @@ func TestTDTL(t *testing.T) {
 		t.Log("tentacle: ", k, tentacle)
 	}
 }
+
+type cleanupRepo struct {
+	repository.IRepository
+	exprs         []repository.Expression
+	subscriptions map[string]*repository.Subscription
+	schedules     map[string]*repository.Schedule
+}
+
+func (r *cleanupRepo) GetLastRevision(ctx context.Context) int64 {
+	return 0
+}
+
+func (r *cleanupRepo) DelExprByEnity(ctx context.Context, expr repository.Expression) error {
+	r.exprs = append(r.exprs, expr)
+	return nil
+}
+
+func (r *cleanupRepo) ListSubscription(ctx context.Context, rev int64, req *repository.ListSubscriptionReq) ([]*repository.Subscription, error) {
+	var subscriptions []*repository.Subscription
+	for _, subscription := range r.subscriptions {
+		if subscription.ID == req.ID {
+			subscriptions = append(subscriptions, subscription)
+		}
+	}
+	return subscriptions, nil
+}
+
+func (r *cleanupRepo) DelSubscription(ctx context.Context, subscription *repository.Subscription) error {
+	delete(r.subscriptions, subscription.ID+"/"+subscription.SourceEntityID)
+	return nil
+}
+
+func (r *cleanupRepo) ListSchedule(ctx context.Context, rev int64, req *repository.ListScheduleReq) ([]*repository.Schedule, error) {
+	var schedules []*repository.Schedule
+	for _, schedule := range r.schedules {
+		if schedule.EntityID == req.EntityID {
+			schedules = append(schedules, schedule)
+		}
+	}
+	return schedules, nil
+}
+
+func (r *cleanupRepo) DelSchedule(ctx context.Context, schedule *repository.Schedule) error {
+	delete(r.schedules, schedule.ID)
+	return nil
+}
+
+func (r *cleanupRepo) ListRelationship(ctx context.Context, rev int64, req *repository.ListRelationshipReq) ([]*repository.Relationship, error) {
+	return nil, nil
+}
+
+func TestNode_removeEntityResources(t *testing.T) {
+	repo := &cleanupRepo{
+		subscriptions: map[string]*repository.Subscription{
+			"sub-1/device123": {ID: "sub-1", SourceEntityID: "device123"},
+			"sub-1/device234": {ID: "sub-1", SourceEntityID: "device234"},
+			"sub-2/device234": {ID: "sub-2", SourceEntityID: "device234"},
+		},
+		schedules: map[string]*repository.Schedule{
+			"ttl-device123": {ID: "ttl-device123", EntityID: "device123"},
+			"sch-1":         {ID: "sch-1", EntityID: "device234"},
+		},
+	}
+	placement.Initialize()
+	placement.Global().Append(placement.Info{ID: "core-0", Flag: true})
+	node := NewNode(context.Background(), types.NewResources(nil, nil, nil, repo), nil, nil)
+
+	// subscriptions sourced from entities indexed by runtime, without ranging all subscriptions.
+	rt := NewRuntime(context.Background(), EntityResource{}, "core-0", &dispatcherMock{}, repo)
+	for _, sub := range repo.subscriptions {
+		if _, ok := rt.entitySubscriptions[sub.SourceEntityID]; !ok {
+			rt.entitySubscriptions[sub.SourceEntityID] = make(map[string]*repository.Subscription)
+		}
+		rt.entitySubscriptions[sub.SourceEntityID][sub.ID] = sub
+	}
+	node.runtimes["core-0"] = rt
+
+	en, err := NewEntity("device123", []byte(`{"owner":"admin","properties":{}}`))
+	assert.Nil(t, err)
+	node.removeEntityResources(context.Background(), en)
+	assert.Equal(t, []repository.Expression{{Owner: "admin", EntityID: "device123"}}, repo.exprs)
+	assert.Len(t, repo.subscriptions, 2)
+	assert.NotContains(t, repo.subscriptions, "sub-1/device123")
+	assert.Equal(t, map[string]*repository.Schedule{"sch-1": repo.schedules["sch-1"]}, repo.schedules)
+
+	// subscription entity removed.
+	sub, err := NewEntity("sub-1", []byte(`{"owner":"admin","properties":{}}`))
+	assert.Nil(t, err)
+	node.removeEntityResources(context.Background(), sub)
+	assert.Len(t, repo.subscriptions, 1)
+	assert.Contains(t, repo.subscriptions, "sub-2/device234")
+}
+
+type relationshipRepo struct {
+	repository.IRepository
+	rels map[string]*repository.Relationship
+}
+
+func (r *relationshipRepo) GetLastRevision(ctx context.Context) int64 {
+	return 0
+}
+
+func (r *relationshipRepo) PutRelationship(ctx context.Context, rel *repository.Relationship) error {
+	key, _ := rel.EncodeKey()
+	r.rels[string(key)] = rel
+	return nil
+}
+
+func (r *relationshipRepo) DelRelationship(ctx context.Context, rel *repository.Relationship) error {
+	key, _ := rel.EncodeKey()
+	delete(r.rels, string(key))
+	return nil
+}
+
+func (r *relationshipRepo) ListRelationship(ctx context.Context, rev int64, req *repository.ListRelationshipReq) ([]*repository.Relationship, error) {
+	var rels []*repository.Relationship
+	for _, rel := range r.rels {
+		if (!req.Reverse && rel.From == req.EntityID) || (req.Reverse && rel.To == req.EntityID) {
+			rels = append(rels, rel)
+		}
+	}
+	return rels, nil
+}
+
+func TestNode_removeEntityRelationships(t *testing.T) {
+	repo := &relationshipRepo{rels: make(map[string]*repository.Relationship)}
+	dispatcher := &forwardDispatcher{}
+	node := NewNode(context.Background(), types.NewResources(nil, nil, nil, repo), dispatcher, nil)
+
+	ctx := context.Background()
+	for _, rel := range []*repository.Relationship{
+		{Type: "contains", From: "site1", To: "gateway1", Owner: "admin", Cascade: repository.RelationshipCascadeDelete},
+		{Type: "contains", From: "gateway1", To: "device1", Owner: "admin", Cascade: repository.RelationshipCascadeDelete},
+		{Type: "contains", From: "gateway1", To: "device3", Owner: "tenant", Cascade: repository.RelationshipCascadeDelete},
+		{Type: "monitors", From: "gateway1", To: "device2", Owner: "admin"},
+		{Type: "reports", From: "device2", To: "gateway1", Owner: "admin"},
+		{Type: "contains", From: "site1", To: "gateway2", Owner: "admin"},
+	} {
+		assert.Nil(t, repo.PutRelationship(ctx, rel))
+	}
+
+	en, err := NewEntity("gateway1", []byte(`{"owner":"admin","properties":{}}`))
+	assert.Nil(t, err)
+	node.removeEntityRelationships(ctx, en, 0)
+
+	// edges from and to gateway1 removed.
+	assert.Len(t, repo.rels, 1)
+	for _, rel := range repo.rels {
+		assert.Equal(t, "gateway2", rel.To)
+	}
+
+	// target of cascade edge deleted, cascade never crosses owners.
+	assert.Len(t, dispatcher.events, 1)
+	assert.Equal(t, "device1", dispatcher.events[0].Entity())
+	assert.Equal(t, v1.ETSystem, dispatcher.events[0].Type())
+}
+
+func TestPlanExpression(t *testing.T) {
+	placement.Initialize()
+	placement.Global().Append(placement.Info{ID: "core/1234", Flag: true})
+
+	mounts, err := PlanExpression(*repository.NewExpression("admin", "device123", "",
+		"properties.temp_f", "device234.properties.temp * 1.8 + 32", ""))
+	assert.Nil(t, err)
+	assert.Equal(t, []*Mount{
+		{Runtime: "core/1234", Tree: TreeEval, Path: "device234.properties.temp",
+			WildcardPath: "device234.properties.temp.*", Target: "device123"},
+		{Runtime: "core/1234", Tree: TreeSub, Path: "device234.properties.temp",
+			WildcardPath: "device234.properties.temp.*", Target: "device123", Delivery: "core/1234"},
+	}, mounts)
+
+	_, err = PlanExpression(repository.Expression{EntityID: "device123", Expression: "device234.properties.temp +"})
+	assert.NotNil(t, err)
+}
+
+func (r *relationshipRepo) GetRelationship(ctx context.Context, rel *repository.Relationship) (*repository.Relationship, error) {
+	key, _ := rel.EncodeKey()
+	if ret, has := r.rels[string(key)]; has {
+		return ret, nil
+	}
+	return nil, xerrors.ErrResourceNotFound
+}
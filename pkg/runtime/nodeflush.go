@@ -18,6 +18,7 @@ package runtime
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 	"strings"
 	"time"
@@ -27,6 +28,8 @@ import (
 	v1 "github.com/tkeel-io/core/api/core/v1"
 	logf "github.com/tkeel-io/core/pkg/logfield"
 	"github.com/tkeel-io/core/pkg/metrics"
+	"github.com/tkeel-io/core/pkg/placement"
+	"github.com/tkeel-io/core/pkg/repository"
 	"github.com/tkeel-io/core/pkg/resource/rawdata"
 	"github.com/tkeel-io/core/pkg/resource/tseries"
 	"github.com/tkeel-io/kit/log"
@@ -300,10 +303,107 @@ func (n *Node) RemoveEntity(ctx context.Context, en Entity, feed *Feed) error {
 		return errors.Wrap(err, "remove entity from state search engine")
 	}
 
-	// 3. 删除实体相关的 Expression.
+	// 3. 删除实体相关的 Expression、Subscription、定时任务及关系.
+	n.removeEntityResources(ctx, en)
 	return nil
 }
 
+// removeEntityResources remove expressions, subscriptions, schedules and relationships of entity,
+// subscriptions sourced from the entity removed too, failures logged only.
+func (n *Node) removeEntityResources(ctx context.Context, en Entity) {
+	repo := n.resourceManager.Repo()
+	if err := repo.DelExprByEnity(ctx, repository.Expression{
+		Owner: en.Owner(), EntityID: en.ID()}); nil != err {
+		log.L().Error("remove entity expressions", logf.Eid(en.ID()), logf.Error(err))
+	}
+
+	// subscriptions sourced from the entity indexed by the runtime owning the entity,
+	// subscriptions of the subscription entity listed by the subscription id.
+	revision := repo.GetLastRevision(ctx)
+	subscriptions, err := repo.ListSubscription(ctx, revision,
+		&repository.ListSubscriptionReq{Owner: en.Owner(), ID: en.ID()})
+	if nil != err {
+		log.L().Error("list entity subscriptions", logf.Eid(en.ID()), logf.Error(err))
+	}
+	if rt, ok := n.runtimes[placement.Global().Select(en.ID()).ID]; ok {
+		subscriptions = append(subscriptions, rt.sourceSubscriptions(en.ID())...)
+	}
+
+	for _, subscription := range subscriptions {
+		if err = repo.DelSubscription(ctx, subscription); nil != err {
+			log.L().Error("remove entity subscription", logf.Eid(en.ID()),
+				logf.ID(subscription.ID), logf.Error(err))
+		}
+	}
+
+	schedules, err := repo.ListSchedule(ctx, revision, &repository.ListScheduleReq{EntityID: en.ID()})
+	if nil != err {
+		log.L().Error("list entity schedules", logf.Eid(en.ID()), logf.Error(err))
+	}
+	for _, schedule := range schedules {
+		if err = repo.DelSchedule(ctx, schedule); nil != err {
+			log.L().Error("remove entity schedule", logf.Eid(en.ID()),
+				logf.ID(schedule.ID), logf.Error(err))
+		}
+	}
+
+	n.removeEntityRelationships(ctx, en, revision)
+}
+
+// removeEntityRelationships remove edges from and to entity, then delete targets of
+// cascade edges from entity, cascaded recursively as targets removed.
+func (n *Node) removeEntityRelationships(ctx context.Context, en Entity, revision int64) {
+	var cascades []*repository.Relationship
+	repo := n.resourceManager.Repo()
+	for _, reverse := range []bool{false, true} {
+		rels, err := repo.ListRelationship(ctx, revision,
+			&repository.ListRelationshipReq{EntityID: en.ID(), Reverse: reverse})
+		if nil != err {
+			log.L().Error("list entity relationships", logf.Eid(en.ID()), logf.Error(err))
+		}
+
+		for _, rel := range rels {
+			if err = repo.DelRelationship(ctx, rel); nil != err {
+				log.L().Error("remove entity relationship", logf.Eid(en.ID()),
+					logf.Type(rel.Type), logf.Target(rel.To), logf.Error(err))
+				continue
+			}
+			// cascade never crosses owners.
+			if !reverse && rel.Cascade == repository.RelationshipCascadeDelete && rel.Owner == en.Owner() {
+				cascades = append(cascades, rel)
+			}
+		}
+	}
+
+	for _, rel := range cascades {
+		log.L().Info("cascade delete entity", logf.Eid(rel.To),
+			logf.Type(rel.Type), logf.Sender(rel.From))
+		if err := n.dispatch.Dispatch(ctx, makeCascadeEvent(rel)); nil != err {
+			log.L().Error("cascade delete entity", logf.Eid(rel.To),
+				logf.Sender(rel.From), logf.Error(err))
+		}
+	}
+}
+
+const bornCascade = "relationship.cascade"
+
+func makeCascadeEvent(rel *repository.Relationship) *v1.ProtoEvent {
+	ev := &v1.ProtoEvent{
+		Id:        fmt.Sprintf("cascade-%s-%s-%s", rel.From, rel.Type, rel.To),
+		Timestamp: time.Now().UnixNano(),
+		Metadata: map[string]string{
+			v1.MetaBorn:     bornCascade,
+			v1.MetaEntityID: rel.To,
+			v1.MetaOwner:    rel.Owner,
+		},
+		Data: &v1.ProtoEvent_SystemData{
+			SystemData: &v1.SystemData{Operator: string(v1.OpDelete)},
+		},
+	}
+	ev.SetType(v1.ETSystem)
+	return ev
+}
+
 func (n *Node) FlushEntity(ctx context.Context, en Entity, feed *Feed) error {
 	return n.resourceManager.Repo().FlushEntity(ctx)
 }
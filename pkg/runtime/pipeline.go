@@ -0,0 +1,192 @@
+package runtime
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/pkg/errors"
+	v1 "github.com/tkeel-io/core/api/core/v1"
+)
+
+// Stage of the execer which handlers of plugins run in.
+type Stage string
+
+const (
+	// StagePre handlers run after built-in pre handlers, before the entity handled,
+	// e.g. validation and enrichment of patches.
+	StagePre Stage = "pre"
+	// StagePost handlers run after built-in post handlers, e.g. custom sinks.
+	StagePost Stage = "post"
+)
+
+// HandlerPlugin is a custom handler registered into the pipeline of runtimes.
+// plugins run only if enabled in configs.
+type HandlerPlugin struct {
+	Name    string
+	Stage   Stage
+	Handler Handler
+	// Events types of events handled, entity and system events if empty.
+	Events []v1.EventType
+}
+
+func (p *HandlerPlugin) accept(typ v1.EventType) bool {
+	if len(p.Events) == 0 {
+		return typ == v1.ETEntity || typ == v1.ETSystem
+	}
+	for _, et := range p.Events {
+		if et == typ {
+			return true
+		}
+	}
+	return false
+}
+
+// HandlerConf enable a plugin registered, plugins enabled run in the order of Order,
+// plugins with same Order run in the order configured.
+type HandlerConf struct {
+	Name    string
+	Enabled bool
+	Order   int
+}
+
+var registry = struct {
+	sync.RWMutex
+	plugins map[string]HandlerPlugin
+}{plugins: make(map[string]HandlerPlugin)}
+
+// RegisterHandler register a plugin, plugins should be registered before the node started.
+func RegisterHandler(plugin HandlerPlugin) error {
+	switch {
+	case plugin.Name == "":
+		return errors.New("register handler, name required")
+	case nil == plugin.Handler:
+		return errors.Errorf("register handler %s, handler required", plugin.Name)
+	case plugin.Stage != StagePre && plugin.Stage != StagePost:
+		return errors.Errorf("register handler %s, invalid stage %s", plugin.Name, plugin.Stage)
+	}
+
+	registry.Lock()
+	defer registry.Unlock()
+	if _, has := registry.plugins[plugin.Name]; has {
+		return errors.Errorf("register handler %s, handler already registered", plugin.Name)
+	}
+	registry.plugins[plugin.Name] = plugin
+	return nil
+}
+
+func unregisterHandler(name string) {
+	registry.Lock()
+	delete(registry.plugins, name)
+	registry.Unlock()
+}
+
+// pluginHandler wrap handler of plugin, skipped if the feed failed.
+type pluginHandler struct {
+	name    string
+	handler Handler
+}
+
+func (h *pluginHandler) Handle(ctx context.Context, feed *Feed) *Feed {
+	if nil != feed.Err {
+		return feed
+	}
+	return h.handler.Handle(ctx, feed)
+}
+
+// Pipeline holds plugins enabled, appended into execers of events.
+type Pipeline struct {
+	plugins []HandlerPlugin
+}
+
+// NewPipeline returns pipeline of plugins enabled in confs, plugins not registered rejected.
+func NewPipeline(confs []HandlerConf) (*Pipeline, error) {
+	registry.RLock()
+	defer registry.RUnlock()
+
+	type item struct {
+		conf   HandlerConf
+		plugin HandlerPlugin
+	}
+
+	items := make([]item, 0, len(confs))
+	configured := make(map[string]bool)
+	for _, conf := range confs {
+		plugin, has := registry.plugins[conf.Name]
+		if !has {
+			return nil, errors.Errorf("new pipeline, handler %s not registered", conf.Name)
+		} else if configured[conf.Name] {
+			return nil, errors.Errorf("new pipeline, handler %s configured repeatedly", conf.Name)
+		}
+
+		configured[conf.Name] = true
+		if conf.Enabled {
+			items = append(items, item{conf: conf, plugin: plugin})
+		}
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].conf.Order < items[j].conf.Order
+	})
+
+	p := &Pipeline{plugins: make([]HandlerPlugin, 0, len(items))}
+	for _, item := range items {
+		p.plugins = append(p.plugins, item.plugin)
+	}
+	return p, nil
+}
+
+// Handlers returns names of plugins enabled in order.
+func (p *Pipeline) Handlers() []string {
+	if nil == p {
+		return nil
+	}
+	names := make([]string, 0, len(p.plugins))
+	for _, plugin := range p.plugins {
+		names = append(names, plugin.Name)
+	}
+	return names
+}
+
+// apply append handlers of plugins which accept the event type into execer.
+func (p *Pipeline) apply(execer *Execer, typ v1.EventType) {
+	if nil == p {
+		return
+	}
+	for index := range p.plugins {
+		plugin := &p.plugins[index]
+		if !plugin.accept(typ) {
+			continue
+		}
+		handler := &pluginHandler{name: plugin.Name, handler: plugin.Handler}
+		switch plugin.Stage {
+		case StagePre:
+			execer.preFuncs = append(execer.preFuncs, handler)
+		case StagePost:
+			execer.postFuncs = append(execer.postFuncs, handler)
+		}
+	}
+}
+
+// Run handle feed through the pipeline, without built-in handlers, plugins run around the
+// entity which state of the feed loaded into, it is a harness for testing plugins.
+func (p *Pipeline) Run(ctx context.Context, feed *Feed) *Feed {
+	entity := DefaultEntity(feed.EntityID)
+	if len(feed.State) > 0 {
+		var err error
+		if entity, err = NewEntity(feed.EntityID, feed.State); nil != err {
+			feed.Err = errors.Wrap(err, "load entity")
+		}
+	}
+
+	execer := &Execer{state: entity, execFunc: entity}
+	p.apply(execer, feed.Event.Type())
+	return execer.Exec(ctx, feed)
+}
+
+// WithPipeline append plugins enabled into execers of entity and system events.
+func WithPipeline(p *Pipeline) Option {
+	return func(r *Runtime) {
+		r.pipeline = p
+	}
+}
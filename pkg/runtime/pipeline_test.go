@@ -0,0 +1,117 @@
+package runtime
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "github.com/tkeel-io/core/api/core/v1"
+	xjson "github.com/tkeel-io/core/pkg/util/json"
+	"github.com/tkeel-io/tdtl"
+)
+
+type funcHandler func(context.Context, *Feed) *Feed
+
+func (fn funcHandler) Handle(ctx context.Context, feed *Feed) *Feed {
+	return fn(ctx, feed)
+}
+
+func registerTestHandlers(t *testing.T, plugins ...HandlerPlugin) {
+	for _, plugin := range plugins {
+		assert.Nil(t, RegisterHandler(plugin))
+		name := plugin.Name
+		t.Cleanup(func() { unregisterHandler(name) })
+	}
+}
+
+func TestRegisterHandler(t *testing.T) {
+	noop := funcHandler(func(_ context.Context, feed *Feed) *Feed { return feed })
+	registerTestHandlers(t, HandlerPlugin{Name: "noop", Stage: StagePost, Handler: noop})
+
+	assert.NotNil(t, RegisterHandler(HandlerPlugin{Name: "noop", Stage: StagePost, Handler: noop}))
+	assert.NotNil(t, RegisterHandler(HandlerPlugin{Stage: StagePost, Handler: noop}))
+	assert.NotNil(t, RegisterHandler(HandlerPlugin{Name: "nil", Stage: StagePost}))
+	assert.NotNil(t, RegisterHandler(HandlerPlugin{Name: "exec", Stage: "exec", Handler: noop}))
+
+	_, err := NewPipeline([]HandlerConf{{Name: "unknown", Enabled: true}})
+	assert.NotNil(t, err)
+	_, err = NewPipeline([]HandlerConf{{Name: "noop"}, {Name: "noop", Enabled: true}})
+	assert.NotNil(t, err)
+}
+
+func TestPipeline_Run(t *testing.T) {
+	var sunk []string
+	registerTestHandlers(t,
+		HandlerPlugin{Name: "validate", Stage: StagePre, Handler: funcHandler(func(_ context.Context, feed *Feed) *Feed {
+			for _, patch := range feed.Patches {
+				temp, _ := strconv.Atoi(patch.Value.String())
+				if patch.Path == "properties.temp" && temp > 100 {
+					feed.Err = errors.New("temp out of range")
+				}
+			}
+			return feed
+		})},
+		HandlerPlugin{Name: "enrich", Stage: StagePre, Handler: funcHandler(func(_ context.Context, feed *Feed) *Feed {
+			feed.Patches = append(feed.Patches, Patch{Op: xjson.OpReplace, Path: "properties.unit", Value: tdtl.New(`"C"`)})
+			return feed
+		})},
+		HandlerPlugin{Name: "sink", Stage: StagePost, Handler: funcHandler(func(_ context.Context, feed *Feed) *Feed {
+			sunk = append(sunk, tdtl.New(feed.State).Get("properties").String())
+			return feed
+		})},
+		HandlerPlugin{Name: "cache", Stage: StagePost, Events: []v1.EventType{v1.ETCache},
+			Handler: funcHandler(func(_ context.Context, feed *Feed) *Feed {
+				sunk = append(sunk, "cache")
+				return feed
+			})},
+	)
+
+	pipeline, err := NewPipeline([]HandlerConf{
+		{Name: "sink", Enabled: true, Order: 2},
+		{Name: "enrich", Enabled: true, Order: 1},
+		{Name: "validate", Enabled: true, Order: 1},
+		{Name: "cache", Enabled: true},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"cache", "enrich", "validate", "sink"}, pipeline.Handlers())
+
+	newFeed := func(temp string) *Feed {
+		return &Feed{
+			Event:    newTxEvent("", "a", ""),
+			EntityID: "iotd-1",
+			State:    []byte(`{"id":"iotd-1","properties":{"temp":20}}`),
+			Patches:  []Patch{{Op: xjson.OpReplace, Path: "properties.temp", Value: tdtl.New(temp)}},
+		}
+	}
+
+	feed := pipeline.Run(context.Background(), newFeed("30"))
+	assert.Nil(t, feed.Err)
+	assert.Equal(t, []string{`{"temp":30,"unit":"C"}`}, sunk)
+	assert.Len(t, feed.Changes, 2)
+
+	feed = pipeline.Run(context.Background(), newFeed("300"))
+	assert.NotNil(t, feed.Err)
+	assert.Equal(t, "validate", feed.Handler)
+	assert.Len(t, sunk, 1)
+
+	// plugins disabled.
+	pipeline, err = NewPipeline([]HandlerConf{{Name: "validate"}})
+	assert.Nil(t, err)
+	feed = pipeline.Run(context.Background(), newFeed("300"))
+	assert.Nil(t, feed.Err)
+	assert.Len(t, sunk, 1)
+}
+
+func TestRuntime_PrepareEventPipeline(t *testing.T) {
+	registerTestHandlers(t, HandlerPlugin{Name: "audit", Stage: StagePost,
+		Handler: funcHandler(func(_ context.Context, feed *Feed) *Feed { return feed })})
+	pipeline, err := NewPipeline([]HandlerConf{{Name: "audit", Enabled: true}})
+	assert.Nil(t, err)
+
+	rt := NewRuntime(context.Background(), EntityResource{}, "core-0", &forwardDispatcher{}, newRebalanceRepo(t), WithPipeline(pipeline))
+	execer, _ := rt.PrepareEvent(context.Background(), newTxEvent("", "a", ""))
+	last := execer.postFuncs[len(execer.postFuncs)-1]
+	assert.Equal(t, "audit", handlerName(last))
+}
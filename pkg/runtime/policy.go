@@ -0,0 +1,169 @@
+package runtime
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"time"
+
+	v1 "github.com/tkeel-io/core/api/core/v1"
+	logf "github.com/tkeel-io/core/pkg/logfield"
+	"github.com/tkeel-io/core/pkg/repository"
+	"github.com/tkeel-io/core/pkg/util"
+	"github.com/tkeel-io/kit/log"
+	"github.com/tkeel-io/tdtl"
+)
+
+// exprGate enforce policy of the expression, the latest result held dispatched when the window ends.
+type exprGate struct {
+	policy repository.ExpressionPolicy
+	// time and numeric value of the result last dispatched.
+	last   time.Time
+	value  float64
+	valued bool
+	// result held and hops of the computed event, dispatched by timer.
+	pending tdtl.Node
+	ttl     int
+	timer   *time.Timer
+	// seq of timer, timers stale not flushed.
+	seq int
+}
+
+// admit reports whether result of the expression dispatched now,
+// result held if debounced or throttled, dropped if within deadband.
+func (r *Runtime) admit(expr repository.Expression, result tdtl.Node, ttl int) bool {
+	if expr.Policy.Empty() {
+		return true
+	}
+
+	r.glock.Lock()
+	defer r.glock.Unlock()
+	gate, has := r.gates[expr.ID]
+	if !has || gate.policy != *expr.Policy {
+		if has && nil != gate.timer {
+			gate.timer.Stop()
+		}
+		gate = &exprGate{policy: *expr.Policy}
+		if nil == r.gates {
+			r.gates = make(map[string]*exprGate)
+		}
+		r.gates[expr.ID] = gate
+	}
+
+	now := time.Now()
+	switch {
+	case gate.policy.Debounce > 0:
+		// window restarts on each change.
+		if nil != gate.timer {
+			gate.timer.Stop()
+		}
+		r.hold(expr.ID, gate, result, ttl, gate.policy.Debounce)
+		return false
+	case gate.policy.MinInterval > 0 && now.Sub(gate.last) < gate.policy.MinInterval:
+		delay := gate.policy.MinInterval - now.Sub(gate.last)
+		if nil != gate.timer {
+			delay = 0
+		}
+		r.hold(expr.ID, gate, result, ttl, delay)
+		return false
+	case !gate.beyond(result):
+		return false
+	}
+
+	gate.dispatched(now, result)
+	return true
+}
+
+// hold result until the timer fired, timer kept if delay is zero.
+func (r *Runtime) hold(exprID string, gate *exprGate, result tdtl.Node, ttl int, delay time.Duration) {
+	gate.pending, gate.ttl = result, ttl
+	if delay <= 0 {
+		return
+	}
+
+	gate.seq++
+	seq := gate.seq
+	gate.timer = time.AfterFunc(delay, func() {
+		r.Execute(func() { r.flushGate(r.ctx, exprID, seq) })
+	})
+}
+
+// flushGate dispatch result held of the expression.
+func (r *Runtime) flushGate(ctx context.Context, exprID string, seq int) {
+	r.glock.Lock()
+	gate, has := r.gates[exprID]
+	if !has || gate.seq != seq || nil == gate.pending {
+		r.glock.Unlock()
+		return
+	}
+
+	result, ttl := gate.pending, gate.ttl
+	gate.pending, gate.timer = nil, nil
+	if !gate.beyond(result) {
+		r.glock.Unlock()
+		return
+	}
+	gate.dispatched(time.Now(), result)
+	r.glock.Unlock()
+
+	exprInfo, has := r.getExpr(exprID)
+	if !has {
+		return
+	}
+
+	log.L().Debug("flush computed", logf.ID(exprID),
+		logf.Eid(exprInfo.EntityID), logf.Value(result.String()))
+	r.dispatcher.Dispatch(ctx, &v1.ProtoEvent{
+		Id:        util.IG().EvID(),
+		Timestamp: time.Now().UnixNano(),
+		Metadata: map[string]string{
+			v1.MetaType:        string(v1.ETEntity),
+			v1.MetaBorn:        "flushComputed",
+			v1.MetaPartitionID: r.ID(),
+			v1.MetaEntityID:    exprInfo.EntityID,
+			v1.MetaTTL:         strconv.Itoa(ttl + 1),
+		},
+		Data: &v1.ProtoEvent_Patches{
+			Patches: &v1.PatchDatas{
+				Patches: r.computedPatches(exprInfo.Expression, result),
+			},
+		},
+	})
+}
+
+// removeGate drop gate of the expression, result held dropped.
+func (r *Runtime) removeGate(exprID string) {
+	r.glock.Lock()
+	if gate, has := r.gates[exprID]; has {
+		if nil != gate.timer {
+			gate.timer.Stop()
+		}
+		delete(r.gates, exprID)
+	}
+	r.glock.Unlock()
+}
+
+// beyond reports whether result changed beyond the deadband, results not numeric always changed.
+func (g *exprGate) beyond(result tdtl.Node) bool {
+	val, ok := nodeFloat(result)
+	return g.policy.Deadband <= 0 || !ok || !g.valued ||
+		math.Abs(val-g.value) >= g.policy.Deadband
+}
+
+func (g *exprGate) dispatched(now time.Time, result tdtl.Node) {
+	g.last = now
+	g.value, g.valued = nodeFloat(result)
+}
+
+func nodeFloat(n tdtl.Node) (float64, bool) {
+	if nil == n {
+		return 0, false
+	}
+
+	switch n.Type() {
+	case tdtl.Int, tdtl.Float, tdtl.Number:
+		val, err := strconv.ParseFloat(n.String(), 64)
+		return val, nil == err
+	}
+	return 0, false
+}
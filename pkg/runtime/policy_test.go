@@ -0,0 +1,92 @@
+package runtime
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "github.com/tkeel-io/core/api/core/v1"
+	"github.com/tkeel-io/core/pkg/repository"
+	"github.com/tkeel-io/tdtl"
+)
+
+// chanDispatcher deliver events dispatched by timers into channel.
+type chanDispatcher struct {
+	dispatcherMock
+	events chan v1.Event
+}
+
+func (d *chanDispatcher) Dispatch(ctx context.Context, event v1.Event) error {
+	d.events <- event
+	return nil
+}
+
+func TestRuntime_admit(t *testing.T) {
+	dispatcher := &chanDispatcher{events: make(chan v1.Event, 10)}
+	rt := &Runtime{
+		id:          "core/1234",
+		ctx:         context.Background(),
+		dispatcher:  dispatcher,
+		expressions: map[string]ExpressionInfo{},
+	}
+
+	expr := *repository.NewExpression("admin", "device2", "", "properties.b", "device1.a", "")
+	withPolicy := func(policy *repository.ExpressionPolicy) repository.Expression {
+		expr.Policy = policy
+		rt.AppendExpression(ExpressionInfo{Expression: expr})
+		return expr
+	}
+	flushed := func() string {
+		select {
+		case ev := <-dispatcher.events:
+			assert.Equal(t, "device2", ev.Entity())
+			patches := ev.(v1.PatchEvent).Patches()
+			assert.Equal(t, "properties.b", patches[0].Path)
+			return string(patches[0].Value)
+		case <-time.After(5 * time.Second):
+			t.Fatal("result held not flushed")
+		}
+		return ""
+	}
+
+	// dispatched on each change if no policy.
+	assert.True(t, rt.admit(withPolicy(nil), tdtl.IntNode(1), 0))
+
+	// numbers within deadband dropped.
+	withPolicy(&repository.ExpressionPolicy{Deadband: 1})
+	assert.True(t, rt.admit(expr, tdtl.IntNode(10), 0))
+	assert.False(t, rt.admit(expr, tdtl.FloatNode(10.5), 0))
+	assert.False(t, rt.admit(expr, tdtl.FloatNode(9.5), 0))
+	assert.True(t, rt.admit(expr, tdtl.FloatNode(11.2), 0))
+	assert.True(t, rt.admit(expr, tdtl.StringNode("on"), 0))
+
+	// the latest result dispatched after no changes for debounce.
+	withPolicy(&repository.ExpressionPolicy{Debounce: 200 * time.Millisecond})
+	for i := 1; i <= 3; i++ {
+		assert.False(t, rt.admit(expr, tdtl.IntNode(i), 0))
+	}
+	assert.Equal(t, "3", flushed())
+
+	// at most once in min interval, the latest result dispatched when the interval ends.
+	withPolicy(&repository.ExpressionPolicy{MinInterval: 200 * time.Millisecond})
+	assert.True(t, rt.admit(expr, tdtl.IntNode(1), 0))
+	assert.False(t, rt.admit(expr, tdtl.IntNode(2), 0))
+	assert.False(t, rt.admit(expr, tdtl.IntNode(3), 0))
+	assert.Equal(t, "3", flushed())
+
+	// interval ended.
+	rt.glock.Lock()
+	rt.gates[expr.ID].last = time.Time{}
+	rt.glock.Unlock()
+	assert.True(t, rt.admit(expr, tdtl.IntNode(4), 0))
+
+	// results held dropped if expression removed.
+	assert.False(t, rt.admit(expr, tdtl.IntNode(5), 0))
+	rt.glock.Lock()
+	seq := rt.gates[expr.ID].seq
+	rt.glock.Unlock()
+	rt.RemoveExpression(expr.ID)
+	rt.flushGate(context.Background(), expr.ID, seq)
+	assert.Len(t, dispatcher.events, 0)
+}
@@ -0,0 +1,159 @@
+package runtime
+
+import (
+	"context"
+
+	v1 "github.com/tkeel-io/core/api/core/v1"
+	logf "github.com/tkeel-io/core/pkg/logfield"
+	"github.com/tkeel-io/core/pkg/placement"
+	"github.com/tkeel-io/core/pkg/repository"
+	"github.com/tkeel-io/kit/log"
+)
+
+// forwardEvent forward entity event to the runtime which owns the entity,
+// events may arrive at the previous owner while placement changing.
+func (r *Runtime) forwardEvent(ctx context.Context, ev v1.Event) bool {
+	switch ev.Type() {
+	case v1.ETEntity, v1.ETSystem, v1.ETFanIn:
+	default:
+		return false
+	}
+
+	info := placement.Global().Select(ev.Entity())
+	if info.ID == "" || info.ID == r.id {
+		return false
+	}
+
+	log.L().Info("forward event", logf.RID(r.id), logf.ID(ev.ID()),
+		logf.Eid(ev.Entity()), logf.String("target", info.ID))
+
+	ev.SetAttr(v1.MetaPartitionID, info.ID)
+	if err := r.dispatcher.Dispatch(ctx, ev); nil != err {
+		log.L().Error("forward event", logf.RID(r.id), logf.ID(ev.ID()),
+			logf.Eid(ev.Entity()), logf.String("target", info.ID), logf.Error(err))
+	}
+	return true
+}
+
+// release detach the entities and subscriptions which not owned by the runtime,
+// entity states are persisted before released, so that the new owner can load them.
+func (r *Runtime) release(ctx context.Context) {
+	r.lock.RLock()
+	released := make(map[string]Entity)
+	for id, en := range r.entities {
+		if placement.Global().Select(id).ID != r.id {
+			released[id] = en
+		}
+	}
+	r.lock.RUnlock()
+
+	for id, en := range released {
+		log.L().Info("release entity", logf.RID(r.id), logf.Eid(id))
+		r.releaseTransaction(ctx, id)
+		r.deleteEntity(id)
+		if err := r.repository.PutEntity(ctx, id, en.Raw()); nil != err {
+			log.L().Error("persistent released entity",
+				logf.RID(r.id), logf.Eid(id), logf.Error(err))
+		}
+	}
+
+	r.slock.Lock()
+	defer r.slock.Unlock()
+	for entityID := range r.entitySubscriptions {
+		if placement.Global().Select(entityID).ID != r.id {
+			delete(r.entitySubscriptions, entityID)
+		}
+	}
+}
+
+func (r *Runtime) listExpressions() []repository.Expression {
+	r.mlock.RLock()
+	defer r.mlock.RUnlock()
+	exprs := make([]repository.Expression, 0, len(r.expressions))
+	for _, exprInfo := range r.expressions {
+		exprs = append(exprs, exprInfo.Expression)
+	}
+	return exprs
+}
+
+func (r *Runtime) deleteExpression(exprID string) {
+	r.RemoveExpression(exprID)
+	r.mlock.Lock()
+	delete(r.expressions, exprID)
+	r.mlock.Unlock()
+}
+
+// onPlacementChanged rebalance runtimes when queue appended or removed.
+func (n *Node) onPlacementChanged(ev placement.Event) {
+	log.L().Info("placement changed, rebalance runtimes",
+		logf.String("type", string(ev.Type)), logf.ID(ev.Info.ID))
+	n.rebalance(n.ctx)
+}
+
+// rebalance release entities no longer owned by runtimes, then remount expressions and
+// subscriptions from repository, runtimes may own entities of runtimes in other nodes.
+// n.lock serializes rebalances only, it is acquired before runtime locks and never
+// acquired while handling events.
+func (n *Node) rebalance(ctx context.Context) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	// 1. release entities & subscriptions.
+	for _, rt := range n.runtimes {
+		rt := rt
+		rt.Execute(func() { rt.release(ctx) })
+	}
+
+	// 2. remount expressions & subscriptions.
+	n.remount(ctx)
+}
+
+func (n *Node) remount(ctx context.Context) {
+	repo := n.resourceManager.Repo()
+	revision := repo.GetLastRevision(ctx)
+
+	// map[runtimeID][exprID], expressions mounted.
+	mounted := make(map[string]map[string]bool)
+	repo.RangeExpression(ctx, revision, func(exprs []*repository.Expression) {
+		for _, expr := range exprs {
+			exprInfos, err := parseExpression(*expr, 1)
+			if nil != err {
+				log.L().Error("parse expression", logf.Eid(expr.EntityID),
+					logf.Expr(expr.Expression), logf.Desc(expr.Description),
+					logf.Mid(expr.Path), logf.Owner(expr.Owner), logf.Name(expr.Name), logf.Error(err))
+				continue
+			}
+
+			for rtID, exprItem := range exprInfos {
+				if rt, has := n.runtimes[rtID]; has {
+					exprItem := exprItem
+					rt.Execute(func() { rt.AppendExpression(*exprItem) })
+					if _, ok := mounted[rtID]; !ok {
+						mounted[rtID] = make(map[string]bool)
+					}
+					mounted[rtID][exprItem.ID] = true
+				}
+			}
+		}
+	})
+
+	// unmount expressions no longer target or source entities of the runtime.
+	for rtID, rt := range n.runtimes {
+		rt, exprIDs := rt, mounted[rtID]
+		rt.Execute(func() {
+			for _, expr := range rt.listExpressions() {
+				if !exprIDs[expr.ID] {
+					rt.deleteExpression(expr.ID)
+				}
+			}
+		})
+	}
+
+	repo.RangeSubscription(ctx, revision, func(subscriptions []*repository.Subscription) {
+		for _, sub := range subscriptions {
+			if rt, has := n.runtimes[placement.Global().Select(sub.SourceEntityID).ID]; has {
+				rt.setSubscription(sub)
+			}
+		}
+	})
+}
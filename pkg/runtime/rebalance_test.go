@@ -0,0 +1,223 @@
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "github.com/tkeel-io/core/api/core/v1"
+	"github.com/tkeel-io/core/pkg/config"
+	"github.com/tkeel-io/core/pkg/dispatch"
+	"github.com/tkeel-io/core/pkg/placement"
+	"github.com/tkeel-io/core/pkg/repository"
+	"github.com/tkeel-io/core/pkg/repository/dao"
+	_ "github.com/tkeel-io/core/pkg/resource/store/memory"
+	"github.com/tkeel-io/core/pkg/types"
+)
+
+type forwardDispatcher struct {
+	dispatcherMock
+	events []v1.Event
+}
+
+func (d *forwardDispatcher) Dispatch(ctx context.Context, event v1.Event) error {
+	d.events = append(d.events, event)
+	return nil
+}
+
+func newRebalanceRepo(t *testing.T) repository.IRepository {
+	daoIns, err := dao.NewMock(context.Background(), config.Metadata{Name: "memory"}, config.EtcdConfig{})
+	assert.Nil(t, err)
+	return repository.New(daoIns)
+}
+
+// metadataRepo keep expressions and subscriptions in memory, ranged by rebalance.
+type metadataRepo struct {
+	repository.IRepository
+	exprs         []*repository.Expression
+	subscriptions []*repository.Subscription
+}
+
+func newMetadataRepo(t *testing.T) *metadataRepo {
+	return &metadataRepo{IRepository: newRebalanceRepo(t)}
+}
+
+func (r *metadataRepo) PutExpression(ctx context.Context, expr repository.Expression) error {
+	r.exprs = append(r.exprs, &expr)
+	return nil
+}
+
+func (r *metadataRepo) RangeExpression(ctx context.Context, rev int64, handler repository.RangeExpressionFunc) {
+	handler(r.exprs)
+}
+
+func (r *metadataRepo) PutSubscription(ctx context.Context, sub *repository.Subscription) error {
+	r.subscriptions = append(r.subscriptions, sub)
+	return nil
+}
+
+func (r *metadataRepo) RangeSubscription(ctx context.Context, rev int64, handler repository.RangeSubscriptionFunc) {
+	handler(r.subscriptions)
+}
+
+type downstreamMock struct {
+	id     string
+	events []v1.Event
+}
+
+func (d *downstreamMock) ID() string { return d.id }
+
+func (d *downstreamMock) Send(ctx context.Context, ev v1.Event) error {
+	d.events = append(d.events, ev)
+	return nil
+}
+
+func (d *downstreamMock) Close() error { return nil }
+
+func TestNode_rebalance(t *testing.T) {
+	placement.Initialize()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	repo := newMetadataRepo(t)
+	node := NewNode(ctx, types.NewResources(nil, nil, nil, repo), &dispatcherMock{}, nil)
+	rt0 := NewRuntime(ctx, EntityResource{}, "core-0", &dispatcherMock{}, repo)
+	rt1 := NewRuntime(ctx, EntityResource{}, "core-1", &dispatcherMock{}, repo)
+	node.runtimes[rt0.ID()] = rt0
+	node.runtimes[rt1.ID()] = rt1
+	placement.Global().Append(placement.Info{ID: rt0.ID(), Flag: true})
+	placement.Global().OnChanged(node.onPlacementChanged)
+
+	total := 100
+	for index := 0; index < total; index++ {
+		entityID := fmt.Sprintf("iotd-%d", index)
+		en, err := NewEntity(entityID, []byte(`{"properties":{"temp":20}}`))
+		assert.Nil(t, err)
+		rt0.entities[entityID] = en
+		sub := &repository.Subscription{ID: "sub-" + entityID, Owner: "admin", SourceEntityID: entityID}
+		assert.Nil(t, repo.PutSubscription(ctx, sub))
+		rt0.setSubscription(sub)
+	}
+
+	placement.Global().Append(placement.Info{ID: rt1.ID(), Flag: true})
+
+	moved := 0
+	for index := 0; index < total; index++ {
+		entityID := fmt.Sprintf("iotd-%d", index)
+		switch placement.Global().Select(entityID).ID {
+		case rt0.ID():
+			assert.Contains(t, rt0.entities, entityID)
+			assert.Contains(t, rt0.entitySubscriptions, entityID)
+		case rt1.ID():
+			moved++
+			assert.NotContains(t, rt0.entities, entityID)
+			assert.NotContains(t, rt0.entitySubscriptions, entityID)
+			assert.Contains(t, rt1.entitySubscriptions[entityID], "sub-"+entityID)
+
+			// released entity can be loaded by the new owner.
+			en, err := rt1.LoadEntity(entityID)
+			assert.Nil(t, err)
+			assert.Equal(t, "20", en.Get("properties.temp").String())
+		}
+	}
+
+	assert.Greater(t, moved, 0)
+	assert.Less(t, moved, total)
+}
+
+func TestNode_scaleDownstreams(t *testing.T) {
+	placement.Initialize()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	downstreams := make(map[string]*downstreamMock)
+	d := dispatch.New(ctx, dispatch.WithDownstreamFactory(func(urlText string) (dispatch.Downstream, error) {
+		downstream := &downstreamMock{id: strings.Split(urlText, "/")[3]}
+		downstreams[downstream.id] = downstream
+		return downstream, nil
+	}))
+
+	repo := newMetadataRepo(t)
+	node := NewNode(ctx, types.NewResources(nil, nil, nil, repo), d, nil)
+	rt0 := NewRuntime(ctx, EntityResource{}, "core-0", d, repo)
+	rt1 := NewRuntime(ctx, EntityResource{}, "core-1", d, repo)
+	node.runtimes[rt0.ID()] = rt0
+	node.runtimes[rt1.ID()] = rt1
+	assert.Nil(t, d.UpdateDownstreams(ctx, []string{"kafka://localhost:9092/core-0/core"}))
+	placement.Global().OnChanged(node.onPlacementChanged)
+
+	// expression of entity owned by rt0, which mounted from repository after rebalanced.
+	var entityID string
+	for index := 0; entityID == ""; index++ {
+		if id := fmt.Sprintf("iotd-%d", index); placement.Global().Select(id).ID == rt0.ID() {
+			entityID = id
+		}
+	}
+	expr := repository.NewExpression("admin", entityID, "", "properties.b", entityID+".properties.a", "")
+	assert.Nil(t, repo.PutExpression(ctx, *expr))
+	sub := &repository.Subscription{ID: "sub-1", Owner: "admin", SourceEntityID: entityID}
+	assert.Nil(t, repo.PutSubscription(ctx, sub))
+	en, err := NewEntity(entityID, []byte(`{"properties":{"a":20}}`))
+	assert.Nil(t, err)
+	rt0.setEntity(entityID, en, 0)
+
+	// scale in, entities of core-0 placed on core-1.
+	assert.Nil(t, d.UpdateDownstreams(ctx, []string{"kafka://localhost:9092/core-1/core"}))
+	assert.Equal(t, rt1.ID(), placement.Global().Select(entityID).ID)
+	assert.NotContains(t, rt0.entities, entityID)
+	assert.Len(t, rt0.listExpressions(), 0)
+	assert.Len(t, rt0.sourceSubscriptions(entityID), 0)
+	_, has := rt1.getExpr(expr.ID)
+	assert.True(t, has)
+	assert.Equal(t, []*repository.Subscription{sub}, rt1.sourceSubscriptions(entityID))
+	loaded, err := rt1.LoadEntity(entityID)
+	assert.Nil(t, err)
+	assert.Equal(t, "20", loaded.Get("properties.a").String())
+
+	// events dispatched to the downstream of the new owner.
+	ev := &v1.ProtoEvent{Id: "ev-1", Metadata: map[string]string{}}
+	ev.SetType(v1.ETEntity)
+	ev.SetEntity(entityID)
+	assert.Nil(t, d.Dispatch(ctx, ev))
+	assert.Len(t, downstreams["core-1"].events, 1)
+	assert.Len(t, downstreams["core-0"].events, 0)
+
+	// events of downstreams removed rejected.
+	ev.SetAttr(v1.MetaPartitionID, "core-0")
+	assert.NotNil(t, d.Dispatch(ctx, ev))
+}
+
+func TestRuntime_forwardEvent(t *testing.T) {
+	placement.Initialize()
+	placement.Global().Append(placement.Info{ID: "core-0", Flag: true})
+	placement.Global().Append(placement.Info{ID: "core-1", Flag: true})
+
+	disp := &forwardDispatcher{}
+	rt := &Runtime{id: "core-0", dispatcher: disp}
+
+	var local, remote string
+	for index := 0; local == "" || remote == ""; index++ {
+		entityID := fmt.Sprintf("iotd-%d", index)
+		if placement.Global().Select(entityID).ID == rt.ID() {
+			local = entityID
+		} else {
+			remote = entityID
+		}
+	}
+
+	ev := &v1.ProtoEvent{Metadata: map[string]string{}}
+	ev.SetType(v1.ETEntity)
+	ev.SetEntity(local)
+	assert.False(t, rt.forwardEvent(context.Background(), ev))
+
+	ev.SetEntity(remote)
+	ev.SetType(v1.ETCache)
+	assert.False(t, rt.forwardEvent(context.Background(), ev))
+
+	ev.SetType(v1.ETEntity)
+	assert.True(t, rt.forwardEvent(context.Background(), ev))
+	assert.Len(t, disp.events, 1)
+	assert.Equal(t, "core-1", disp.events[0].Attr(v1.MetaPartitionID))
+}
@@ -0,0 +1,201 @@
+package runtime
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/tkeel-io/core/pkg/metrics"
+	"github.com/tkeel-io/tdtl"
+)
+
+const (
+	FieldTelemetry string = "properties.telemetry"
+
+	// DefineFieldRetention retention of field defined in configs, `<field>.define.retention`.
+	DefineFieldRetention = "retention"
+
+	retentionMaxKeys  = "max_keys"
+	retentionMaxAge   = "max_age"
+	retentionMaxBytes = "max_bytes"
+)
+
+// retainedField is a field of properties pruned by retention on every write.
+type retainedField struct {
+	name string
+	path string
+	// keyed field holds values keyed by property, pruned by key,
+	// otherwise the field is a single record pruned as a whole.
+	keyed bool
+}
+
+var retainedFields = []retainedField{
+	{name: "telemetry", path: FieldTelemetry, keyed: true},
+	{name: "rawData", path: FieldRawData, keyed: false},
+}
+
+// Retention limits keys, age and bytes of a retained field, unlimited if zero.
+// values pruned in the order of their `ts`, values without `ts` pruned first.
+type Retention struct {
+	MaxKeys  int
+	MaxAge   time.Duration
+	MaxBytes int
+}
+
+func (r Retention) unlimited() bool {
+	return r.MaxKeys <= 0 && r.MaxAge <= 0 && r.MaxBytes <= 0
+}
+
+// DefaultRetention retention of entities if neither configs of entity nor node define retention,
+// bounds telemetry reported without scheme from growing the entity unlimited.
+var DefaultRetention = Retention{
+	MaxKeys:  1000,
+	MaxBytes: 512 * 1024,
+}
+
+var defaultRetention = struct {
+	sync.RWMutex
+	Retention
+}{Retention: DefaultRetention}
+
+// SetDefaultRetention set retention of entities which retention not defined in configs,
+// DefaultRetention used if the retention is unlimited.
+func SetDefaultRetention(retention Retention) {
+	if retention.unlimited() {
+		retention = DefaultRetention
+	}
+	defaultRetention.Lock()
+	defaultRetention.Retention = retention
+	defaultRetention.Unlock()
+}
+
+func getDefaultRetention() Retention {
+	defaultRetention.RLock()
+	defer defaultRetention.RUnlock()
+	return defaultRetention.Retention
+}
+
+// parseRetention parse retention defined in configs, e.g. {"max_keys":100,"max_age":86400,"max_bytes":65536},
+// max_age in seconds.
+func parseRetention(node *tdtl.Collect) (Retention, bool) {
+	switch node.Type() {
+	case tdtl.JSON, tdtl.Object:
+	default:
+		return Retention{}, false
+	}
+
+	var define struct {
+		MaxKeys  int   `json:"max_keys"`
+		MaxAge   int64 `json:"max_age"`
+		MaxBytes int   `json:"max_bytes"`
+	}
+	if err := json.Unmarshal(node.Raw(), &define); nil != err {
+		return Retention{}, false
+	}
+
+	return Retention{
+		MaxKeys:  define.MaxKeys,
+		MaxAge:   time.Duration(define.MaxAge) * time.Second,
+		MaxBytes: define.MaxBytes,
+	}, true
+}
+
+// retain prune retained fields of state, retention defined in configs of the field
+// overrides default retention. returns paths of values pruned.
+func retain(cc *tdtl.Collect, now time.Time) []string {
+	var pruned []string
+	for _, field := range retainedFields {
+		retention, ok := parseRetention(cc.Get(FieldScheme, field.name, "define", DefineFieldRetention))
+		if !ok {
+			retention = getDefaultRetention()
+		}
+		if !retention.unlimited() {
+			pruned = append(pruned, retention.prune(cc, field, now)...)
+		}
+	}
+	return pruned
+}
+
+type retainedValue struct {
+	path string
+	ts   int64
+	size int
+}
+
+func makeRetainedValue(path string, value *tdtl.Collect) retainedValue {
+	var ts int64
+	if val, ok := value.Get("ts").To(tdtl.Int).(tdtl.IntNode); ok {
+		ts = int64(val)
+	}
+	return retainedValue{path: path, ts: ts, size: len(value.Raw())}
+}
+
+// prune values of field exceeding retention, oldest first, returns paths of values pruned.
+// keys defined in configs of keyed field never pruned, nor counted.
+func (r Retention) prune(cc *tdtl.Collect, field retainedField, now time.Time) []string {
+	node := cc.Get(field.path)
+	switch node.Type() {
+	case tdtl.JSON, tdtl.Object:
+	default:
+		return nil
+	}
+
+	var values []retainedValue
+	if field.keyed {
+		defined := make(map[string]bool)
+		tdtl.New(cc.Get(FieldScheme, field.name, "define", "fields").Raw()).
+			Foreach(func(key []byte, value *tdtl.Collect) {
+				defined[string(key)] = true
+			})
+		tdtl.New(node.Raw()).Foreach(func(key []byte, value *tdtl.Collect) {
+			if !defined[string(key)] {
+				values = append(values, makeRetainedValue(field.path+"."+string(key), value))
+			}
+		})
+	} else {
+		values = append(values, makeRetainedValue(field.path, node))
+	}
+
+	total := 0
+	for _, value := range values {
+		total += value.size
+	}
+
+	sort.SliceStable(values, func(i, j int) bool {
+		return values[i].ts < values[j].ts
+	})
+
+	var pruned []string
+	prune := func(value retainedValue, reason string) {
+		cc.Del(value.path)
+		total -= value.size
+		pruned = append(pruned, value.path)
+		metrics.CollectorRetentionPruned.WithLabelValues(field.path, reason).Inc()
+	}
+
+	if r.MaxAge > 0 {
+		// values without ts never expired.
+		var retained []retainedValue
+		expired := now.Add(-r.MaxAge).UnixMilli()
+		for _, value := range values {
+			if value.ts > 0 && value.ts < expired {
+				prune(value, retentionMaxAge)
+				continue
+			}
+			retained = append(retained, value)
+		}
+		values = retained
+	}
+
+	for ; len(values) > 0; values = values[1:] {
+		switch {
+		case r.MaxKeys > 0 && len(values) > r.MaxKeys:
+			prune(values[0], retentionMaxKeys)
+		case r.MaxBytes > 0 && total > r.MaxBytes:
+			prune(values[0], retentionMaxBytes)
+		default:
+			return pruned
+		}
+	}
+	return pruned
+}
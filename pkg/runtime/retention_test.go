@@ -0,0 +1,117 @@
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "github.com/tkeel-io/core/api/core/v1"
+	xjson "github.com/tkeel-io/core/pkg/util/json"
+	"github.com/tkeel-io/tdtl"
+)
+
+func TestRetention_prune(t *testing.T) {
+	now := time.UnixMilli(100000)
+	state := `{"properties":{"telemetry":{
+		"a":{"ts":10000,"value":1},
+		"b":{"ts":90000,"value":2},
+		"c":{"ts":95000,"value":3},
+		"d":{"value":4}}}}`
+
+	tests := []struct {
+		name      string
+		retention Retention
+		pruned    int
+		remains   []string
+	}{
+		{"unlimited", Retention{}, 0, []string{"a", "b", "c", "d"}},
+		{"max_age", Retention{MaxAge: 30 * time.Second}, 1, []string{"b", "c", "d"}},
+		{"max_keys", Retention{MaxKeys: 2}, 2, []string{"b", "c"}},
+		{"max_bytes", Retention{MaxBytes: 50}, 2, []string{"b", "c"}},
+		{"max_age&max_keys", Retention{MaxAge: 30 * time.Second, MaxKeys: 1}, 3, []string{"c"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cc := tdtl.New(state)
+			pruned := tt.retention.prune(cc, retainedFields[0], now)
+			assert.Len(t, pruned, tt.pruned)
+
+			var remains []string
+			cc.Get(FieldTelemetry).Foreach(func(key []byte, value *tdtl.Collect) {
+				remains = append(remains, string(key))
+			})
+			assert.ElementsMatch(t, tt.remains, remains)
+		})
+	}
+}
+
+func TestRetain(t *testing.T) {
+	now := time.UnixMilli(100000)
+	SetDefaultRetention(Retention{MaxAge: 30 * time.Second})
+	defer SetDefaultRetention(DefaultRetention)
+
+	// default retention.
+	cc := tdtl.New(`{"properties":{
+		"telemetry":{"a":{"ts":10000,"value":1},"b":{"ts":90000,"value":2}},
+		"rawData":{"type":"telemetry","ts":10000,"values":"e30="}}}`)
+	assert.Equal(t, []string{"properties.telemetry.a", "properties.rawData"}, retain(cc, now))
+	assert.Equal(t, `{"b":{"ts":90000,"value":2}}`, cc.Get(FieldTelemetry).String())
+	assert.Equal(t, "", cc.Get(FieldRawData).String())
+
+	// retention defined in configs overrides default retention.
+	cc = tdtl.New(`{"scheme":{"telemetry":{"define":{"retention":{"max_keys":1}}}},"properties":{
+		"telemetry":{"a":{"ts":10000,"value":1},"b":{"ts":90000,"value":2},"c":{"ts":20000,"value":3}}}}`)
+	assert.Len(t, retain(cc, now), 2)
+	assert.Equal(t, `{"b":{"ts":90000,"value":2}}`, cc.Get(FieldTelemetry).String())
+
+	// keys defined in configs kept.
+	cc = tdtl.New(`{"scheme":{"telemetry":{"define":{"fields":{"a":{"type":"int"}},"retention":{"max_keys":1}}}},"properties":{
+		"telemetry":{"a":{"ts":10000,"value":1},"b":{"ts":90000,"value":2},"c":{"ts":20000,"value":3}}}}`)
+	assert.Equal(t, []string{"properties.telemetry.c"}, retain(cc, now))
+	assert.Equal(t, `{"a":{"ts":10000,"value":1},"b":{"ts":90000,"value":2}}`, cc.Get(FieldTelemetry).String())
+
+	// unlimited default falls back to DefaultRetention.
+	SetDefaultRetention(Retention{})
+	assert.Equal(t, DefaultRetention, getDefaultRetention())
+	telemetry := make(map[string]interface{}, DefaultRetention.MaxKeys+1)
+	for i := 0; i <= DefaultRetention.MaxKeys; i++ {
+		telemetry[fmt.Sprintf("k%d", i)] = map[string]interface{}{"ts": 10000 + i, "value": i}
+	}
+	raw, err := json.Marshal(map[string]interface{}{"properties": map[string]interface{}{"telemetry": telemetry}})
+	assert.Nil(t, err)
+	cc = tdtl.New(raw)
+	assert.Equal(t, []string{"properties.telemetry.k0"}, retain(cc, now))
+}
+
+func TestEntity_HandleRetention(t *testing.T) {
+	en, err := NewEntity("en-123", []byte(`{"scheme":{"telemetry":{"define":{"retention":{"max_keys":1}}}},
+		"properties":{"telemetry":{"a":{"ts":10000,"value":1}}}}`))
+	assert.Nil(t, err)
+
+	got := en.Handle(context.Background(), &Feed{
+		Event: &v1.ProtoEvent{Metadata: map[string]string{}},
+		Patches: []Patch{{
+			Op:    xjson.OpReplace,
+			Path:  "properties.telemetry.b",
+			Value: tdtl.New(`{"ts":20000,"value":2}`),
+		}},
+	})
+	assert.Nil(t, got.Err)
+
+	// values pruned recorded as changes.
+	assert.Len(t, got.Changes, 2)
+	assert.Equal(t, Patch{Op: xjson.OpRemove, Path: "properties.telemetry.a"}, got.Changes[1])
+	assert.Equal(t, "", tdtl.New(got.State).Get("properties.telemetry.a").String())
+}
+
+func Test_parseRetention(t *testing.T) {
+	retention, ok := parseRetention(tdtl.New(`{"max_keys":10,"max_age":60,"max_bytes":1024}`))
+	assert.True(t, ok)
+	assert.Equal(t, Retention{MaxKeys: 10, MaxAge: time.Minute, MaxBytes: 1024}, retention)
+
+	_, ok = parseRetention(tdtl.New(`{"scheme":{}}`).Get("retention"))
+	assert.False(t, ok)
+}
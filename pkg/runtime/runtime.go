@@ -11,14 +11,16 @@ import (
 
 	"github.com/tkeel-io/core/pkg/placement"
 
-	"github.com/Shopify/sarama"
 	"github.com/pkg/errors"
 	v1 "github.com/tkeel-io/core/api/core/v1"
 	"github.com/tkeel-io/core/pkg/dispatch"
 	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"github.com/tkeel-io/core/pkg/function"
+	"github.com/tkeel-io/core/pkg/history"
 	logf "github.com/tkeel-io/core/pkg/logfield"
 	"github.com/tkeel-io/core/pkg/mapper"
 	"github.com/tkeel-io/core/pkg/mapper/expression"
+	"github.com/tkeel-io/core/pkg/metrics"
 	"github.com/tkeel-io/core/pkg/repository"
 	"github.com/tkeel-io/core/pkg/types"
 	"github.com/tkeel-io/core/pkg/util"
@@ -31,6 +33,8 @@ import (
 const (
 	rawDataRawType       = "rawData"
 	rawDataTelemetryType = "telemetry"
+	// defaultMaxTTL hops of computed events, breaks expressions depending on each other cyclically.
+	defaultMaxTTL = 16
 )
 
 type EntityResourceFunc func(context.Context, Entity, *Feed) error
@@ -53,19 +57,90 @@ type Runtime struct {
 	entityResourcer EntityResource
 	// map[entityID][SubscriptionID]Subscription
 	entitySubscriptions map[string]map[string]*repository.Subscription
-	msgs                chan sarama.ConsumerMessage
+	// map[partition]offset, offset before which all messages handled.
+	offsets map[int32]int64
+	// map[partition]tracker, messages in flight.
+	trackers map[int32]*offsetTracker
+	// map[entityID]mailbox, mailboxes which events queued or being handled.
+	mailboxes map[string]*mailbox
+	ready     chan *mailbox
+	slots     chan struct{}
+	queued    int
+	workers   int
+	queueSize int
+	// entityIndex track recency of entities, bounded by entityLimit.
+	entityIndex *lru
+	entityLimit CacheLimit
+	cacheLimit  CacheLimit
+	// dirty entities which failed to persistent.
+	dirty map[string]struct{}
+	// map[entityID]transaction, entities locked by prepared transactions.
+	transactions map[string]*transaction
+	history      history.History
+	// records queued and offsets committed after records flushed, nil if history disabled.
+	histories chan *historyItem
+	// pipeline plugins appended into execers, disabled if nil.
+	pipeline *Pipeline
+	// maxTTL hops of computed events, computed events exceeding dropped.
+	maxTTL int
+	// map[exprID]windows, windows of aggregations called by expressions.
+	windows map[string]*exprWindows
+	// map[exprID]expression, expressions calling fan-ins, entities selected forwarded.
+	fanInExprs map[string]ExpressionInfo
+	// map[exprID]members, members of fan-ins called by expressions targeting entities of the runtime.
+	fanIns map[string]*exprFanIns
+	// map[from/type/to]related, relationships checked by selectors.
+	relations map[string]bool
+	// map[exprID]gate, policies of expressions enforced.
+	gates map[string]*exprGate
+	// processed event ids of entities, redelivered events skipped.
+	processed *processedIndex
+	// chunks referenced by the latest snapshot persisted, loaded by the first snapshot if nil.
+	chunks map[string]struct{}
 
 	mlock  sync.RWMutex
 	lock   sync.RWMutex
+	tlock  sync.RWMutex
+	wlock  sync.Mutex
+	flock  sync.Mutex
+	glock  sync.Mutex
+	slock  sync.RWMutex
+	plock  sync.Mutex
+	qlock  sync.Mutex
+	exec   sync.RWMutex
 	ctx    context.Context
 	cancel context.CancelFunc
 }
 
-func NewRuntime(ctx context.Context, ercFuncs EntityResource, id string, dispatcher dispatch.Dispatcher, repo repository.IRepository) *Runtime {
+type Option func(*Runtime)
+
+// WithEntityLimit bound entities owned by the runtime.
+func WithEntityLimit(limit CacheLimit) Option {
+	return func(r *Runtime) {
+		r.entityLimit = limit
+	}
+}
+
+// WithCacheLimit bound entities cached from other runtimes.
+func WithCacheLimit(limit CacheLimit) Option {
+	return func(r *Runtime) {
+		r.cacheLimit = limit
+	}
+}
+
+// WithMaxTTL bound hops of computed events, computed events exceeding dropped.
+func WithMaxTTL(ttl int) Option {
+	return func(r *Runtime) {
+		if ttl > 0 {
+			r.maxTTL = ttl
+		}
+	}
+}
+
+func NewRuntime(ctx context.Context, ercFuncs EntityResource, id string, dispatcher dispatch.Dispatcher, repo repository.IRepository, opts ...Option) *Runtime {
 	ctx, cancel := context.WithCancel(ctx)
 	runtime := Runtime{
 		id:                  id,
-		enCache:             NewCache(repo),
 		entities:            map[string]Entity{},
 		expressions:         map[string]ExpressionInfo{},
 		entitySubscriptions: make(map[string]map[string]*repository.Subscription),
@@ -78,9 +153,34 @@ func NewRuntime(ctx context.Context, ercFuncs EntityResource, id string, dispatc
 		mlock:               sync.RWMutex{},
 		cancel:              cancel,
 		ctx:                 ctx,
-		msgs:                make(chan sarama.ConsumerMessage, 10),
+		offsets:             make(map[int32]int64),
+		trackers:            make(map[int32]*offsetTracker),
+		mailboxes:           make(map[string]*mailbox),
+		workers:             defaultWorkers,
+		queueSize:           defaultQueueSize,
+		maxTTL:              defaultMaxTTL,
+		windows:             make(map[string]*exprWindows),
+		fanInExprs:          make(map[string]ExpressionInfo),
+		fanIns:              make(map[string]*exprFanIns),
+		relations:           make(map[string]bool),
+		gates:               make(map[string]*exprGate),
+		processed:           newProcessedIndex(),
+		entityIndex:         newLRU(),
+		dirty:               make(map[string]struct{}),
+		transactions:        make(map[string]*transaction),
+	}
+
+	for _, opt := range opts {
+		opt(&runtime)
+	}
+
+	runtime.enCache = NewCache(id, repo, runtime.cacheLimit)
+	runtime.slots = make(chan struct{}, runtime.queueSize)
+	runtime.ready = make(chan *mailbox, runtime.queueSize)
+	for i := 0; i < runtime.workers; i++ {
+		go runtime.work()
 	}
-	go runtime.deliveredEvent()
+	runtime.startHistory()
 	return &runtime
 }
 
@@ -88,22 +188,15 @@ func (r *Runtime) ID() string {
 	return r.id
 }
 
-func (r *Runtime) DeliveredEvent(ctx context.Context, msg *sarama.ConsumerMessage) {
-	r.msgs <- *msg
-}
-
-func (r *Runtime) deliveredEvent() {
-	for msg := range r.msgs {
-		var err error
-		var ev v1.ProtoEvent
-		if err = v1.Unmarshal(msg.Value, &ev); nil != err {
-			log.L().Error("decode Event", logf.Error(err),
-				logf.Message(string(msg.Value)), logf.RID(r.id))
-			continue
-		}
-
-		r.HandleEvent(context.Background(), &ev)
+// Execute run task exclusively, no event handled while task running.
+func (r *Runtime) Execute(task Task) {
+	if nil != r.ctx.Err() {
+		return
 	}
+
+	r.exec.Lock()
+	defer r.exec.Unlock()
+	task()
 }
 
 type FeedLog struct {
@@ -115,14 +208,36 @@ func (r *Runtime) HandleEvent(ctx context.Context, event v1.Event) error {
 	log.L().Debug("handle event", logf.RID(r.id),
 		logf.Event(event), logf.EvID(event.ID()))
 
+	// forward event if entity has been handed off to another runtime.
+	if r.forwardEvent(ctx, event) {
+		return nil
+	}
+
+	// handle transaction event, or hold event while entity locked.
+	if r.handleTransaction(ctx, event) {
+		return nil
+	}
+
+	// skip event which has been applied.
+	if r.handleDuplicate(ctx, event) {
+		return nil
+	}
+
 	execer, feed := r.PrepareEvent(ctx, event)
+	record := idempotent(event, feed)
 	newFeed := execer.Exec(ctx, feed)
 
+	// record event applied, redelivered event skipped.
+	if nil == newFeed.Err && record {
+		r.recordProcessed(event.Entity(), event.ID())
+	}
+
 	// call callback once.
 	r.handleCallback(ctx, newFeed)
 	if nil != newFeed.Err {
 		log.Error("handle event", logf.Error(newFeed.Err),
 			logf.ID(event.ID()), logf.Eid(event.Entity()), logf.Event(event))
+		r.handleDeadLetter(ctx, event, newFeed)
 	}
 
 	byt, err := json.Marshal(FeedLog{feed, newFeed})
@@ -132,6 +247,8 @@ func (r *Runtime) HandleEvent(ctx context.Context, event v1.Event) error {
 	}
 	r.dispatcher.DispatchToLog(ctx, byt)
 
+	// evict entities after event handled, avoid evicting entity in use.
+	r.evictEntities(ctx)
 	return nil
 }
 
@@ -141,11 +258,17 @@ func (r *Runtime) PrepareEvent(ctx context.Context, ev v1.Event) (*Execer, *Feed
 
 	switch ev.Type() {
 	case v1.ETSystem:
-		return r.handleSystemEvent(ctx, ev)
+		execer, feed := r.handleSystemEvent(ctx, ev)
+		r.pipeline.apply(execer, ev.Type())
+		return execer, feed
 	case v1.ETEntity:
-		return r.handleEntityEvent(ev)
+		execer, feed := r.handleEntityEvent(ev)
+		r.pipeline.apply(execer, ev.Type())
+		return execer, feed
 	case v1.ETCache:
 		return r.handleCacheEvent(ctx, ev)
+	case v1.ETFanIn:
+		return r.handleFanInEvent(ctx, ev)
 	default:
 		return &Execer{}, &Feed{
 			Event:    ev,
@@ -174,6 +297,7 @@ func (r *Runtime) handleCacheEvent(ctx context.Context, ev v1.Event) (*Execer, *
 		postFuncs: []Handler{&handlerImpl{fn: r.handleComputed}},
 	}
 	return execer, &Feed{
+		TTL:      ev.TTL(),
 		Err:      err,
 		Event:    ev,
 		State:    entity.Raw(),
@@ -186,6 +310,7 @@ func (r *Runtime) handleSystemEvent(ctx context.Context, ev v1.Event) (*Execer,
 	execer, feed := r.prepareSystemEvent(ctx, ev)
 	execer.postFuncs = append(execer.postFuncs,
 		&handlerImpl{fn: r.handlePersistent},
+		&handlerImpl{fn: r.handleHistory},
 		&handlerImpl{fn: r.handleFlush})
 	return execer, feed
 }
@@ -207,14 +332,17 @@ func (r *Runtime) handleEntityEvent(ev v1.Event) (*Execer, *Feed) {
 		execFunc: entity,
 		postFuncs: []Handler{
 			&handlerImpl{fn: r.handleTentacle},   // 无变化
+			&handlerImpl{fn: r.handleFanIn},      // 无变化
 			&handlerImpl{fn: r.handleComputed},   // 无变化
 			&handlerImpl{fn: r.handlePersistent}, // 无变化
+			&handlerImpl{fn: r.handleHistory},    //
 			&handlerImpl{fn: r.handleSubscribe},  //
 			&handlerImpl{fn: r.handleTemplate},
 		},
 	} //
 
 	return execer, &Feed{
+		TTL:      ev.TTL(),
 		Err:      err,
 		Event:    ev,
 		State:    entity.Raw(),
@@ -255,6 +383,7 @@ func (r *Runtime) prepareSystemEvent(ctx context.Context, event v1.Event) (*Exec
 			execFunc: DefaultEntity(ev.Entity()),
 			postFuncs: []Handler{
 				&handlerImpl{fn: r.handleTentacle},
+				&handlerImpl{fn: r.handleFanIn},
 				&handlerImpl{fn: r.handleSubscribe}, //
 				&handlerImpl{fn: r.handleComputed},
 				&handlerImpl{fn: func(_ context.Context, feed *Feed) *Feed {
@@ -266,7 +395,7 @@ func (r *Runtime) prepareSystemEvent(ctx context.Context, event v1.Event) (*Exec
 		}
 
 		// check entity exists.
-		if _, exists := r.entities[ev.Entity()]; exists {
+		if _, exists := r.getEntity(ev.Entity()); exists {
 			return execer, &Feed{
 				Event:    ev,
 				EntityID: ev.Entity(),
@@ -298,8 +427,17 @@ func (r *Runtime) prepareSystemEvent(ctx context.Context, event v1.Event) (*Exec
 			}
 		}
 
+		// configs of entity override configs inherited from template.
+		schemePath := FieldScheme
+		if templateID != "" {
+			schemePath = FieldTemplateScheme
+		} else if configs := tdtl.New(action.GetData()).Get(FieldScheme); defined(configs) {
+			// configs of entity created without template, copied from a cloned entity.
+			scheme = configs
+		}
+
 		props := state.Get(FieldProperties)
-		r.entities[ev.Entity()] = state
+		r.setEntity(ev.Entity(), state, int64(len(action.GetData())))
 		execer.state = state
 		execer.execFunc = state
 		return execer, &Feed{
@@ -313,7 +451,7 @@ func (r *Runtime) prepareSystemEvent(ctx context.Context, event v1.Event) (*Exec
 				Value: tdtl.New(props.Raw()),
 			}, {
 				Op:    xjson.OpReplace,
-				Path:  FieldScheme,
+				Path:  schemePath,
 				Value: tdtl.New(scheme.Raw()),
 			}},
 		}
@@ -321,7 +459,19 @@ func (r *Runtime) prepareSystemEvent(ctx context.Context, event v1.Event) (*Exec
 		state, err := r.LoadEntity(ev.Entity())
 		if nil != err {
 			state = DefaultEntity(ev.Entity())
-			if errors.Is(err, xerrors.ErrEntityNotFound) {
+			notFound := errors.Is(err, xerrors.ErrEntityNotFound) || errors.Is(err, xerrors.ErrResourceNotFound)
+			if notFound && ev.Attr(v1.MetaIfVersion) != "" {
+				// version precondition of entity not exists failed.
+				return &Execer{
+					state:    state,
+					execFunc: state,
+				}, &Feed{
+					Err:      xerrors.ErrEntityVersionConflict,
+					Event:    ev,
+					State:    state.Raw(),
+					EntityID: ev.Entity(),
+				}
+			} else if errors.Is(err, xerrors.ErrEntityNotFound) {
 				// TODO: if entity not exists.
 				return &Execer{
 						state:    state,
@@ -341,6 +491,14 @@ func (r *Runtime) prepareSystemEvent(ctx context.Context, event v1.Event) (*Exec
 			execFunc: state,
 			preFuncs: []Handler{
 				&handlerImpl{fn: func(ctx context.Context, feed *Feed) *Feed {
+					// check version precondition.
+					if innerErr := checkVersion(state, ev); nil != innerErr {
+						log.L().Warn("delete entity", logf.Eid(ev.Entity()),
+							logf.Reason(innerErr.Error()), logf.ID(ev.ID()), logf.Header(ev.Attributes()))
+						feed.Err = innerErr
+						return feed
+					}
+
 					if innerErr := r.entityResourcer.RemoveHandler(ctx, state, feed); nil != innerErr {
 						log.L().Error("delete entity failure", logf.Eid(ev.Entity()),
 							logf.Error(innerErr), logf.ID(ev.ID()), logf.Header(ev.Attributes()))
@@ -349,13 +507,15 @@ func (r *Runtime) prepareSystemEvent(ctx context.Context, event v1.Event) (*Exec
 					}
 
 					// remove entity from runtime.
-					delete(r.entities, state.ID())
+					r.deleteEntity(state.ID())
+					r.removeProcessed(ctx, state.ID())
 
 					return feed
 				}},
 			},
 			postFuncs: []Handler{
 				&handlerImpl{fn: r.handleTentacle},
+				&handlerImpl{fn: r.handleFanIn},
 				&handlerImpl{fn: func(_ context.Context, feed *Feed) *Feed {
 					log.L().Info("delete entity successed", logf.Eid(ev.Entity()),
 						logf.ID(ev.ID()), logf.Header(ev.Attributes()))
@@ -405,6 +565,14 @@ func (r *Runtime) handleComputed(ctx context.Context, feed *Feed) *Feed {
 		}
 	}
 
+	// drop computed events exceeding max ttl, expressions depend on each other cyclically.
+	if len(expressions) > 0 && r.maxTTL > 0 && feed.TTL >= r.maxTTL {
+		log.L().Warn("handle computed, exceeding max ttl", logf.Eid(entityID),
+			logf.RID(r.id), logf.Any("ttl", feed.TTL), logf.Any("max_ttl", r.maxTTL))
+		metrics.CollectorComputedDropped.WithLabelValues(r.id, entityID).Inc()
+		return feed
+	}
+
 	patches := make(map[string][]*v1.PatchData)
 	for id, expr := range expressions {
 		target := expr.EntityID
@@ -431,15 +599,14 @@ func (r *Runtime) handleComputed(ctx context.Context, feed *Feed) *Feed {
 				logf.Eid(entityID), logf.Mid(id),
 				logf.Expr(expr.Expression.Expression))
 			continue
+		} else if !r.admit(expr.Expression, result, feed.TTL) {
+			log.L().Debug("eval expression, result held or dropped by policy.",
+				logf.Eid(entityID), logf.Mid(id), logf.Value(result.String()))
+			continue
 		}
 
-		patches[target] = append(
-			patches[target],
-			&v1.PatchData{
-				Operator: xjson.OpReplace.String(),
-				Path:     expr.Expression.Path,
-				Value:    result.Raw(),
-			})
+		patches[target] = append(patches[target],
+			r.computedPatches(expr.Expression, result)...)
 	}
 
 	// 2. dispatch.send()
@@ -452,6 +619,7 @@ func (r *Runtime) handleComputed(ctx context.Context, feed *Feed) *Feed {
 				v1.MetaBorn:        "handleComputed",
 				v1.MetaPartitionID: r.ID(),
 				v1.MetaEntityID:    target,
+				v1.MetaTTL:         strconv.Itoa(feed.TTL + 1),
 			},
 			Data: &v1.ProtoEvent_Patches{
 				Patches: &v1.PatchDatas{
@@ -484,7 +652,7 @@ func (r *Runtime) evalExpression(ctx context.Context, expr repository.Expression
 
 		var state Entity
 		// get value from entities.
-		if state, has = r.entities[watchKey.EntityID]; has {
+		if state, has = r.getEntity(watchKey.EntityID); has {
 			in[item.path] = state.Get(watchKey.PropertyKey)
 			continue
 		}
@@ -494,18 +662,26 @@ func (r *Runtime) evalExpression(ctx context.Context, expr repository.Expression
 		}
 	}
 
-	// ignore empty input.
-	if len(in) == 0 {
+	// ignore empty input, members of fan-ins kept by runtime.
+	if len(in) == 0 && len(exprInfo.fanIns) == 0 {
 		log.L().Warn("ignore empty input",
 			logf.ID(expr.ID), logf.Expr(expr.Expression))
 		return nil, nil
 	}
 
-	exprIns, err := expression.NewExpr(exprInfo.Expression.Expression, nil)
-	if nil != err {
-		log.L().Error("parse expression",
-			logf.Eid(expr.EntityID), logf.Error(err))
-		return nil, errors.Wrap(err, "parse expression")
+	funcs := function.Funcs()
+	if len(exprInfo.fanIns) > 0 {
+		funcs = r.fanInFuncs(exprInfo.Expression, funcs)
+	}
+
+	// windows of aggregations kept by runtime.
+	var exprIns expression.IExpression = &windowEvaler{runtime: r, expr: exprInfo.Expression, funcs: funcs}
+	if !exprInfo.windowed {
+		if exprIns, err = expression.NewExpr(exprInfo.Expression.Expression, funcs); nil != err {
+			log.L().Error("parse expression",
+				logf.Eid(expr.EntityID), logf.Error(err))
+			return nil, errors.Wrap(err, "parse expression")
+		}
 	}
 
 	// eval expression.
@@ -529,6 +705,25 @@ func (r *Runtime) evalExpression(ctx context.Context, expr repository.Expression
 	return out, nil
 }
 
+// computedPatches returns patches of the result, states of the expression persisted along.
+func (r *Runtime) computedPatches(expr repository.Expression, result tdtl.Node) []*v1.PatchData {
+	var patches []*v1.PatchData
+	if nil != result {
+		patches = append(patches, &v1.PatchData{
+			Operator: xjson.OpReplace.String(),
+			Path:     expr.Path,
+			Value:    result.Raw(),
+		})
+	}
+
+	for _, patch := range []*v1.PatchData{r.windowPatch(expr), r.fanInPatch(expr)} {
+		if nil != patch {
+			patches = append(patches, patch)
+		}
+	}
+	return patches
+}
+
 func mergePath(subPath, changePath string) string {
 	// subPath format: entity_id.property_key
 	watchKey := mapper.NewWatchKey(subPath)
@@ -606,6 +801,7 @@ func (r *Runtime) handleTentacle(ctx context.Context, feed *Feed) *Feed {
 				v1.MetaBorn:        "handleTentacle",
 				v1.MetaPartitionID: runtimeID,
 				v1.MetaSender:      entityID,
+				v1.MetaTTL:         strconv.Itoa(feed.TTL + 1),
 			},
 			Data: &v1.ProtoEvent_Patches{
 				Patches: &v1.PatchDatas{
@@ -668,12 +864,19 @@ func (r *Runtime) handleCallback(ctx context.Context, feed *Feed) error {
 
 func (r *Runtime) handlePersistent(ctx context.Context, feed *Feed) *Feed {
 	log.L().Debug("handle persistent", logf.Eid(feed.EntityID))
-	en, ok := r.entities[feed.EntityID]
+	en, ok := r.getEntity(feed.EntityID)
 	if !ok {
 		// entity has been deleted.
 		return feed
 	}
-	r.entityResourcer.PersistentEntity(ctx, en, feed)
+
+	// record event changed the entity before persisted, so that the window persisted with the state.
+	if ev := feed.Event; nil == feed.Err && nil != ev && ev.ID() != "" &&
+		v1.ETEntity == ev.Type() && len(feed.Changes) > 0 {
+		r.recordProcessed(ev.Entity(), ev.ID())
+	}
+
+	r.persistentEntity(ctx, en, feed)
 	return feed
 }
 
@@ -687,50 +890,62 @@ func (r *Runtime) handleFlush(ctx context.Context, feed *Feed) *Feed {
 
 func (r *Runtime) handleTemplate(ctx context.Context, feed *Feed) *Feed {
 	log.L().Debug("handle template", logf.Eid(feed.EntityID))
+	en, ok := r.getEntity(feed.EntityID)
+	if !ok || nil != feed.Err {
+		return feed
+	}
+
+	var schemeChanged bool
 	for index := range feed.Changes {
-		if FieldTemplate == feed.Changes[index].Path {
+		switch {
+		case FieldTemplate == feed.Changes[index].Path:
 			log.Info("entity template changed", logf.Eid(feed.EntityID),
 				logf.Template(feed.Changes[index].Value.String()))
-			feed.Err = r.onTemplateChanged(ctx,
-				feed.EntityID, feed.Changes[index].Value.String())
-			break
+			feed.Err = r.onTemplateChanged(ctx, en, feed.Changes[index].Value.String())
+		case isFieldScheme(feed.Changes[index].Path):
+			schemeChanged = true
+		}
+	}
+
+	// entity created from template, scheme inherited when created.
+	if v1.ETSystem == feed.Event.Type() && en.TemplateID() != "" {
+		if err := r.bindTemplate(ctx, en.ID(), en.TemplateID(), en.Owner()); nil != err {
+			log.L().Error("bind template", logf.Eid(en.ID()),
+				logf.Template(en.TemplateID()), logf.Error(err))
+		}
+	}
+
+	// entity may be template of other entities.
+	if schemeChanged {
+		if err := r.propagateTemplate(ctx, en); nil != err {
+			log.L().Error("propagate template", logf.Eid(en.ID()), logf.Error(err))
+			feed.Err = err
 		}
 	}
 	return feed
 }
 
-func (r *Runtime) onTemplateChanged(ctx context.Context, entityID, templateID string) error {
-	log.L().Debug("entity template changed", logf.Eid(entityID), logf.Template(templateID))
+func (r *Runtime) onTemplateChanged(ctx context.Context, en Entity, templateID string) error {
+	log.L().Debug("entity template changed", logf.Eid(en.ID()), logf.Template(templateID))
+	if templateID == en.ID() {
+		return errors.Wrap(xerrors.ErrInvalidRequest, "On Template Changed, template self")
+	} else if err := r.bindTemplate(ctx, en.ID(), templateID, en.Owner()); nil != err {
+		log.L().Error("onTemplateChanged", logf.Error(err),
+			logf.Eid(en.ID()), logf.Template(templateID))
+		return errors.Wrap(err, "On Template Changed")
+	} else if templateID == "" {
+		return nil
+	}
+
 	// load template entity.
 	templateIns, err := r.LoadEntity(templateID)
 	if nil != err {
 		log.L().Error("onTemplateChanged", logf.Error(err),
-			logf.Eid(entityID), logf.Template(templateID))
+			logf.Eid(en.ID()), logf.Template(templateID))
 		return errors.Wrap(err, "On Template Changed")
 	}
 
-	ev := &v1.ProtoEvent{
-		Id:        entityID,
-		Timestamp: time.Now().UnixNano(),
-		Metadata: map[string]string{
-			v1.MetaType:     string(v1.ETEntity),
-			v1.MetaBorn:     "onTemplateChanged",
-			v1.MetaEntityID: entityID,
-			v1.MetaSender:   entityID,
-		},
-		Data: &v1.ProtoEvent_Patches{
-			Patches: &v1.PatchDatas{
-				Patches: []*v1.PatchData{
-					{
-						Path:     FieldScheme,
-						Value:    templateIns.Scheme().Raw(),
-						Operator: xjson.OpReplace.String(),
-					},
-				},
-			},
-		},
-	}
-	err = r.dispatcher.Dispatch(ctx, ev)
+	err = r.dispatcher.Dispatch(ctx, makeTemplateEvent(en.ID(), templateID, "onTemplateChanged", templateIns.Scheme()))
 	return errors.Wrap(err, "On Template Changed")
 }
 
@@ -980,6 +1195,8 @@ func (r *Runtime) AppendExpression(exprInfo ExpressionInfo) {
 
 	// cache expression info.
 	r.setExpr(exprInfo)
+	r.setFanInExpr(exprInfo)
+	r.removeGate(exprInfo.ID)
 
 	// mount sub-endpoint to sub-tree.
 	for _, item := range exprInfo.subEndpoints {
@@ -1009,6 +1226,9 @@ func (r *Runtime) RemoveExpression(exprID string) {
 		for _, item := range exprInfo.evalEndpoints {
 			r.evalTree.Remove(item.WildcardPath(), &item)
 		}
+		r.removeWindows(exprID)
+		r.removeFanIns(exprID)
+		r.removeGate(exprID)
 	}
 }
 
@@ -1039,13 +1259,8 @@ func (r *Runtime) initializeExpression(ctx context.Context, expr ExpressionInfo)
 		log.L().Debug("eval expression", logf.Expr(expr.Expression.Expression),
 			logf.Eid(expr.EntityID), logf.ID(expr.ID), logf.Owner(expr.Owner))
 
-		patches = append(
-			patches,
-			&v1.PatchData{
-				Operator: xjson.OpReplace.String(),
-				Path:     expr.Expression.Path,
-				Value:    result.Raw(),
-			})
+		patches = append(patches,
+			r.computedPatches(expr.Expression, result)...)
 
 		// 2. dispatch.send() .
 		r.dispatcher.Dispatch(ctx, &v1.ProtoEvent{
@@ -1121,10 +1336,13 @@ func (r *Runtime) initializeExpression(ctx context.Context, expr ExpressionInfo)
 func (r *Runtime) LoadEntity(id string) (Entity, error) {
 	r.lock.Lock()
 	if state, ok := r.entities[id]; ok {
+		r.entityIndex.Touch(id)
 		r.lock.Unlock()
+		metrics.CollectorEntityCache.WithLabelValues(r.id, metrics.CacheTypeEntity, metrics.CacheResultHit).Inc()
 		return state, nil
 	}
 	r.lock.Unlock()
+	metrics.CollectorEntityCache.WithLabelValues(r.id, metrics.CacheTypeEntity, metrics.CacheResultMiss).Inc()
 
 	// load from state storage.
 	jsonData, err := r.repository.GetEntity(context.TODO(), id)
@@ -1144,9 +1362,8 @@ func (r *Runtime) LoadEntity(id string) (Entity, error) {
 
 	info := placement.Global().Select(id)
 	if info.ID == r.ID() {
-		r.lock.Lock()
-		r.entities[id] = en
-		r.lock.Unlock()
+		r.loadProcessed(context.TODO(), id)
+		r.setEntity(id, en, int64(len(jsonData)))
 	}
 	return en, nil
 }
@@ -1158,6 +1375,7 @@ func conv(patches []*v1.PatchData) []Patch {
 			Op:    xjson.NewPatchOp(patch.Operator),
 			Path:  patch.Path,
 			Value: tdtl.New(patch.Value),
+			From:  patch.From,
 		})
 	}
 	return res
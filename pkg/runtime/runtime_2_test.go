@@ -167,8 +167,6 @@ func TestEntity_HandleEntity_benchmark(t *testing.T) {
 			noop(ret)
 		}
 	}))
-	time.Sleep(3 * time.Second)
-	cleanTelemetry(e)
 	t.Log(p(func() {
 		for i := 0; i < 200; i++ {
 			ret := handleEntity(t, v, e)
@@ -178,13 +176,6 @@ func TestEntity_HandleEntity_benchmark(t *testing.T) {
 	}))
 }
 
-func cleanTelemetry(e Entity) {
-	switch e := e.(type) {
-	case *entity:
-		e.cleanTelemetry()
-	}
-}
-
 func entityCopy(e Entity) *tdtl.JSONNode {
 	switch e := e.(type) {
 	case *entity:
@@ -206,7 +197,6 @@ func Test_merge(t *testing.T) {
 	t.Log(err)
 	e, err := NewEntity("iotd-e130acba-1fd5-4e4f-8b51-5824d930e19f", bytes)
 	t.Log(err)
-	cleanTelemetry(e)
 	feed := &Feed{}
 	t.Log(p(func() {
 		for i := 0; i < 100; i++ {
@@ -232,7 +222,6 @@ func Test_merge1(t *testing.T) {
 	t.Log(err)
 	e, err := NewEntity("iotd-e130acba-1fd5-4e4f-8b51-5824d930e19f", bytes)
 	t.Log(err)
-	cleanTelemetry(e)
 	feed := &Feed{}
 	t.Log(p(func() {
 		for i := 0; i < 100; i++ {
@@ -258,8 +247,12 @@ func handleEntity(t *testing.T, event v1.PatchEvent, entity Entity) *Feed {
 		entities: map[string]Entity{
 			entity.ID(): entity,
 		},
-		subTree:  path.NewRefTree(),
-		evalTree: path.New(),
+		entityIndex: newLRU(),
+		dirty:       map[string]struct{}{},
+		processed:   newProcessedIndex(),
+		repository:  newRebalanceRepo(t),
+		subTree:     path.NewRefTree(),
+		evalTree:    path.New(),
 		entityResourcer: EntityResource{
 			PersistentEntity: func(ctx context.Context, en Entity, feed *Feed) error {
 				globalData, err := n.makeSearchData(en, feed)
@@ -556,6 +556,8 @@ func TestRuntime_handleEntityEvent(t *testing.T) {
 		entities: map[string]Entity{
 			entity.ID(): entity,
 		},
+		entityIndex: newLRU(),
+		dirty:       map[string]struct{}{},
 		enCache: NewCacheMock(map[string]Entity{
 			"iotd-06a96c8d-c166-447c-afd1-63010636b362": en,
 		}),
@@ -0,0 +1,319 @@
+package runtime
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"hash/fnv"
+	"time"
+
+	jsoniter "github.com/json-iterator/go"
+	"github.com/pkg/errors"
+	logf "github.com/tkeel-io/core/pkg/logfield"
+	"github.com/tkeel-io/core/pkg/placement"
+	"github.com/tkeel-io/kit/log"
+)
+
+const (
+	// snapshotChunkSize approximate bytes of a snapshot chunk, bounded below value size limits of state stores.
+	snapshotChunkSize = 1 << 20
+	// snapshotMinChunks chunks of a snapshot at least, doubled while chunks exceed snapshotChunkSize.
+	snapshotMinChunks = 4
+)
+
+// Snapshot is the manifest of a runtime at the time of the recorded kafka offsets,
+// entities bucketed into chunks by id, chunks keyed by the hash of content so that
+// chunks unchanged are not rewritten.
+type Snapshot struct {
+	ID        string          `json:"id"`
+	Timestamp int64           `json:"timestamp"`
+	Offsets   map[int32]int64 `json:"offsets"`
+	Chunks    []string        `json:"chunks,omitempty"`
+	// entities held inline by snapshots of earlier versions.
+	snapshotChunk
+}
+
+// snapshotChunk is the entities and caches of a bucket.
+type snapshotChunk struct {
+	Entities map[string]jsoniter.RawMessage `json:"entities,omitempty"`
+	Caches   map[string]jsoniter.RawMessage `json:"caches,omitempty"`
+	// Processed event ids of entities, redelivered events skipped after restored.
+	Processed map[string][]string `json:"processed,omitempty"`
+}
+
+// Snapshot persist entities held by the runtime and the offsets they reflect,
+// chunks persisted before the manifest, chunks no longer referenced dropped after.
+func (r *Runtime) Snapshot(ctx context.Context) error {
+	var err error
+	var snap *Snapshot
+	var chunks map[string][]byte
+	var elapsed = time.Now()
+	r.Execute(func() {
+		snap, chunks, err = r.snapshot()
+	})
+
+	if nil != err {
+		log.L().Error("make runtime snapshot", logf.RID(r.id), logf.Error(err))
+		return errors.Wrap(err, "make runtime snapshot")
+	}
+
+	written := 0
+	persisted := r.persistedChunks(ctx)
+	for chunkID, bytes := range chunks {
+		if _, has := persisted[chunkID]; has {
+			continue
+		}
+
+		if err = r.repository.PutSnapshotChunk(ctx, r.id, chunkID, bytes); nil != err {
+			log.L().Error("persistent runtime snapshot chunk", logf.RID(r.id), logf.ID(chunkID), logf.Error(err))
+			return errors.Wrap(err, "persistent runtime snapshot chunk")
+		}
+		written++
+	}
+
+	bytes, err := json.Marshal(snap)
+	if nil != err {
+		log.L().Error("encode runtime snapshot", logf.RID(r.id), logf.Error(err))
+		return errors.Wrap(err, "encode runtime snapshot")
+	} else if err = r.repository.PutSnapshot(ctx, r.id, bytes); nil != err {
+		log.L().Error("persistent runtime snapshot", logf.RID(r.id), logf.Error(err))
+		return errors.Wrap(err, "persistent runtime snapshot")
+	}
+
+	r.chunks = make(map[string]struct{}, len(chunks))
+	for chunkID := range chunks {
+		r.chunks[chunkID] = struct{}{}
+	}
+
+	for chunkID := range persisted {
+		if _, has := chunks[chunkID]; has {
+			continue
+		}
+		if err = r.repository.DelSnapshotChunk(ctx, r.id, chunkID); nil != err {
+			log.L().Warn("drop runtime snapshot chunk", logf.RID(r.id), logf.ID(chunkID), logf.Reason(err.Error()))
+		}
+	}
+
+	log.L().Info("runtime snapshot completed", logf.RID(r.id),
+		logf.Int("chunks", len(chunks)), logf.Int("written", written),
+		logf.Elapsedms(time.Since(elapsed).Milliseconds()))
+	return nil
+}
+
+// persistedChunks returns chunks referenced by the latest snapshot, loaded from
+// the manifest if the runtime has not snapshotted or restored yet.
+func (r *Runtime) persistedChunks(ctx context.Context) map[string]struct{} {
+	if nil != r.chunks {
+		return r.chunks
+	}
+
+	chunks := make(map[string]struct{})
+	bytes, err := r.repository.GetSnapshot(ctx, r.id)
+	if nil != err {
+		return chunks
+	}
+
+	var snap Snapshot
+	if err = json.Unmarshal(bytes, &snap); nil != err {
+		log.L().Warn("decode runtime snapshot", logf.RID(r.id), logf.Reason(err.Error()))
+		return chunks
+	}
+
+	for _, chunkID := range snap.Chunks {
+		chunks[chunkID] = struct{}{}
+	}
+	return chunks
+}
+
+// snapshot must be called in the runtime event loop, returns manifest and chunks keyed by hash.
+func (r *Runtime) snapshot() (*Snapshot, map[string][]byte, error) {
+	caches, err := r.enCache.Snapshot()
+	if nil != err {
+		return nil, nil, errors.Wrap(err, "snapshot cache")
+	}
+
+	size := 0
+	r.lock.RLock()
+	eids := make([]string, 0, len(r.entities))
+	entities := make(map[string][]byte, len(r.entities))
+	for id, en := range r.entities {
+		eids = append(eids, id)
+		entities[id] = en.Raw()
+		size += len(entities[id])
+	}
+	r.lock.RUnlock()
+	processed := r.processedEvents(eids)
+
+	for _, raw := range caches {
+		size += len(raw)
+	}
+
+	buckets := snapshotMinChunks
+	for buckets*snapshotChunkSize < size {
+		buckets *= 2
+	}
+
+	parts := make([]snapshotChunk, buckets)
+	for id, raw := range entities {
+		part := &parts[bucketOf(id, buckets)]
+		if nil == part.Entities {
+			part.Entities = make(map[string]jsoniter.RawMessage)
+			part.Processed = make(map[string][]string)
+		}
+		part.Entities[id] = raw
+		if ids, has := processed[id]; has {
+			part.Processed[id] = ids
+		}
+	}
+
+	for id, raw := range caches {
+		part := &parts[bucketOf(id, buckets)]
+		if nil == part.Caches {
+			part.Caches = make(map[string]jsoniter.RawMessage)
+		}
+		part.Caches[id] = raw
+	}
+
+	snap := &Snapshot{
+		ID:        r.id,
+		Timestamp: time.Now().UnixNano() / 1e6,
+		Offsets:   r.Offsets(),
+	}
+
+	chunks := make(map[string][]byte)
+	for index := range parts {
+		part := &parts[index]
+		if len(part.Entities) == 0 && len(part.Caches) == 0 {
+			continue
+		}
+
+		// map keys sorted, content of chunk unchanged encoded identically.
+		bytes, err := json.Marshal(part)
+		if nil != err {
+			return nil, nil, errors.Wrap(err, "encode snapshot chunk")
+		}
+
+		chunkID := fmt.Sprintf("%x", sha256.Sum256(bytes))
+		snap.Chunks = append(snap.Chunks, chunkID)
+		chunks[chunkID] = bytes
+	}
+	return snap, chunks, nil
+}
+
+func bucketOf(id string, buckets int) int {
+	h := fnv.New32a()
+	h.Write([]byte(id))
+	return int(h.Sum32() % uint32(buckets))
+}
+
+// Restore warm the runtime from the latest snapshot, must be called before consuming.
+func (r *Runtime) Restore(ctx context.Context) error {
+	bytes, err := r.repository.GetSnapshot(ctx, r.id)
+	if nil != err {
+		log.L().Warn("load runtime snapshot", logf.RID(r.id), logf.Reason(err.Error()))
+		return errors.Wrap(err, "load runtime snapshot")
+	}
+
+	var snap Snapshot
+	if err = json.Unmarshal(bytes, &snap); nil != err {
+		log.L().Error("decode runtime snapshot", logf.RID(r.id), logf.Error(err))
+		return errors.Wrap(err, "decode runtime snapshot")
+	}
+
+	// restore entities of chunks one by one, caches restored at last.
+	caches := make(map[string][]byte)
+	restored, err := r.restoreChunk(&snap.snapshotChunk, caches)
+	if nil != err {
+		return errors.Wrap(err, "restore runtime snapshot")
+	}
+
+	for _, chunkID := range snap.Chunks {
+		bytes, err = r.repository.GetSnapshotChunk(ctx, r.id, chunkID)
+		if nil != err {
+			log.L().Error("load runtime snapshot chunk", logf.RID(r.id), logf.ID(chunkID), logf.Error(err))
+			return errors.Wrap(err, "load runtime snapshot chunk")
+		}
+
+		var part snapshotChunk
+		if err = json.Unmarshal(bytes, &part); nil != err {
+			log.L().Error("decode runtime snapshot chunk", logf.RID(r.id), logf.ID(chunkID), logf.Error(err))
+			return errors.Wrap(err, "decode runtime snapshot chunk")
+		}
+
+		count, err := r.restoreChunk(&part, caches)
+		if nil != err {
+			return errors.Wrap(err, "restore runtime snapshot")
+		}
+		restored += count
+	}
+
+	if err = r.enCache.Restore(caches); nil != err {
+		log.L().Error("restore cache", logf.RID(r.id), logf.Error(err))
+		return errors.Wrap(err, "restore cache")
+	}
+
+	r.chunks = make(map[string]struct{}, len(snap.Chunks))
+	for _, chunkID := range snap.Chunks {
+		r.chunks[chunkID] = struct{}{}
+	}
+
+	r.lock.Lock()
+	for partition, offset := range snap.Offsets {
+		r.offsets[partition] = offset
+	}
+	r.lock.Unlock()
+	r.evictEntities(ctx)
+
+	log.L().Info("runtime restored", logf.RID(r.id), logf.Any("offsets", snap.Offsets),
+		logf.Int("chunks", len(snap.Chunks)), logf.Int("entities", restored))
+	return nil
+}
+
+// restoreChunk restore entities owned by the runtime, collect caches, returns entities restored.
+func (r *Runtime) restoreChunk(part *snapshotChunk, caches map[string][]byte) (int, error) {
+	for id, raw := range part.Caches {
+		caches[id] = raw
+	}
+
+	restored := 0
+	for id, raw := range part.Entities {
+		// skip entities which owned by other runtime now.
+		if placement.Global().Select(id).ID != r.id {
+			continue
+		}
+
+		en, err := NewEntity(id, raw)
+		if nil != err {
+			log.L().Error("restore entity", logf.RID(r.id), logf.Eid(id), logf.Error(err))
+			return restored, errors.Wrap(err, "restore entity")
+		}
+
+		restored++
+		r.setEntity(id, en, int64(len(raw)))
+		r.restoreProcessed(id, part.Processed[id])
+	}
+	return restored, nil
+}
+
+// Offsets returns the offset of the last handled message for each partition.
+func (r *Runtime) Offsets() map[int32]int64 {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	offsets := make(map[int32]int64, len(r.offsets))
+	for partition, offset := range r.offsets {
+		offsets[partition] = offset
+	}
+	return offsets
+}
+
+// setOffset advance offset of partition, returns the offset committed,
+// offsets committed by concurrent workers may arrive out of order.
+func (r *Runtime) setOffset(partition int32, offset int64) int64 {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if last, has := r.offsets[partition]; has && last > offset {
+		return last
+	}
+	r.offsets[partition] = offset
+	return offset
+}
@@ -0,0 +1,123 @@
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tkeel-io/core/pkg/placement"
+	"github.com/tkeel-io/core/pkg/repository"
+)
+
+// chunkRepo count snapshot chunks written and dropped.
+type chunkRepo struct {
+	repository.IRepository
+	puts []string
+	dels []string
+}
+
+func (r *chunkRepo) PutSnapshotChunk(ctx context.Context, id, chunkID string, data []byte) error {
+	r.puts = append(r.puts, chunkID)
+	return r.IRepository.PutSnapshotChunk(ctx, id, chunkID, data)
+}
+
+func (r *chunkRepo) DelSnapshotChunk(ctx context.Context, id, chunkID string) error {
+	r.dels = append(r.dels, chunkID)
+	return r.IRepository.DelSnapshotChunk(ctx, id, chunkID)
+}
+
+func TestRuntime_SnapshotRestore(t *testing.T) {
+	placement.Initialize()
+	placement.Global().Append(placement.Info{ID: "core-0", Flag: true})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	repo := newRebalanceRepo(t)
+	rt := NewRuntime(ctx, EntityResource{}, "core-0", &dispatcherMock{}, repo)
+	en, err := NewEntity("iotd-1", []byte(`{"properties":{"temp":20}}`))
+	assert.Nil(t, err)
+	rt.entities["iotd-1"] = en
+	rt.recordProcessed("iotd-1", "ev-1")
+	rt.setOffset(0, 41)
+	rt.setOffset(1, 7)
+	assert.Nil(t, rt.Snapshot(ctx))
+
+	restored := NewRuntime(ctx, EntityResource{}, "core-0", &dispatcherMock{}, repo)
+	assert.Nil(t, restored.Restore(ctx))
+	assert.Equal(t, map[int32]int64{0: 41, 1: 7}, restored.Offsets())
+	assert.Contains(t, restored.entities, "iotd-1")
+	assert.Equal(t, "20", restored.entities["iotd-1"].Get("properties.temp").String())
+	assert.True(t, restored.isProcessed("iotd-1", "ev-1"))
+
+	// events before the recorded offsets are skipped.
+	assert.False(t, restored.track(0, 41))
+	assert.True(t, restored.track(0, 42))
+	assert.True(t, restored.track(2, 0))
+
+	// cold start without snapshot.
+	empty := NewRuntime(ctx, EntityResource{}, "core-1", &dispatcherMock{}, repo)
+	assert.NotNil(t, empty.Restore(ctx))
+	assert.Len(t, empty.entities, 0)
+}
+
+func TestRuntime_SnapshotChunks(t *testing.T) {
+	placement.Initialize()
+	placement.Global().Append(placement.Info{ID: "core-0", Flag: true})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	repo := &chunkRepo{IRepository: newRebalanceRepo(t)}
+	rt := NewRuntime(ctx, EntityResource{}, "core-0", &dispatcherMock{}, repo)
+	for i := 0; i < 32; i++ {
+		id := fmt.Sprintf("iotd-%d", i)
+		en, err := NewEntity(id, []byte(fmt.Sprintf(`{"properties":{"temp":%d}}`, i)))
+		assert.Nil(t, err)
+		rt.entities[id] = en
+	}
+	assert.Nil(t, rt.Snapshot(ctx))
+	assert.Len(t, repo.puts, snapshotMinChunks)
+
+	// only the chunk of entity changed rewritten, the replaced chunk dropped.
+	repo.puts = nil
+	en, err := NewEntity("iotd-1", []byte(`{"properties":{"temp":100}}`))
+	assert.Nil(t, err)
+	rt.entities["iotd-1"] = en
+	assert.Nil(t, rt.Snapshot(ctx))
+	assert.Len(t, repo.puts, 1)
+	assert.Len(t, repo.dels, 1)
+
+	// chunks persisted loaded from manifest by a new runtime.
+	repo.puts, repo.dels = nil, nil
+	restarted := NewRuntime(ctx, EntityResource{}, "core-0", &dispatcherMock{}, repo)
+	for i := 0; i < 32; i++ {
+		id := fmt.Sprintf("iotd-%d", i)
+		restarted.entities[id] = rt.entities[id]
+	}
+	assert.Nil(t, restarted.Snapshot(ctx))
+	assert.Len(t, repo.puts, 0)
+	assert.Len(t, repo.dels, 0)
+
+	restored := NewRuntime(ctx, EntityResource{}, "core-0", &dispatcherMock{}, repo)
+	assert.Nil(t, restored.Restore(ctx))
+	assert.Len(t, restored.entities, 32)
+	assert.Equal(t, "100", restored.entities["iotd-1"].Get("properties.temp").String())
+}
+
+func TestRuntime_RestoreInline(t *testing.T) {
+	placement.Initialize()
+	placement.Global().Append(placement.Info{ID: "core-0", Flag: true})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// snapshots of earlier versions hold entities inline.
+	repo := newRebalanceRepo(t)
+	assert.Nil(t, repo.PutSnapshot(ctx, "core-0", []byte(`{"id":"core-0","offsets":{"0":9},`+
+		`"entities":{"iotd-1":{"properties":{"temp":20}}},"processed":{"iotd-1":["ev-1"]}}`)))
+
+	rt := NewRuntime(ctx, EntityResource{}, "core-0", &dispatcherMock{}, repo)
+	assert.Nil(t, rt.Restore(ctx))
+	assert.Equal(t, map[int32]int64{0: 9}, rt.Offsets())
+	assert.Equal(t, "20", rt.entities["iotd-1"].Get("properties.temp").String())
+	assert.True(t, rt.isProcessed("iotd-1", "ev-1"))
+}
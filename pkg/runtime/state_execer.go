@@ -2,6 +2,9 @@ package runtime
 
 import (
 	"context"
+	"reflect"
+	goruntime "runtime"
+	"strings"
 
 	v1 "github.com/tkeel-io/core/api/core/v1"
 )
@@ -24,6 +27,8 @@ type Feed struct {
 	EntityID string
 	Patches  []Patch
 	Changes  []Patch
+	// Handler is the name of handler which the feed failed in.
+	Handler string
 }
 
 func (feed *Feed) Copy() *Feed {
@@ -35,6 +40,7 @@ func (feed *Feed) Copy() *Feed {
 		EntityID: feed.EntityID,
 		Patches:  feed.Patches,
 		Changes:  feed.Changes,
+		Handler:  feed.Handler,
 	}
 }
 
@@ -51,22 +57,57 @@ type Execer struct {
 
 func (e *Execer) Exec(ctx context.Context, feed *Feed) *Feed {
 	if nil != feed.Err {
+		if feed.Handler == "" {
+			feed.Handler = handlerPrepare
+		}
 		return feed
 	}
 
 	// handle preFuncs.
 	for _, handler := range e.preFuncs {
-		feed = handler.Handle(ctx, feed)
+		feed = e.handle(ctx, handler, feed)
 	}
 
 	// handle execFunc.
-	feed = e.execFunc.Handle(ctx, feed)
+	feed = e.handle(ctx, e.execFunc, feed)
 
 	// handle postFuncs.
 	for _, handler := range e.postFuncs {
-		feed = handler.Handle(ctx, feed)
+		feed = e.handle(ctx, handler, feed)
 	}
 
 	feed.TTL++
 	return feed
 }
+
+// handle run handler, and record the handler which the feed failed in.
+func (e *Execer) handle(ctx context.Context, handler Handler, feed *Feed) *Feed {
+	feed = handler.Handle(ctx, feed)
+	if nil != feed.Err && feed.Handler == "" {
+		feed.Handler = handlerName(handler)
+	}
+	return feed
+}
+
+const (
+	handlerDecode  = "decode"
+	handlerPrepare = "prepare"
+)
+
+// handlerName returns name of handler, e.g. handlePersistent.
+func handlerName(handler Handler) string {
+	if plugin, ok := handler.(*pluginHandler); ok {
+		return plugin.name
+	}
+	if impl, ok := handler.(*handlerImpl); ok {
+		name := goruntime.FuncForPC(reflect.ValueOf(impl.fn).Pointer()).Name()
+		name = strings.TrimSuffix(name, "-fm")
+		return name[strings.LastIndex(name, ".")+1:]
+	}
+
+	typ := reflect.TypeOf(handler)
+	if typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	return typ.Name()
+}
@@ -29,7 +29,7 @@ func (r *Runtime) handleSubscribe(ctx context.Context, feed *Feed) *Feed {
 	log.L().Debug("handle external subscribe", logf.Eid(feed.EntityID), logf.Event(feed.Event))
 
 	entityID := feed.EntityID
-	if subs, ok := r.entitySubscriptions[entityID]; ok {
+	if subs := r.sourceSubscriptions(entityID); len(subs) > 0 {
 		for _, sub := range subs {
 			state := makeSubData(feed, sub)
 			if state == nil {
@@ -51,6 +51,39 @@ func (r *Runtime) handleSubscribe(ctx context.Context, feed *Feed) *Feed {
 	return feed
 }
 
+// sourceSubscriptions returns subscriptions sourced from entity.
+func (r *Runtime) sourceSubscriptions(entityID string) []*repository.Subscription {
+	r.slock.RLock()
+	defer r.slock.RUnlock()
+	subs := make([]*repository.Subscription, 0, len(r.entitySubscriptions[entityID]))
+	for _, sub := range r.entitySubscriptions[entityID] {
+		subs = append(subs, sub)
+	}
+	return subs
+}
+
+// setSubscription mount subscription to the source entity.
+func (r *Runtime) setSubscription(sub *repository.Subscription) {
+	r.slock.Lock()
+	defer r.slock.Unlock()
+	if _, ok := r.entitySubscriptions[sub.SourceEntityID]; !ok {
+		r.entitySubscriptions[sub.SourceEntityID] = make(map[string]*repository.Subscription)
+	}
+	r.entitySubscriptions[sub.SourceEntityID][sub.ID] = sub
+}
+
+// removeSubscription unmount subscription from the source entity.
+func (r *Runtime) removeSubscription(sub *repository.Subscription) {
+	r.slock.Lock()
+	defer r.slock.Unlock()
+	if subs, ok := r.entitySubscriptions[sub.SourceEntityID]; ok {
+		delete(subs, sub.ID)
+		if len(subs) == 0 {
+			delete(r.entitySubscriptions, sub.SourceEntityID)
+		}
+	}
+}
+
 func pathMatch(paths []string, pathCheck string) bool {
 	log.L().Info("pathMatch", logf.Any("paths", paths), logf.String("pathCheck", pathCheck))
 	for _, path := range paths {
@@ -1,9 +1,13 @@
 package runtime
 
 import (
+	"context"
+	"fmt"
 	"strings"
+	"sync"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/tkeel-io/core/pkg/repository"
 	xjson "github.com/tkeel-io/core/pkg/util/json"
 	"github.com/tkeel-io/tdtl"
@@ -39,3 +43,32 @@ func Test_makeSubData(t *testing.T) {
 	}, &sub)
 	t.Log("payload: ", string(bytes))
 }
+
+func TestRuntime_subscriptions(t *testing.T) {
+	rt := NewRuntime(context.Background(), EntityResource{}, "core-0", &dispatcherMock{}, newRebalanceRepo(t))
+	sub := &repository.Subscription{ID: "sub-1", SourceEntityID: "device123"}
+	rt.setSubscription(sub)
+	assert.Equal(t, []*repository.Subscription{sub}, rt.sourceSubscriptions("device123"))
+	rt.removeSubscription(sub)
+	assert.Len(t, rt.sourceSubscriptions("device123"), 0)
+	assert.NotContains(t, rt.entitySubscriptions, "device123")
+
+	// subscriptions changed by watchers while events handled.
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			sub := &repository.Subscription{ID: fmt.Sprintf("sub-%d", i), SourceEntityID: "device123"}
+			rt.setSubscription(sub)
+			rt.removeSubscription(sub)
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			rt.handleSubscribe(context.Background(), &Feed{EntityID: "device123", State: []byte(`{}`)})
+		}
+	}()
+	wg.Wait()
+}
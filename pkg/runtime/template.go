@@ -0,0 +1,146 @@
+package runtime
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	v1 "github.com/tkeel-io/core/api/core/v1"
+	logf "github.com/tkeel-io/core/pkg/logfield"
+	"github.com/tkeel-io/core/pkg/repository"
+	"github.com/tkeel-io/core/pkg/util"
+	xjson "github.com/tkeel-io/core/pkg/util/json"
+	"github.com/tkeel-io/kit/log"
+	"github.com/tkeel-io/tdtl"
+)
+
+// FieldTemplateScheme scheme inherited from template, the base which overrides of entity compared with.
+const FieldTemplateScheme string = "template_scheme"
+
+const bornTemplate = "propagateTemplate"
+
+// inheritScheme merge scheme of template into scheme of entity by property, properties
+// overridden by entity preserved, returns true if scheme of entity changed.
+// a property is overridden if the entity has a config differs from the inherited one,
+// the scheme of entity which never inherited is taken as inherited if legacy, which
+// copied from template entirely.
+func inheritScheme(cc *tdtl.Collect, scheme tdtl.Node, legacy bool) bool {
+	var keys []string
+	seen := make(map[string]bool)
+	collect := func(key []byte, _ *tdtl.Collect) {
+		if !seen[string(key)] {
+			seen[string(key)] = true
+			keys = append(keys, string(key))
+		}
+	}
+
+	template := tdtl.New(scheme.Raw())
+	inherited := cc.Get(FieldTemplateScheme)
+	if legacy && !defined(inherited) {
+		inherited = cc.Get(FieldScheme)
+	}
+	template.Foreach(collect)
+	tdtl.New(inherited.Raw()).Foreach(collect)
+
+	var changed bool
+	current := cc.Get(FieldScheme)
+	for _, key := range keys {
+		cur, old, val := current.Get(key), inherited.Get(key), template.Get(key)
+		if defined(cur) && !(defined(old) && bytes.Equal(cur.Raw(), old.Raw())) {
+			// overridden by entity.
+			continue
+		}
+
+		path := FieldScheme + "." + key
+		switch {
+		case defined(val) && !bytes.Equal(cur.Raw(), val.Raw()):
+			cc.Set(path, val)
+			changed = true
+		case !defined(val) && defined(cur):
+			cc.Del(path)
+			changed = true
+		}
+	}
+
+	cc.Set(FieldTemplateScheme, template)
+	return changed
+}
+
+func defined(node tdtl.Node) bool {
+	return node.Type() != tdtl.Undefined && node.Type() != tdtl.Null
+}
+
+// bindTemplate index entity by template as template relationship, previous template unbound.
+func (r *Runtime) bindTemplate(ctx context.Context, entityID, templateID, owner string) error {
+	rels, err := r.repository.ListRelationship(ctx, r.repository.GetLastRevision(ctx),
+		&repository.ListRelationshipReq{EntityID: entityID, Type: repository.RelationshipTypeTemplate, Reverse: true})
+	if nil != err {
+		return errors.Wrap(err, "list template relationship")
+	}
+
+	var bound bool
+	for _, rel := range rels {
+		if rel.From == templateID {
+			bound = true
+			continue
+		}
+		if err = r.repository.DelRelationship(ctx, rel); nil != err {
+			return errors.Wrap(err, "unbind template")
+		}
+	}
+
+	if bound || templateID == "" {
+		return nil
+	}
+
+	err = r.repository.PutRelationship(ctx, &repository.Relationship{
+		Type:      repository.RelationshipTypeTemplate,
+		From:      templateID,
+		To:        entityID,
+		Owner:     owner,
+		CreatedAt: time.Now().UnixMilli(),
+	})
+	return errors.Wrap(err, "bind template")
+}
+
+// propagateTemplate dispatch scheme of template to entities of the template,
+// templates inheriting the template propagate to their entities in turn.
+func (r *Runtime) propagateTemplate(ctx context.Context, en Entity) error {
+	rels, err := r.repository.ListRelationship(ctx, r.repository.GetLastRevision(ctx),
+		&repository.ListRelationshipReq{EntityID: en.ID(), Type: repository.RelationshipTypeTemplate})
+	if nil != err {
+		return errors.Wrap(err, "list template entities")
+	}
+
+	scheme := en.Scheme()
+	for _, rel := range rels {
+		log.L().Debug("propagate template", logf.Eid(rel.To), logf.Template(en.ID()))
+		if err = r.dispatcher.Dispatch(ctx, makeTemplateEvent(rel.To, en.ID(), bornTemplate, scheme)); nil != err {
+			return errors.Wrap(err, "propagate template")
+		}
+	}
+	return nil
+}
+
+func makeTemplateEvent(entityID, templateID, born string, scheme tdtl.Node) *v1.ProtoEvent {
+	return &v1.ProtoEvent{
+		Id:        util.IG().EvID(),
+		Timestamp: time.Now().UnixNano(),
+		Metadata: map[string]string{
+			v1.MetaType:     string(v1.ETEntity),
+			v1.MetaBorn:     born,
+			v1.MetaEntityID: entityID,
+			v1.MetaSender:   templateID,
+		},
+		Data: &v1.ProtoEvent_Patches{
+			Patches: &v1.PatchDatas{
+				Patches: []*v1.PatchData{{
+					Path:     FieldTemplateScheme,
+					Value:    scheme.Raw(),
+					Operator: xjson.OpReplace.String(),
+				}},
+			},
+		},
+	}
+}
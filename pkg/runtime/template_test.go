@@ -0,0 +1,109 @@
+package runtime
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "github.com/tkeel-io/core/api/core/v1"
+	"github.com/tkeel-io/core/pkg/repository"
+	xjson "github.com/tkeel-io/core/pkg/util/json"
+	"github.com/tkeel-io/tdtl"
+)
+
+func Test_inheritScheme(t *testing.T) {
+	// temp overridden, mode inherited, name of entity.
+	cc := tdtl.New(`{"scheme":{"temp":{"type":"float"},"mode":{"type":"int"},"name":{"type":"string"}},` +
+		`"template_scheme":{"temp":{"type":"int"},"mode":{"type":"int"},"volt":{"type":"int"}}}`)
+
+	changed := inheritScheme(cc, tdtl.New(`{"temp":{"type":"double"},"mode":{"type":"string"},"power":{"type":"int"}}`), false)
+	assert.True(t, changed)
+	assert.Equal(t, `{"type":"float"}`, cc.Get("scheme.temp").String())
+	assert.Equal(t, `{"type":"string"}`, cc.Get("scheme.mode").String())
+	assert.Equal(t, `{"type":"string"}`, cc.Get("scheme.name").String())
+	assert.Equal(t, `{"type":"int"}`, cc.Get("scheme.power").String())
+	assert.Equal(t, `{"temp":{"type":"double"},"mode":{"type":"string"},"power":{"type":"int"}}`, cc.Get(FieldTemplateScheme).String())
+
+	// unchanged template.
+	assert.False(t, inheritScheme(cc, tdtl.New(cc.Get(FieldTemplateScheme).Raw()), false))
+
+	// property removed from template.
+	assert.True(t, inheritScheme(cc, tdtl.New(`{"temp":{"type":"double"},"mode":{"type":"string"}}`), false))
+	assert.Equal(t, "", cc.Get("scheme.power").String())
+
+	// configs of entity never inherited.
+	cc = tdtl.New(`{"scheme":{"temp":{"type":"float"}}}`)
+	assert.False(t, inheritScheme(cc, tdtl.New(`{"temp":{"type":"int"}}`), false))
+	assert.Equal(t, `{"type":"float"}`, cc.Get("scheme.temp").String())
+
+	// legacy entity copied scheme from template.
+	cc = tdtl.New(`{"scheme":{"temp":{"type":"float"}}}`)
+	assert.True(t, inheritScheme(cc, tdtl.New(`{"temp":{"type":"int"}}`), true))
+	assert.Equal(t, `{"type":"int"}`, cc.Get("scheme.temp").String())
+}
+
+func TestEntity_HandleTemplateScheme(t *testing.T) {
+	en, err := NewEntity("device1", []byte(`{"version":1,"scheme":{"temp":{"type":"float"}},"template_scheme":{},"properties":{}}`))
+	assert.Nil(t, err)
+
+	feed := en.Handle(context.Background(), &Feed{
+		Event: &v1.ProtoEvent{Metadata: map[string]string{v1.MetaType: string(v1.ETEntity)}},
+		Patches: []Patch{{
+			Op:    xjson.OpReplace,
+			Path:  FieldTemplateScheme,
+			Value: tdtl.New(`{"temp":{"type":"int"},"mode":{"type":"int"}}`),
+		}},
+	})
+	assert.Nil(t, feed.Err)
+	assert.Equal(t, `{"type":"float"}`, en.Get("scheme.temp").String())
+	assert.Equal(t, `{"type":"int"}`, en.Get("scheme.mode").String())
+	assert.Len(t, feed.Changes, 1)
+	assert.Equal(t, FieldScheme, feed.Changes[0].Path)
+}
+
+func TestRuntime_handleTemplate(t *testing.T) {
+	ctx := context.Background()
+	repo := &relationshipRepo{rels: make(map[string]*repository.Relationship)}
+	dispatcher := &forwardDispatcher{}
+	rt := NewRuntime(ctx, EntityResource{}, "core-0", dispatcher, repo)
+
+	template, err := NewEntity("tpl1", []byte(`{"owner":"admin","scheme":{"temp":{"type":"int"}},"properties":{}}`))
+	assert.Nil(t, err)
+	device, err := NewEntity("device1", []byte(`{"owner":"admin","scheme":{},"properties":{}}`))
+	assert.Nil(t, err)
+	rt.setEntity(template.ID(), template, 0)
+	rt.setEntity(device.ID(), device, 0)
+
+	// template of device changed.
+	ev := &v1.ProtoEvent{Metadata: map[string]string{v1.MetaType: string(v1.ETEntity)}}
+	feed := rt.handleTemplate(ctx, &Feed{
+		Event:    ev,
+		EntityID: device.ID(),
+		Changes:  []Patch{{Op: xjson.OpReplace, Path: FieldTemplate, Value: tdtl.NewString("tpl1")}},
+	})
+	assert.Nil(t, feed.Err)
+	assert.Len(t, repo.rels, 1)
+	assert.Len(t, dispatcher.events, 1)
+	assert.Equal(t, "device1", dispatcher.events[0].Entity())
+
+	// scheme of template changed, propagated to device.
+	feed = rt.handleTemplate(ctx, &Feed{
+		Event:    ev,
+		EntityID: template.ID(),
+		Changes:  []Patch{{Op: xjson.OpReplace, Path: "scheme.temp", Value: tdtl.New(`{"type":"int"}`)}},
+	})
+	assert.Nil(t, feed.Err)
+	assert.Len(t, dispatcher.events, 2)
+	patches := dispatcher.events[1].(v1.PatchEvent).Patches()
+	assert.Equal(t, FieldTemplateScheme, patches[0].Path)
+	assert.Equal(t, `{"temp":{"type":"int"}}`, string(patches[0].Value))
+
+	// template unset.
+	feed = rt.handleTemplate(ctx, &Feed{
+		Event:    ev,
+		EntityID: device.ID(),
+		Changes:  []Patch{{Op: xjson.OpReplace, Path: FieldTemplate, Value: tdtl.NewString("")}},
+	})
+	assert.Nil(t, feed.Err)
+	assert.Len(t, repo.rels, 0)
+}
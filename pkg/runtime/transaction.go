@@ -0,0 +1,228 @@
+package runtime
+
+import (
+	"context"
+	"time"
+
+	v1 "github.com/tkeel-io/core/api/core/v1"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	logf "github.com/tkeel-io/core/pkg/logfield"
+	"github.com/tkeel-io/kit/log"
+)
+
+// transactionTimeout bound the time entity locked by a prepared transaction,
+// transaction aborted if not committed before timeout.
+const transactionTimeout = 60 * time.Second
+
+// transaction is the patches of an entity staged by the prepare phase.
+type transaction struct {
+	ID       string
+	EntityID string
+	Metadata map[string]string
+	Patches  []*v1.PatchData
+	// events held while entity locked, replayed after transaction finished.
+	Pending []*delivery
+	timer   *time.Timer
+}
+
+// handleTransaction handle two-phase transaction events, entity locked by
+// a prepared transaction holds other events until committed or aborted.
+func (r *Runtime) handleTransaction(ctx context.Context, ev v1.Event) bool {
+	switch ev.Type() {
+	case v1.ETEntity, v1.ETSystem:
+	default:
+		return false
+	}
+
+	txID := ev.Attr(v1.MetaTxID)
+	if txID == "" {
+		tx, locked := r.getTransaction(ev.Entity())
+		if locked {
+			log.L().Debug("entity locked, hold event", logf.RID(r.id),
+				logf.Eid(ev.Entity()), logf.ID(ev.ID()), logf.String("tx_id", tx.ID))
+			d := deliveryFrom(ctx, ev)
+			d.held = true
+			tx.Pending = append(tx.Pending, d)
+		}
+		return locked
+	}
+
+	switch v1.TxPhase(ev.Attr(v1.MetaTxPhase)) {
+	case v1.TxPrepare:
+		r.prepareTransaction(ctx, ev)
+	case v1.TxCommit:
+		r.commitTransaction(ctx, ev)
+	case v1.TxAbort:
+		r.abortTransaction(ctx, ev)
+	default:
+		r.handleCallback(ctx, &Feed{Event: ev, EntityID: ev.Entity(), Err: xerrors.ErrInvalidRequest})
+	}
+	return true
+}
+
+// prepareTransaction apply patches on a copy of entity, stage patches and lock entity if succeed.
+func (r *Runtime) prepareTransaction(ctx context.Context, ev v1.Event) {
+	txID, entityID := ev.Attr(v1.MetaTxID), ev.Entity()
+	log.L().Info("prepare transaction", logf.RID(r.id),
+		logf.Eid(entityID), logf.String("tx_id", txID))
+
+	if tx, locked := r.getTransaction(entityID); locked && tx.ID != txID {
+		log.L().Warn("prepare transaction, entity locked", logf.RID(r.id),
+			logf.Eid(entityID), logf.String("tx_id", txID), logf.String("locked_by", tx.ID))
+		r.handleCallback(ctx, &Feed{Event: ev, EntityID: entityID, Err: xerrors.ErrEntityLocked})
+		return
+	}
+
+	e, ok := ev.(v1.PatchEvent)
+	if !ok {
+		r.handleCallback(ctx, &Feed{Event: ev, EntityID: entityID, Err: xerrors.ErrInvalidRequest})
+		return
+	}
+
+	en, err := r.LoadEntity(entityID)
+	if nil != err {
+		log.L().Warn("prepare transaction, load entity", logf.RID(r.id),
+			logf.Eid(entityID), logf.String("tx_id", txID), logf.Reason(err.Error()))
+		r.handleCallback(ctx, &Feed{Event: ev, EntityID: entityID, Err: xerrors.ErrEntityNotFound})
+		return
+	}
+
+	// dry run patches, entity state keep unchanged until committed.
+	feed := en.Copy().Handle(ctx, &Feed{
+		Event:    ev,
+		State:    en.Raw(),
+		EntityID: entityID,
+		Patches:  conv(e.Patches()),
+	})
+
+	if nil == feed.Err {
+		var pending []*delivery
+		if tx, locked := r.getTransaction(entityID); locked {
+			// prepare retried, keep events held.
+			pending = tx.Pending
+			tx.timer.Stop()
+		}
+
+		// copy metadata, callback reuses metadata of event.
+		metadata := make(map[string]string)
+		for key, val := range ev.Attributes() {
+			metadata[key] = val
+		}
+
+		r.setTransaction(&transaction{
+			ID:       txID,
+			EntityID: entityID,
+			Metadata: metadata,
+			Patches:  e.Patches(),
+			Pending:  pending,
+			timer: time.AfterFunc(transactionTimeout, func() {
+				r.Execute(func() { r.expireTransaction(ctx, entityID, txID) })
+			}),
+		})
+	}
+
+	r.handleCallback(ctx, feed)
+}
+
+// commitTransaction apply staged patches, then replay events held.
+func (r *Runtime) commitTransaction(ctx context.Context, ev v1.Event) {
+	txID, entityID := ev.Attr(v1.MetaTxID), ev.Entity()
+	log.L().Info("commit transaction", logf.RID(r.id),
+		logf.Eid(entityID), logf.String("tx_id", txID))
+
+	tx, locked := r.getTransaction(entityID)
+	if !locked || tx.ID != txID {
+		log.L().Warn("commit transaction, transaction not found", logf.RID(r.id),
+			logf.Eid(entityID), logf.String("tx_id", txID))
+		r.handleCallback(ctx, &Feed{Event: ev, EntityID: entityID, Err: xerrors.ErrTransactionNotFound})
+		return
+	}
+
+	r.unlock(tx)
+
+	// handle staged patches as a normal patch event, respond to commit request.
+	metadata := tx.Metadata
+	delete(metadata, v1.MetaTxID)
+	delete(metadata, v1.MetaTxPhase)
+	metadata[v1.MetaRequestID] = ev.Attr(v1.MetaRequestID)
+
+	r.HandleEvent(ctx, &v1.ProtoEvent{
+		Id:        ev.ID(),
+		Timestamp: time.Now().UnixNano(),
+		Callback:  ev.CallbackAddr(),
+		Metadata:  metadata,
+		Data: &v1.ProtoEvent_Patches{
+			Patches: &v1.PatchDatas{Patches: tx.Patches},
+		},
+	})
+
+	r.replay(tx)
+}
+
+// abortTransaction drop staged patches, then replay events held.
+func (r *Runtime) abortTransaction(ctx context.Context, ev v1.Event) {
+	txID, entityID := ev.Attr(v1.MetaTxID), ev.Entity()
+	log.L().Info("abort transaction", logf.RID(r.id),
+		logf.Eid(entityID), logf.String("tx_id", txID))
+
+	if tx, locked := r.getTransaction(entityID); locked && tx.ID == txID {
+		r.unlock(tx)
+		r.replay(tx)
+	}
+
+	en, err := r.LoadEntity(entityID)
+	if nil != err {
+		en = DefaultEntity(entityID)
+	}
+	r.handleCallback(ctx, &Feed{Event: ev, EntityID: entityID, State: en.Raw()})
+}
+
+func (r *Runtime) expireTransaction(ctx context.Context, entityID, txID string) {
+	if tx, locked := r.getTransaction(entityID); locked && tx.ID == txID {
+		log.L().Warn("transaction expired, abort", logf.RID(r.id),
+			logf.Eid(entityID), logf.String("tx_id", txID))
+		r.unlock(tx)
+		r.replay(tx)
+	}
+}
+
+// releaseTransaction abort transaction of entity handed off to other runtime.
+func (r *Runtime) releaseTransaction(ctx context.Context, entityID string) {
+	if tx, locked := r.getTransaction(entityID); locked {
+		log.L().Warn("entity released, abort transaction", logf.RID(r.id),
+			logf.Eid(entityID), logf.String("tx_id", tx.ID))
+		r.unlock(tx)
+		r.replay(tx)
+	}
+}
+
+func (r *Runtime) getTransaction(entityID string) (*transaction, bool) {
+	r.tlock.RLock()
+	defer r.tlock.RUnlock()
+	tx, locked := r.transactions[entityID]
+	return tx, locked
+}
+
+func (r *Runtime) setTransaction(tx *transaction) {
+	r.tlock.Lock()
+	defer r.tlock.Unlock()
+	r.transactions[tx.EntityID] = tx
+}
+
+func (r *Runtime) unlock(tx *transaction) {
+	tx.timer.Stop()
+	r.tlock.Lock()
+	delete(r.transactions, tx.EntityID)
+	r.tlock.Unlock()
+}
+
+// replay events held, commit offsets of messages unless held again.
+func (r *Runtime) replay(tx *transaction) {
+	for _, d := range tx.Pending {
+		d.held = false
+		r.HandleEvent(d.ctx, d.event)
+		if !d.held && nil != d.msg {
+			r.commit(d)
+		}
+	}
+}
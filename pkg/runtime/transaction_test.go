@@ -0,0 +1,107 @@
+package runtime
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "github.com/tkeel-io/core/api/core/v1"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"github.com/tkeel-io/core/pkg/placement"
+	"github.com/tkeel-io/core/pkg/types"
+)
+
+func newTxEvent(txID, reqID string, phase v1.TxPhase, patches ...*v1.PatchData) *v1.ProtoEvent {
+	metadata := map[string]string{
+		v1.MetaType:      string(v1.ETEntity),
+		v1.MetaEntityID:  "iotd-1",
+		v1.MetaRequestID: reqID,
+	}
+	if txID != "" {
+		metadata[v1.MetaTxID] = txID
+		metadata[v1.MetaTxPhase] = string(phase)
+	}
+
+	return &v1.ProtoEvent{
+		Id:       "ev-" + reqID,
+		Callback: "callback",
+		Metadata: metadata,
+		Data: &v1.ProtoEvent_Patches{
+			Patches: &v1.PatchDatas{Patches: patches},
+		},
+	}
+}
+
+func lastResponse(d *forwardDispatcher, reqID string) v1.Event {
+	for index := len(d.events) - 1; index >= 0; index-- {
+		if d.events[index].Attr(v1.MetaRequestID) == reqID {
+			return d.events[index]
+		}
+	}
+	return nil
+}
+
+func TestRuntime_transaction(t *testing.T) {
+	placement.Initialize()
+	placement.Global().Append(placement.Info{ID: "core-0", Flag: true})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	dispatcher := &forwardDispatcher{}
+	rt := NewRuntime(ctx, EntityResource{}, "core-0", dispatcher, newRebalanceRepo(t))
+	en, err := NewEntity("iotd-1", []byte(`{"id":"iotd-1","version":1,"properties":{"temp":20}}`))
+	assert.Nil(t, err)
+	rt.setEntity("iotd-1", en, 0)
+
+	temp := func() string {
+		return rt.entities["iotd-1"].Get("properties.temp").String()
+	}
+
+	// prepare, entity keep unchanged and locked.
+	rt.HandleEvent(ctx, newTxEvent("tx-1", "req-1", v1.TxPrepare,
+		&v1.PatchData{Path: "properties.temp", Operator: "replace", Value: []byte("50")}))
+	resp := lastResponse(dispatcher, "req-1")
+	assert.Equal(t, string(types.StatusOK), resp.Attr(v1.MetaResponseStatus))
+	assert.Equal(t, "20", temp())
+	assert.Contains(t, rt.transactions, "iotd-1")
+
+	// entity locked by other transaction.
+	rt.HandleEvent(ctx, newTxEvent("tx-2", "req-2", v1.TxPrepare,
+		&v1.PatchData{Path: "properties.temp", Operator: "replace", Value: []byte("30")}))
+	resp = lastResponse(dispatcher, "req-2")
+	assert.Equal(t, xerrors.ErrEntityLocked.Error(), resp.Attr(v1.MetaResponseErrCode))
+
+	// events held until transaction committed.
+	rt.HandleEvent(ctx, newTxEvent("", "req-3", "",
+		&v1.PatchData{Path: "properties.temp", Operator: "replace", Value: []byte("60")}))
+	assert.Nil(t, lastResponse(dispatcher, "req-3"))
+	assert.Equal(t, "20", temp())
+
+	rt.HandleEvent(ctx, newTxEvent("tx-1", "req-4", v1.TxCommit))
+	resp = lastResponse(dispatcher, "req-4")
+	assert.Equal(t, string(types.StatusOK), resp.Attr(v1.MetaResponseStatus))
+	assert.NotNil(t, lastResponse(dispatcher, "req-3"))
+	assert.Equal(t, "60", temp())
+	assert.NotContains(t, rt.transactions, "iotd-1")
+
+	// commit unknown transaction.
+	rt.HandleEvent(ctx, newTxEvent("tx-1", "req-5", v1.TxCommit))
+	resp = lastResponse(dispatcher, "req-5")
+	assert.Equal(t, xerrors.ErrTransactionNotFound.Error(), resp.Attr(v1.MetaResponseErrCode))
+
+	// abort drop staged patches.
+	rt.HandleEvent(ctx, newTxEvent("tx-3", "req-6", v1.TxPrepare,
+		&v1.PatchData{Path: "properties.temp", Operator: "replace", Value: []byte("70")}))
+	rt.HandleEvent(ctx, newTxEvent("tx-3", "req-7", v1.TxAbort))
+	resp = lastResponse(dispatcher, "req-7")
+	assert.Equal(t, string(types.StatusOK), resp.Attr(v1.MetaResponseStatus))
+	assert.Equal(t, "60", temp())
+	assert.NotContains(t, rt.transactions, "iotd-1")
+
+	// prepare failed, entity not locked.
+	rt.HandleEvent(ctx, newTxEvent("tx-4", "req-8", v1.TxPrepare,
+		&v1.PatchData{Path: "properties.temp", Operator: "test", Value: []byte("20")}))
+	resp = lastResponse(dispatcher, "req-8")
+	assert.Equal(t, xerrors.ErrPatchTestFailed.Error(), resp.Attr(v1.MetaResponseErrCode))
+	assert.NotContains(t, rt.transactions, "iotd-1")
+}
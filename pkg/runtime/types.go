@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/tkeel-io/core/pkg/mapper"
+	"github.com/tkeel-io/core/pkg/mapper/expression"
 	"github.com/tkeel-io/core/pkg/repository"
 	xjson "github.com/tkeel-io/core/pkg/util/json"
 	"github.com/tkeel-io/core/pkg/util/path"
@@ -16,6 +17,8 @@ type Patch struct {
 	Op    xjson.PatchOp
 	Path  string
 	Value *tdtl.Collect
+	// From is the source path of move and copy operations.
+	From string
 }
 
 type EntityAttr interface {
@@ -67,6 +70,10 @@ type ExpressionInfo struct {
 	version       int
 	subEndpoints  []SubEndpoint
 	evalEndpoints []EvalEndpoint
+	// windowed expression calls aggregations over windows.
+	windowed bool
+	// fan-ins called by the expression, members may be in any runtime.
+	fanIns []expression.FanIn
 }
 
 type SubEndpoint struct {
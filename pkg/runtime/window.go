@@ -0,0 +1,120 @@
+package runtime
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	v1 "github.com/tkeel-io/core/api/core/v1"
+	logf "github.com/tkeel-io/core/pkg/logfield"
+	"github.com/tkeel-io/core/pkg/mapper/expression"
+	"github.com/tkeel-io/core/pkg/repository"
+	xjson "github.com/tkeel-io/core/pkg/util/json"
+	"github.com/tkeel-io/kit/log"
+	"github.com/tkeel-io/tdtl"
+)
+
+// exprWindows are windows of aggregations called by the expression,
+// persisted into the target entity so that windows survive restarts.
+type exprWindows struct {
+	Expression string             `json:"expression"`
+	Windows    expression.Windows `json:"windows"`
+}
+
+// windowEvaler evaluates expression aggregating over windows kept by the runtime.
+type windowEvaler struct {
+	runtime *Runtime
+	expr    repository.Expression
+	funcs   map[string]tdtl.ContextFunc
+}
+
+func (e *windowEvaler) Eval(ctx context.Context, in map[string]tdtl.Node) (tdtl.Node, error) {
+	r := e.runtime
+	r.wlock.Lock()
+	defer r.wlock.Unlock()
+
+	exprIns, err := expression.NewWindowExpr(e.expr.Expression, e.funcs, r.loadWindows(e.expr))
+	if nil != err {
+		return tdtl.UNDEFINED_RESULT, errors.Wrap(err, "parse expression")
+	}
+
+	out, err := exprIns.Eval(ctx, in)
+	if nil != err {
+		return out, errors.Wrap(err, "eval expression")
+	}
+
+	if nil == r.windows {
+		r.windows = make(map[string]*exprWindows)
+	}
+	r.windows[e.expr.ID] = &exprWindows{Expression: e.expr.Expression, Windows: exprIns.Windows()}
+	return out, nil
+}
+
+func (e *windowEvaler) Sources() map[string][]string {
+	return nil
+}
+
+// windowPath returns path of expression windows in the target entity.
+func windowPath(expr repository.Expression) string {
+	return FieldWindows + "." + strings.ReplaceAll(expr.Path, ".", "/")
+}
+
+// loadWindows returns windows of the expression, restored from the target entity if not kept,
+// windows of the expression changed dropped.
+func (r *Runtime) loadWindows(expr repository.Expression) expression.Windows {
+	if state, has := r.windows[expr.ID]; has && state.Expression == expr.Expression {
+		return state.Windows
+	}
+
+	en, err := r.LoadEntity(expr.EntityID)
+	if nil != err {
+		log.L().Warn("restore expression windows", logf.ID(expr.ID),
+			logf.Eid(expr.EntityID), logf.Reason(err.Error()))
+		return nil
+	}
+
+	var state exprWindows
+	if raw := en.Get(windowPath(expr)).Raw(); len(raw) > 0 {
+		if err = json.Unmarshal(raw, &state); nil != err {
+			log.L().Warn("restore expression windows", logf.ID(expr.ID),
+				logf.Eid(expr.EntityID), logf.Reason(err.Error()))
+			return nil
+		}
+	}
+
+	if state.Expression != expr.Expression {
+		return nil
+	}
+	return state.Windows
+}
+
+// windowPatch returns patch persisting windows of the expression, nil if no windows kept.
+func (r *Runtime) windowPatch(expr repository.Expression) *v1.PatchData {
+	r.wlock.Lock()
+	state, has := r.windows[expr.ID]
+	if !has {
+		r.wlock.Unlock()
+		return nil
+	}
+	bytes, err := json.Marshal(state)
+	r.wlock.Unlock()
+
+	if nil != err {
+		log.L().Warn("persist expression windows", logf.ID(expr.ID),
+			logf.Eid(expr.EntityID), logf.Reason(err.Error()))
+		return nil
+	}
+
+	return &v1.PatchData{
+		Operator: xjson.OpReplace.String(),
+		Path:     windowPath(expr),
+		Value:    bytes,
+	}
+}
+
+// removeWindows drop windows kept of the expression.
+func (r *Runtime) removeWindows(exprID string) {
+	r.wlock.Lock()
+	delete(r.windows, exprID)
+	r.wlock.Unlock()
+}
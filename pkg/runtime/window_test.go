@@ -0,0 +1,70 @@
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/tkeel-io/core/pkg/placement"
+	"github.com/tkeel-io/core/pkg/repository"
+	"github.com/tkeel-io/core/pkg/util/path"
+)
+
+func TestRuntime_windowExpression(t *testing.T) {
+	placement.Initialize()
+	placement.Global().Append(placement.Info{ID: "core/1234", Flag: true})
+
+	// windows persisted of the expression, a sample of 20 a minute ago.
+	text := `avg(device1.properties.a, '5m')`
+	device1, err := NewEntity("device1", []byte(`{"id": "device1", "properties": {"a": 10}}`))
+	assert.Nil(t, err)
+	device2, err := NewEntity("device2", []byte(fmt.Sprintf(
+		`{"id": "device2", "properties": {}, "windows": {"properties/b": {"expression": "%s", "windows": [{"samples": [{"ts": %d, "v": 20}], "count": 1, "sum": 20, "min": 20, "max": 20}]}}}`,
+		text, time.Now().Add(-time.Minute).UnixMilli())))
+	assert.Nil(t, err)
+
+	rt := &Runtime{
+		enCache:     NewCacheMock(map[string]Entity{}),
+		entities:    map[string]Entity{"device1": device1, "device2": device2},
+		entityIndex: newLRU(),
+		expressions: map[string]ExpressionInfo{},
+		subTree:     path.NewRefTree(),
+		evalTree:    path.New(),
+	}
+
+	appendExpr := func(text string) repository.Expression {
+		expr := repository.NewExpression("admin", "device2", "", "properties.b", text, "")
+		exprInfos, err := parseExpression(*expr, 1)
+		assert.Nil(t, err)
+		for _, exprInfo := range exprInfos {
+			assert.True(t, exprInfo.windowed)
+			rt.AppendExpression(*exprInfo)
+		}
+		return *expr
+	}
+
+	// windows restored from the entity.
+	expr := appendExpr(text)
+	ret, err := rt.evalExpression(context.Background(), expr)
+	assert.Nil(t, err)
+	assert.Equal(t, "15.000000", ret.String())
+
+	patch := rt.windowPatch(expr)
+	assert.NotNil(t, patch)
+	assert.Equal(t, "windows.properties/b", patch.Path)
+	var state exprWindows
+	assert.Nil(t, json.Unmarshal(patch.Value, &state))
+	assert.Equal(t, text, state.Expression)
+	assert.Equal(t, int64(2), state.Windows[0].Count)
+
+	// windows of the expression changed dropped.
+	expr = appendExpr(`avg(device1.properties.a, 10)`)
+	ret, err = rt.evalExpression(context.Background(), expr)
+	assert.Nil(t, err)
+	assert.Equal(t, "10.000000", ret.String())
+
+	rt.RemoveExpression(expr.ID)
+	assert.Nil(t, rt.windowPatch(expr))
+}
@@ -0,0 +1,160 @@
+package scheduler
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// maxCronYears bound the search of next time, schedules like "0 0 30 2 *" never fire.
+const maxCronYears = 5
+
+var cronDescriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+type cronField struct {
+	min, max int
+}
+
+var cronFields = []cronField{
+	{0, 59}, // minute.
+	{0, 23}, // hour.
+	{1, 31}, // day of month.
+	{1, 12}, // month.
+	{0, 7},  // day of week, 0 and 7 are sunday.
+}
+
+// Cron is a parsed cron expression: minute hour day-of-month month day-of-week.
+type Cron struct {
+	minute, hour, dom, month, dow uint64
+	// day of month or day of week is "*".
+	anyDay bool
+}
+
+// ParseCron parse standard five fields cron expression, fields support
+// "*", lists "1,3", ranges "1-5" and steps "*/15", "0-30/10".
+func ParseCron(spec string) (*Cron, error) {
+	spec = strings.TrimSpace(spec)
+	if descriptor, ok := cronDescriptors[spec]; ok {
+		spec = descriptor
+	}
+
+	fields := strings.Fields(spec)
+	if len(fields) != len(cronFields) {
+		return nil, errors.Errorf("cron %q, expected %d fields", spec, len(cronFields))
+	}
+
+	bits := make([]uint64, len(fields))
+	for index, field := range fields {
+		var err error
+		if bits[index], err = parseCronField(field, cronFields[index]); nil != err {
+			return nil, errors.Wrapf(err, "cron %q", spec)
+		}
+	}
+
+	// sunday is either 0 or 7.
+	if bits[4]&(1<<7) != 0 {
+		bits[4] |= 1
+	}
+
+	return &Cron{
+		minute: bits[0],
+		hour:   bits[1],
+		dom:    bits[2],
+		month:  bits[3],
+		dow:    bits[4],
+		anyDay: fields[2] == "*" || fields[4] == "*",
+	}, nil
+}
+
+func parseCronField(field string, bound cronField) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(field, ",") {
+		step := 1
+		if index := strings.Index(part, "/"); index >= 0 {
+			var err error
+			if step, err = strconv.Atoi(part[index+1:]); nil != err || step <= 0 {
+				return 0, errors.Errorf("invalid step %q", part)
+			}
+			part = part[:index]
+		}
+
+		low, high := bound.min, bound.max
+		switch {
+		case part == "*":
+		case strings.Contains(part, "-"):
+			values := strings.SplitN(part, "-", 2)
+			var err0, err1 error
+			low, err0 = strconv.Atoi(values[0])
+			high, err1 = strconv.Atoi(values[1])
+			if nil != err0 || nil != err1 {
+				return 0, errors.Errorf("invalid range %q", part)
+			}
+		default:
+			value, err := strconv.Atoi(part)
+			if nil != err {
+				return 0, errors.Errorf("invalid value %q", part)
+			}
+			low, high = value, value
+			if step > 1 {
+				// "5/10" means "5-max/10".
+				high = bound.max
+			}
+		}
+
+		if low < bound.min || high > bound.max || low > high {
+			return 0, errors.Errorf("%q out of range [%d, %d]", part, bound.min, bound.max)
+		}
+
+		for value := low; value <= high; value += step {
+			bits |= 1 << uint(value)
+		}
+	}
+	return bits, nil
+}
+
+// Next returns the first time after t matched, zero time if none.
+func (c *Cron) Next(t time.Time) time.Time {
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	yearLimit := t.Year() + maxCronYears
+
+	for t.Year() <= yearLimit {
+		if c.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if !c.matchDay(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if c.hour&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+			continue
+		}
+		if c.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+// matchDay matches day of month and day of week, either matched if both restricted.
+func (c *Cron) matchDay(t time.Time) bool {
+	dom := c.dom&(1<<uint(t.Day())) != 0
+	dow := c.dow&(1<<uint(t.Weekday())) != 0
+	if c.anyDay {
+		return dom && dow
+	}
+	return dom || dow
+}
@@ -0,0 +1,62 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCron(t *testing.T) {
+	tests := []struct {
+		spec string
+		ok   bool
+	}{
+		{"* * * * *", true},
+		{"0 22 * * *", true},
+		{"*/15 8-18 * * 1-5", true},
+		{"0,30 * 1,15 * *", true},
+		{"0 0 * * 7", true},
+		{"@daily", true},
+		{"0 22 * *", false},
+		{"60 * * * *", false},
+		{"* * 0 * *", false},
+		{"5-1 * * * *", false},
+		{"*/0 * * * *", false},
+		{"a * * * *", false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.spec, func(t *testing.T) {
+			_, err := ParseCron(test.spec)
+			assert.Equal(t, test.ok, nil == err)
+		})
+	}
+}
+
+func TestCron_Next(t *testing.T) {
+	base := time.Date(2022, 4, 15, 21, 30, 20, 0, time.UTC) // friday.
+	tests := []struct {
+		spec string
+		next time.Time
+	}{
+		{"* * * * *", time.Date(2022, 4, 15, 21, 31, 0, 0, time.UTC)},
+		{"0 22 * * *", time.Date(2022, 4, 15, 22, 0, 0, 0, time.UTC)},
+		{"0 21 * * *", time.Date(2022, 4, 16, 21, 0, 0, 0, time.UTC)},
+		{"*/20 * * * *", time.Date(2022, 4, 15, 21, 40, 0, 0, time.UTC)},
+		{"0 8 * * 1-5", time.Date(2022, 4, 18, 8, 0, 0, 0, time.UTC)},
+		{"0 0 1 * *", time.Date(2022, 5, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 0 29 2 *", time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)},
+		// either day of month or day of week matched.
+		{"0 0 20 * 0", time.Date(2022, 4, 17, 0, 0, 0, 0, time.UTC)},
+		{"0 0 30 2 *", time.Time{}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.spec, func(t *testing.T) {
+			cron, err := ParseCron(test.spec)
+			assert.Nil(t, err)
+			assert.Equal(t, test.next, cron.Next(base))
+		})
+	}
+}
@@ -0,0 +1,208 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	v1 "github.com/tkeel-io/core/api/core/v1"
+	"github.com/tkeel-io/core/pkg/dispatch"
+	logf "github.com/tkeel-io/core/pkg/logfield"
+	"github.com/tkeel-io/core/pkg/placement"
+	"github.com/tkeel-io/core/pkg/repository"
+	"github.com/tkeel-io/core/pkg/repository/dao"
+	"github.com/tkeel-io/kit/log"
+)
+
+const (
+	bornScheduler   = "scheduler"
+	defaultInterval = time.Second
+)
+
+// Scheduler dispatch patches of schedules as entity events at the due time,
+// schedules fired by the node which the entity placed on.
+type Scheduler struct {
+	interval   time.Duration
+	repo       repository.IRepository
+	dispatcher dispatch.Dispatcher
+	schedules  map[string]*repository.Schedule
+
+	lock   sync.Mutex
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+func New(ctx context.Context, repo repository.IRepository, dispatcher dispatch.Dispatcher) *Scheduler {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Scheduler{
+		ctx:        ctx,
+		cancel:     cancel,
+		repo:       repo,
+		dispatcher: dispatcher,
+		interval:   defaultInterval,
+		schedules:  make(map[string]*repository.Schedule),
+	}
+}
+
+// Start load schedules and watch changes of schedules, fire due schedules every interval.
+func (s *Scheduler) Start() error {
+	revision := s.repo.GetLastRevision(s.ctx)
+	s.repo.RangeSchedule(s.ctx, revision, func(schedules []*repository.Schedule) {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+		for _, schedule := range schedules {
+			s.schedules[schedule.ID] = schedule
+		}
+	})
+
+	log.L().Info("scheduler started", logf.Value(len(s.schedules)))
+	go s.repo.WatchSchedule(s.ctx, revision, s.onChanged)
+	go s.run()
+	return nil
+}
+
+func (s *Scheduler) Stop() {
+	s.cancel()
+}
+
+func (s *Scheduler) onChanged(et dao.EnventType, schedule *repository.Schedule) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	switch et {
+	case dao.PUT:
+		s.schedules[schedule.ID] = schedule
+	case dao.DELETE:
+		delete(s.schedules, schedule.ID)
+	}
+}
+
+func (s *Scheduler) run() {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case now := <-ticker.C:
+			s.tick(now)
+		}
+	}
+}
+
+// tick fire schedules due at now.
+func (s *Scheduler) tick(now time.Time) {
+	for _, schedule := range s.due(now) {
+		if err := s.fire(s.ctx, schedule, now); nil != err {
+			// retried next tick.
+			log.L().Error("fire schedule", logf.ID(schedule.ID),
+				logf.Eid(schedule.EntityID), logf.Error(err))
+		}
+	}
+}
+
+// due returns schedules due at now, of entities placed on this node.
+func (s *Scheduler) due(now time.Time) []*repository.Schedule {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	var schedules []*repository.Schedule
+	for _, schedule := range s.schedules {
+		if schedule.NextTime <= now.UnixMilli() &&
+			placement.Global().Select(schedule.EntityID).Flag {
+			schedules = append(schedules, schedule)
+		}
+	}
+	return schedules
+}
+
+// fire dispatch event of schedule, then remove the schedule or advance it to the next time.
+func (s *Scheduler) fire(ctx context.Context, schedule *repository.Schedule, now time.Time) error {
+	log.L().Info("fire schedule", logf.ID(schedule.ID), logf.Eid(schedule.EntityID))
+
+	// event id identifies the occurrence, redelivered occurrence skipped by runtime.
+	if err := s.dispatcher.Dispatch(ctx, makeEvent(schedule, now)); nil != err {
+		return errors.Wrap(err, "dispatch schedule")
+	}
+
+	if schedule.Cron != "" {
+		next, err := NextTime(schedule.Cron, schedule.Timezone, now)
+		if nil == err {
+			advanced := *schedule
+			advanced.NextTime = next
+			s.lock.Lock()
+			s.schedules[schedule.ID] = &advanced
+			s.lock.Unlock()
+			return errors.Wrap(s.repo.PutSchedule(ctx, &advanced), "advance schedule")
+		}
+
+		log.L().Warn("schedule never fired again", logf.ID(schedule.ID), logf.Reason(err.Error()))
+	}
+
+	s.remove(schedule.ID)
+	return errors.Wrap(s.repo.DelSchedule(ctx, schedule), "remove schedule")
+}
+
+// makeEvent returns system delete event of delete action, otherwise entity event of patches.
+func makeEvent(schedule *repository.Schedule, now time.Time) *v1.ProtoEvent {
+	ev := &v1.ProtoEvent{
+		Id:        fmt.Sprintf("%s-%d", schedule.ID, schedule.NextTime),
+		Timestamp: now.UnixNano(),
+		Metadata: map[string]string{
+			v1.MetaBorn:     bornScheduler,
+			v1.MetaEntityID: schedule.EntityID,
+			v1.MetaOwner:    schedule.Owner,
+			v1.MetaSource:   schedule.Source,
+		},
+	}
+
+	if schedule.Action == repository.ScheduleActionDelete {
+		ev.SetType(v1.ETSystem)
+		ev.Data = &v1.ProtoEvent_SystemData{
+			SystemData: &v1.SystemData{Operator: string(v1.OpDelete)},
+		}
+		return ev
+	}
+
+	patches := make([]*v1.PatchData, 0, len(schedule.Patches))
+	for _, patch := range schedule.Patches {
+		patches = append(patches, &v1.PatchData{
+			Path:     patch.Path,
+			Operator: patch.Operator,
+			Value:    patch.Value,
+			From:     patch.From,
+		})
+	}
+
+	ev.SetType(v1.ETEntity)
+	ev.Data = &v1.ProtoEvent_Patches{
+		Patches: &v1.PatchDatas{Patches: patches},
+	}
+	return ev
+}
+
+func (s *Scheduler) remove(id string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	delete(s.schedules, id)
+}
+
+// NextTime returns the next time after t of cron evaluated in time zone, unix milliseconds.
+func NextTime(spec, timezone string, t time.Time) (int64, error) {
+	cron, err := ParseCron(spec)
+	if nil != err {
+		return 0, errors.Wrap(err, "parse cron")
+	}
+
+	loc, err := time.LoadLocation(timezone)
+	if nil != err {
+		return 0, errors.Wrap(err, "load time zone")
+	}
+
+	next := cron.Next(t.In(loc))
+	if next.IsZero() {
+		return 0, errors.Errorf("cron %q never fired", spec)
+	}
+	return next.UnixMilli(), nil
+}
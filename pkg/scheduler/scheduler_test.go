@@ -0,0 +1,126 @@
+package scheduler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "github.com/tkeel-io/core/api/core/v1"
+	"github.com/tkeel-io/core/pkg/placement"
+	"github.com/tkeel-io/core/pkg/repository"
+	"github.com/tkeel-io/core/pkg/repository/dao"
+)
+
+type scheduleRepo struct {
+	repository.IRepository
+	schedules map[string]*repository.Schedule
+}
+
+func (r *scheduleRepo) PutSchedule(ctx context.Context, schedule *repository.Schedule) error {
+	r.schedules[schedule.ID] = schedule
+	return nil
+}
+
+func (r *scheduleRepo) DelSchedule(ctx context.Context, schedule *repository.Schedule) error {
+	delete(r.schedules, schedule.ID)
+	return nil
+}
+
+type dispatcherMock struct {
+	events []v1.Event
+}
+
+func (d *dispatcherMock) DispatchToLog(ctx context.Context, bytes []byte) error {
+	return nil
+}
+
+func (d *dispatcherMock) Dispatch(ctx context.Context, ev v1.Event) error {
+	d.events = append(d.events, ev)
+	return nil
+}
+
+func newScheduler(schedules ...*repository.Schedule) (*Scheduler, *scheduleRepo, *dispatcherMock) {
+	placement.Initialize()
+	placement.Global().Append(placement.Info{ID: "core-0", Flag: true})
+
+	repo := &scheduleRepo{schedules: make(map[string]*repository.Schedule)}
+	dispatcher := &dispatcherMock{}
+	s := New(context.Background(), repo, dispatcher)
+	for _, schedule := range schedules {
+		repo.schedules[schedule.ID] = schedule
+		s.onChanged(dao.PUT, schedule)
+	}
+	return s, repo, dispatcher
+}
+
+func TestScheduler_tick(t *testing.T) {
+	now := time.Date(2022, 4, 15, 22, 0, 0, 0, time.UTC)
+	patches := []*repository.SchedulePatch{{Path: "properties.mode", Operator: "replace", Value: []byte(`"eco"`)}}
+	s, repo, dispatcher := newScheduler(
+		&repository.Schedule{ID: "sch-1", EntityID: "device123", Owner: "admin", NextTime: now.UnixMilli(), Patches: patches},
+		&repository.Schedule{ID: "sch-2", EntityID: "device123", NextTime: now.Add(time.Minute).UnixMilli(), Patches: patches},
+		&repository.Schedule{ID: "sch-3", EntityID: "device234", Cron: "0 22 * * *", NextTime: now.UnixMilli(), Patches: patches},
+	)
+
+	s.tick(now)
+	assert.Len(t, dispatcher.events, 2)
+	for _, ev := range dispatcher.events {
+		assert.Equal(t, v1.ETEntity, ev.Type())
+		assert.Equal(t, bornScheduler, ev.Attr(v1.MetaBorn))
+		assert.Equal(t, "properties.mode", ev.(*v1.ProtoEvent).Patches()[0].Path)
+	}
+
+	// one-shot schedule removed, recurring schedule advanced.
+	assert.NotContains(t, repo.schedules, "sch-1")
+	assert.Contains(t, repo.schedules, "sch-2")
+	assert.Equal(t, now.Add(24*time.Hour).UnixMilli(), repo.schedules["sch-3"].NextTime)
+	assert.Equal(t, now.Add(24*time.Hour).UnixMilli(), s.schedules["sch-3"].NextTime)
+
+	// nothing due.
+	s.tick(now.Add(time.Second))
+	assert.Len(t, dispatcher.events, 2)
+
+	s.onChanged(dao.DELETE, &repository.Schedule{ID: "sch-2"})
+	s.tick(now.Add(time.Hour))
+	assert.Len(t, dispatcher.events, 2)
+}
+
+func TestScheduler_tickPlacement(t *testing.T) {
+	now := time.Now()
+	s, repo, dispatcher := newScheduler(
+		&repository.Schedule{ID: "sch-1", EntityID: "device123", NextTime: now.UnixMilli()})
+
+	// entity placed on other node.
+	placement.Initialize()
+	placement.Global().Append(placement.Info{ID: "core-1"})
+	s.tick(now)
+	assert.Len(t, dispatcher.events, 0)
+	assert.Contains(t, repo.schedules, "sch-1")
+}
+
+func TestNextTime(t *testing.T) {
+	now := time.Date(2022, 4, 15, 12, 0, 0, 0, time.UTC)
+	next, err := NextTime("0 22 * * *", "Asia/Shanghai", now)
+	assert.Nil(t, err)
+	assert.Equal(t, time.Date(2022, 4, 15, 14, 0, 0, 0, time.UTC).UnixMilli(), next)
+
+	_, err = NextTime("0 22 * * *", "Invalid/Zone", now)
+	assert.NotNil(t, err)
+	_, err = NextTime("0 0 30 2 *", "", now)
+	assert.NotNil(t, err)
+}
+
+func Test_makeEvent(t *testing.T) {
+	now := time.Now()
+	ev := makeEvent(&repository.Schedule{ID: "ttl-device123", EntityID: "device123",
+		Action: repository.ScheduleActionDelete, NextTime: 1650000000000}, now)
+	assert.Equal(t, v1.ETSystem, ev.Type())
+	assert.Equal(t, string(v1.OpDelete), ev.GetSystemData().Operator)
+	assert.Equal(t, "ttl-device123-1650000000000", ev.ID())
+
+	ev = makeEvent(&repository.Schedule{ID: "sch-1", EntityID: "device123", Patches: []*repository.SchedulePatch{
+		{Path: "properties.alarm", Operator: "remove"}}}, now)
+	assert.Equal(t, v1.ETEntity, ev.Type())
+	assert.Equal(t, "properties.alarm", ev.Patches()[0].Path)
+}
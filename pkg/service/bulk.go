@@ -0,0 +1,228 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/pkg/errors"
+	pb "github.com/tkeel-io/core/api/core/v1"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	logf "github.com/tkeel-io/core/pkg/logfield"
+	apim "github.com/tkeel-io/core/pkg/manager"
+	"github.com/tkeel-io/kit/log"
+)
+
+const (
+	BulkStatusCreated = "created"
+	BulkStatusFailed  = "failed"
+
+	// maxBulkEntities bounds entities created by a request.
+	maxBulkEntities = 10000
+)
+
+// idPattern matches index placeholder of id pattern, `{i}` or zero padded `{i:4}`.
+var idPattern = regexp.MustCompile(`\{i(?::(\d+))?\}`)
+
+// cloneExcludedProps properties of entity which are not copied when cloning.
+var cloneExcludedProps = []string{"rawData", "telemetry"}
+
+// BulkCreateEntities create entities from template, instances generated by id pattern.
+func (s *EntityService) BulkCreateEntities(ctx context.Context, req *pb.BulkCreateEntitiesRequest) (*pb.BulkCreateEntitiesResponse, error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready", logf.Template(req.TemplateId))
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	base := &Entity{
+		Type:       req.Type,
+		Owner:      req.Owner,
+		Source:     req.Source,
+		TemplateID: req.TemplateId,
+	}
+	parseHeaderFrom(ctx, base)
+	ens, err := makeBulkEntities(base, nil, req.Properties.AsMap(),
+		req.IdPattern, req.Start, req.Count, req.Entities)
+	if nil != err {
+		log.L().Error("bulk create entities", logf.Template(req.TemplateId), logf.Error(err))
+		return nil, errors.Wrap(err, "bulk create entities")
+	}
+
+	return s.createEntities(ctx, ens)
+}
+
+// CloneEntity create entities copied from the entity, properties and configs of the entity
+// are copied, configs are inherited from template of the entity if the entity has template.
+func (s *EntityService) CloneEntity(ctx context.Context, req *pb.CloneEntityRequest) (*pb.BulkCreateEntitiesResponse, error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready", logf.Eid(req.Id))
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	source := &Entity{ID: req.Id, Type: req.Type, Owner: req.Owner, Source: req.Source}
+	parseHeaderFrom(ctx, source)
+	ret, err := s.apiManager.GetEntity(ctx, source)
+	if nil != err {
+		log.L().Error("clone entity, get entity", logf.Eid(req.Id), logf.Error(err))
+		return nil, errors.Wrap(err, "clone entity")
+	}
+
+	base := &Entity{
+		Type:       ret.Type,
+		Owner:      source.Owner,
+		Source:     source.Source,
+		TemplateID: ret.TemplateID,
+	}
+	if base.Owner == "" {
+		base.Owner = ret.Owner
+	}
+	if base.TemplateID == "" && len(ret.Scheme) > 0 {
+		if base.Scheme, err = json.Marshal(ret.Scheme); nil != err {
+			log.L().Error("clone entity, encode configs", logf.Eid(req.Id), logf.Error(err))
+			return nil, errors.Wrap(err, "clone entity")
+		}
+	}
+
+	props := make(map[string]interface{}, len(ret.Properties))
+	for key, val := range ret.Properties {
+		props[key] = val
+	}
+	for _, key := range cloneExcludedProps {
+		delete(props, key)
+	}
+
+	ens, err := makeBulkEntities(base, props, req.Properties.AsMap(),
+		req.IdPattern, req.Start, req.Count, req.Entities)
+	if nil != err {
+		log.L().Error("clone entity", logf.Eid(req.Id), logf.Error(err))
+		return nil, errors.Wrap(err, "clone entity")
+	}
+
+	return s.createEntities(ctx, ens)
+}
+
+func (s *EntityService) createEntities(ctx context.Context, ens []*Entity) (*pb.BulkCreateEntitiesResponse, error) {
+	ttl, err := parseTTLFrom(ctx)
+	if nil != err {
+		log.L().Error("create entities", logf.Error(err))
+		return nil, err
+	}
+
+	reverts := make([][]apim.Revert, len(ens))
+	for index, en := range ens {
+		if reverts[index], err = s.expire(ctx, en, ttl, nil); nil != err {
+			for i := 0; i < index; i++ {
+				s.revertExpire(ctx, ens[i], reverts[i])
+			}
+			return nil, errors.Wrap(err, "create entities")
+		}
+	}
+
+	results, err := s.apiManager.CreateEntities(ctx, ens)
+	if nil != err {
+		log.L().Error("create entities", logf.Error(err))
+		for index, en := range ens {
+			s.revertExpire(ctx, en, reverts[index])
+		}
+		return nil, errors.Wrap(err, "create entities")
+	}
+
+	out := &pb.BulkCreateEntitiesResponse{
+		Total:    int32(len(results)),
+		Entities: make([]*pb.BulkCreateEntitiesResult, 0, len(results)),
+	}
+	for index, ret := range results {
+		item := &pb.BulkCreateEntitiesResult{Id: ret.ID, Status: BulkStatusCreated}
+		if nil != ret.Err {
+			out.Failed++
+			item.Status = BulkStatusFailed
+			item.Error = ret.Err.Error()
+			s.revertExpire(ctx, ens[index], reverts[index])
+		} else {
+			out.Succeeded++
+			if nil != ret.Base {
+				item.Version = ret.Base.Version
+			}
+		}
+		out.Entities = append(out.Entities, item)
+	}
+
+	return out, nil
+}
+
+// makeBulkEntities make entities of instances, properties of an instance are merged from
+// properties copied, properties shared and properties of the instance in order.
+func makeBulkEntities(base *Entity, copied, shared map[string]interface{}, pattern string,
+	start, count int32, items []*pb.BulkEntityItem) ([]*Entity, error) {
+	instances, err := expandInstances(pattern, start, count, items)
+	if nil != err {
+		return nil, err
+	}
+
+	ens := make([]*Entity, 0, len(instances))
+	for _, instance := range instances {
+		props := make(map[string]interface{})
+		for _, layer := range []map[string]interface{}{copied, shared, instance.Properties.AsMap()} {
+			for key, val := range layer {
+				props[key] = val
+			}
+		}
+
+		en := &Entity{
+			ID:         instance.Id,
+			Type:       base.Type,
+			Owner:      base.Owner,
+			Source:     base.Source,
+			TemplateID: base.TemplateID,
+			Scheme:     base.Scheme,
+		}
+		if en.Properties, err = json.Marshal(props); nil != err {
+			return nil, errors.Wrapf(xerrors.ErrInvalidEntityParams, "entity %s, %s", instance.Id, err.Error())
+		}
+		ens = append(ens, en)
+	}
+	return ens, nil
+}
+
+// expandInstances generate instances by id pattern, instances listed override generated
+// instances with same id, or appended.
+func expandInstances(pattern string, start, count int32, items []*pb.BulkEntityItem) ([]*pb.BulkEntityItem, error) {
+	switch {
+	case count < 0 || start < 0:
+		return nil, errors.Wrapf(xerrors.ErrInvalidRequest, "start %d, count %d", start, count)
+	case count > 0 && !idPattern.MatchString(pattern):
+		return nil, errors.Wrapf(xerrors.ErrInvalidRequest, "id pattern %s without index", pattern)
+	case int(count)+len(items) == 0:
+		return nil, errors.Wrap(xerrors.ErrInvalidRequest, "empty entities")
+	case int(count)+len(items) > maxBulkEntities:
+		return nil, errors.Wrapf(xerrors.ErrInvalidRequest, "entities exceed %d", maxBulkEntities)
+	}
+
+	indexes := make(map[string]int, int(count)+len(items))
+	instances := make([]*pb.BulkEntityItem, 0, int(count)+len(items))
+	for offset := int64(0); offset < int64(count); offset++ {
+		index := int64(start) + offset
+		id := idPattern.ReplaceAllStringFunc(pattern, func(placeholder string) string {
+			width, _ := strconv.Atoi(idPattern.FindStringSubmatch(placeholder)[1])
+			return fmt.Sprintf("%0*d", width, index)
+		})
+		indexes[id] = len(instances)
+		instances = append(instances, &pb.BulkEntityItem{Id: id})
+	}
+
+	listed := make(map[string]bool, len(items))
+	for _, item := range items {
+		if item.Id == "" || listed[item.Id] {
+			return nil, errors.Wrapf(xerrors.ErrInvalidRequest, "invalid entity id %q", item.Id)
+		}
+		listed[item.Id] = true
+
+		if index, has := indexes[item.Id]; has {
+			instances[index] = item
+			continue
+		}
+		instances = append(instances, item)
+	}
+	return instances, nil
+}
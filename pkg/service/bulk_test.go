@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	pb "github.com/tkeel-io/core/api/core/v1"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func Test_expandInstances(t *testing.T) {
+	tariff, err := structpb.NewStruct(map[string]interface{}{"tariff": 2})
+	assert.Nil(t, err)
+	instances, err := expandInstances("meter-{i:4}", 8, 3, []*pb.BulkEntityItem{
+		{Id: "meter-0009", Properties: tariff},
+		{Id: "meter-main"},
+	})
+	assert.Nil(t, err)
+	var ids []string
+	for _, instance := range instances {
+		ids = append(ids, instance.Id)
+	}
+	assert.Equal(t, []string{"meter-0008", "meter-0009", "meter-0010", "meter-main"}, ids)
+	assert.Equal(t, float64(2), instances[1].Properties.AsMap()["tariff"])
+
+	instances, err = expandInstances("room-{i}-{i}", 1, 2, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "room-2-2", instances[1].Id)
+
+	tests := []struct {
+		name    string
+		pattern string
+		start   int32
+		count   int32
+		items   []*pb.BulkEntityItem
+	}{
+		{"empty", "meter-{i}", 0, 0, nil},
+		{"negative count", "meter-{i}", 0, -1, nil},
+		{"pattern without index", "meter", 0, 2, nil},
+		{"too many", "meter-{i}", 0, maxBulkEntities + 1, nil},
+		{"duplicate item", "", 0, 0, []*pb.BulkEntityItem{{Id: "meter"}, {Id: "meter"}}},
+		{"empty item id", "", 0, 0, []*pb.BulkEntityItem{{}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := expandInstances(tt.pattern, tt.start, tt.count, tt.items)
+			assert.ErrorIs(t, err, xerrors.ErrInvalidRequest)
+		})
+	}
+}
+
+func Test_makeBulkEntities(t *testing.T) {
+	tariff, err := structpb.NewStruct(map[string]interface{}{"tariff": 2})
+	assert.Nil(t, err)
+	ens, err := makeBulkEntities(&Entity{Owner: "admin", TemplateID: "meter"},
+		map[string]interface{}{"model": "m1", "tariff": 0},
+		map[string]interface{}{"tariff": 1},
+		"meter-{i}", 0, 2,
+		[]*pb.BulkEntityItem{{Id: "meter-1", Properties: tariff}})
+	assert.Nil(t, err)
+	assert.Len(t, ens, 2)
+	assert.Equal(t, "meter", ens[0].TemplateID)
+	assert.Equal(t, "admin", ens[1].Owner)
+	assert.JSONEq(t, `{"model":"m1","tariff":1}`, string(ens[0].Properties))
+	assert.JSONEq(t, `{"model":"m1","tariff":2}`, string(ens[1].Properties))
+}
+
+func Test_BulkCreateEntities(t *testing.T) {
+	shared, err := structpb.NewStruct(map[string]interface{}{"tariff": 1})
+	assert.Nil(t, err)
+	out, err := entityService.BulkCreateEntities(context.Background(), &pb.BulkCreateEntitiesRequest{
+		TemplateId: "meter",
+		Owner:      "admin",
+		IdPattern:  "meter-{i}",
+		Count:      3,
+		Properties: shared,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, int32(3), out.Total)
+	assert.Equal(t, int32(3), out.Succeeded)
+	assert.Equal(t, "meter-2", out.Entities[2].Id)
+	assert.Equal(t, BulkStatusCreated, out.Entities[2].Status)
+
+	_, err = entityService.BulkCreateEntities(context.Background(), &pb.BulkCreateEntitiesRequest{TemplateId: "meter"})
+	assert.ErrorIs(t, err, xerrors.ErrInvalidRequest)
+}
+
+func Test_CloneEntity(t *testing.T) {
+	out, err := entityService.CloneEntity(context.Background(), &pb.CloneEntityRequest{
+		Id:       "device123",
+		Owner:    "admin",
+		Entities: []*pb.BulkEntityItem{{Id: "device124"}, {Id: "device125"}},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, int32(2), out.Succeeded)
+	assert.Equal(t, "device125", out.Entities[1].Id)
+}
@@ -0,0 +1,147 @@
+package service
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	pb "github.com/tkeel-io/core/api/core/v1"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	logf "github.com/tkeel-io/core/pkg/logfield"
+	apim "github.com/tkeel-io/core/pkg/manager"
+	"github.com/tkeel-io/core/pkg/repository"
+	terrors "github.com/tkeel-io/kit/errors"
+	"github.com/tkeel-io/kit/log"
+	"go.uber.org/atomic"
+	"google.golang.org/grpc/codes"
+)
+
+type DeadLetterService struct {
+	pb.UnimplementedDeadLetterServer
+
+	inited     *atomic.Bool
+	apiManager apim.APIManager
+}
+
+func NewDeadLetterService() *DeadLetterService {
+	return &DeadLetterService{
+		inited: atomic.NewBool(false),
+	}
+}
+
+func (s *DeadLetterService) Init(apiManager apim.APIManager) {
+	s.apiManager = apiManager
+	s.inited.Store(true)
+}
+
+// ListDeadLetters list dead letters of entity, all dead letters if entity id empty.
+func (s *DeadLetterService) ListDeadLetters(ctx context.Context, req *pb.ListDeadLettersRequest) (*pb.ListDeadLettersResponse, error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready")
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	letters, err := s.apiManager.ListDeadLetter(ctx, req.EntityId)
+	if nil != err {
+		log.L().Error("list dead letters", logf.Eid(req.EntityId), logf.Error(err))
+		return nil, errors.Wrap(err, "list dead letters")
+	}
+
+	out := &pb.ListDeadLettersResponse{
+		Total:       int64(len(letters)),
+		DeadLetters: make([]*pb.DeadLetterObject, 0, len(letters)),
+	}
+	for _, letter := range letters {
+		// events omitted in list, inspect dead letter for event.
+		out.DeadLetters = append(out.DeadLetters, makeDeadLetter(letter, false))
+	}
+	return out, nil
+}
+
+// GetDeadLetter returns dead letter with the original event.
+func (s *DeadLetterService) GetDeadLetter(ctx context.Context, req *pb.DeadLetterRequest) (*pb.DeadLetterObject, error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready")
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	letter, err := s.apiManager.GetDeadLetter(ctx, req.Id)
+	if nil != err {
+		log.L().Error("get dead letter", logf.ID(req.Id), logf.Error(err))
+		return nil, convDeadLetterError(errors.Wrap(err, "get dead letter"))
+	}
+	return makeDeadLetter(letter, true), nil
+}
+
+// ReplayDeadLetter dispatch the original event of dead letter again.
+func (s *DeadLetterService) ReplayDeadLetter(ctx context.Context, req *pb.DeadLetterRequest) (*pb.DeadLetterObject, error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready")
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	if err := s.apiManager.ReplayDeadLetter(ctx, req.Id); nil != err {
+		log.L().Error("replay dead letter", logf.ID(req.Id), logf.Error(err))
+		return nil, convDeadLetterError(errors.Wrap(err, "replay dead letter"))
+	}
+	return &pb.DeadLetterObject{Id: req.Id}, nil
+}
+
+func (s *DeadLetterService) DeleteDeadLetter(ctx context.Context, req *pb.DeadLetterRequest) (*pb.DeadLetterObject, error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready")
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	if err := s.apiManager.RemoveDeadLetter(ctx, req.Id); nil != err {
+		log.L().Error("delete dead letter", logf.ID(req.Id), logf.Error(err))
+		return nil, errors.Wrap(err, "delete dead letter")
+	}
+	return &pb.DeadLetterObject{Id: req.Id}, nil
+}
+
+// PurgeDeadLetters remove dead letters of entity, all dead letters if entity id empty.
+func (s *DeadLetterService) PurgeDeadLetters(ctx context.Context, req *pb.ListDeadLettersRequest) (*pb.PurgeDeadLettersResponse, error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready")
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	purged, err := s.apiManager.PurgeDeadLetter(ctx, req.EntityId)
+	if nil != err {
+		log.L().Error("purge dead letters", logf.Eid(req.EntityId), logf.Error(err))
+		return nil, errors.Wrap(err, "purge dead letters")
+	}
+	return &pb.PurgeDeadLettersResponse{Purged: int64(purged)}, nil
+}
+
+func makeDeadLetter(letter *repository.DeadLetter, withEvent bool) *pb.DeadLetterObject {
+	out := &pb.DeadLetterObject{
+		Id:        letter.ID,
+		RuntimeId: letter.RuntimeID,
+		EntityId:  letter.EntityID,
+		EventId:   letter.EventID,
+		Handler:   letter.Handler,
+		Error:     letter.Error,
+		Partition: letter.Partition,
+		Offset:    letter.Offset,
+		Timestamp: letter.Timestamp,
+	}
+
+	if withEvent && len(letter.Event) > 0 {
+		var ev pb.ProtoEvent
+		if err := pb.Unmarshal(letter.Event, &ev); nil == err {
+			out.Event = &ev
+		} else {
+			// undecodable event responded in raw.
+			out.RawEvent = letter.Event
+		}
+	}
+	return out
+}
+
+func convDeadLetterError(err error) error {
+	if errors.Is(err, xerrors.ErrResourceNotFound) {
+		return terrors.New(int(codes.NotFound), xerrors.ErrResourceNotFound.Error(), err.Error())
+	}
+	return err
+}
@@ -0,0 +1,50 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	pb "github.com/tkeel-io/core/api/core/v1"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"github.com/tkeel-io/core/pkg/repository"
+	terrors "github.com/tkeel-io/kit/errors"
+)
+
+func TestDeadLetterService(t *testing.T) {
+	ctx := context.Background()
+	srv := NewDeadLetterService()
+	_, err := srv.ListDeadLetters(ctx, &pb.ListDeadLettersRequest{})
+	assert.NotNil(t, err)
+
+	srv.Init(apiManager)
+	list, err := srv.ListDeadLetters(ctx, &pb.ListDeadLettersRequest{EntityId: "device123"})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), list.Total)
+	assert.Equal(t, "handlePersistent", list.DeadLetters[0].Handler)
+
+	letter, err := srv.GetDeadLetter(ctx, &pb.DeadLetterRequest{Id: "dl-1"})
+	assert.Nil(t, err)
+	assert.Equal(t, "device123", letter.EntityId)
+
+	_, err = srv.ReplayDeadLetter(ctx, &pb.DeadLetterRequest{Id: "dl-1"})
+	assert.Nil(t, err)
+
+	purged, err := srv.PurgeDeadLetters(ctx, &pb.ListDeadLettersRequest{})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), purged.Purged)
+}
+
+func Test_makeDeadLetter(t *testing.T) {
+	bytes, err := pb.Marshal(&pb.ProtoEvent{Id: "ev-1"})
+	assert.Nil(t, err)
+
+	letter := makeDeadLetter(&repository.DeadLetter{ID: "dl-1", Event: bytes}, true)
+	assert.Equal(t, "ev-1", letter.Event.Id)
+
+	letter = makeDeadLetter(&repository.DeadLetter{ID: "dl-1", Event: bytes}, false)
+	assert.Nil(t, letter.Event)
+
+	err = convDeadLetterError(xerrors.ErrResourceNotFound)
+	assert.Equal(t, 404, terrors.GRPCToHTTPStatusCode(terrors.FromError(err).GRPCStatus().Code()))
+}
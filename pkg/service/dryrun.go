@@ -0,0 +1,161 @@
+package service
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	pb "github.com/tkeel-io/core/api/core/v1"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	logf "github.com/tkeel-io/core/pkg/logfield"
+	apim "github.com/tkeel-io/core/pkg/manager"
+	"github.com/tkeel-io/core/pkg/repository"
+	"github.com/tkeel-io/core/pkg/runtime"
+	terrors "github.com/tkeel-io/kit/errors"
+	"github.com/tkeel-io/kit/log"
+	"github.com/tkeel-io/tdtl"
+	"go.uber.org/atomic"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+type DryRunService struct {
+	pb.UnimplementedDryRunServer
+
+	inited     *atomic.Bool
+	apiManager apim.APIManager
+}
+
+func NewDryRunService() *DryRunService {
+	return &DryRunService{
+		inited: atomic.NewBool(false),
+	}
+}
+
+func (s *DryRunService) Init(apiManager apim.APIManager) {
+	s.apiManager = apiManager
+	s.inited.Store(true)
+}
+
+// DryRunExpression evaluates expressions or mapper TQL of the entity against current or supplied
+// states without persisting, returns results with sources read and endpoints runtimes would mount.
+func (s *DryRunService) DryRunExpression(ctx context.Context, req *pb.DryRunRequest) (*pb.DryRunResponse, error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready", logf.Eid(req.EntityId))
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	base := &apim.Base{ID: req.EntityId, Owner: req.Owner, Source: req.Source}
+	parseHeaderFrom(ctx, base)
+	dryRunReq := &apim.DryRunReq{
+		Owner:    base.Owner,
+		EntityID: req.EntityId,
+		TQL:      req.Tql,
+		States:   make(map[string][]byte),
+	}
+	for _, expr := range req.Expressions {
+		dryRunReq.Expressions = append(dryRunReq.Expressions,
+			repository.Expression{Path: propKey(expr.Path), Expression: expr.Expression})
+	}
+	for id, state := range req.States.AsMap() {
+		bytes, err := json.Marshal(state)
+		if nil != err {
+			return nil, convDryRunError(errors.Wrap(xerrors.ErrInvalidRequest, err.Error()))
+		}
+		dryRunReq.States[id] = bytes
+	}
+
+	results, err := s.apiManager.DryRunExpression(ctx, dryRunReq)
+	if nil != err {
+		log.L().Warn("dry run expression", logf.Eid(req.EntityId), logf.Reason(err.Error()))
+		return nil, convDryRunError(errors.Wrap(err, "dry run expression"))
+	}
+
+	out := &pb.DryRunResponse{EntityId: req.EntityId, Results: make([]*pb.DryRunResult, 0, len(results))}
+	for _, result := range results {
+		ret, err := makeDryRunResult(result)
+		if nil != err {
+			log.L().Error("dry run expression", logf.Eid(req.EntityId), logf.Error(err))
+			return nil, errors.Wrap(err, "dry run expression")
+		}
+		out.Results = append(out.Results, ret)
+	}
+	return out, nil
+}
+
+func makeDryRunResult(result *apim.DryRunResult) (*pb.DryRunResult, error) {
+	mounts, err := runtime.PlanExpression(result.Expression)
+	if nil != err {
+		return nil, errors.Wrap(err, "plan expression")
+	}
+
+	out := &pb.DryRunResult{
+		Path:       result.Expression.Path,
+		Expression: result.Expression.Expression,
+		Written:    result.Written,
+		Reason:     result.Reason,
+		Missing:    result.Missing,
+		Mounts:     make([]*pb.DryRunMount, 0, len(mounts)),
+	}
+
+	val, err := nodeValue(result.Result)
+	if nil != err {
+		return nil, err
+	}
+	if out.Result, err = structpb.NewValue(val); nil != err {
+		return nil, errors.Wrap(err, "convert result")
+	}
+
+	sources := make(map[string]interface{}, len(result.Sources))
+	for id, paths := range result.Sources {
+		items := make([]interface{}, 0, len(paths))
+		for _, path := range paths {
+			items = append(items, path)
+		}
+		sources[id] = items
+	}
+	if out.Sources, err = structpb.NewStruct(sources); nil != err {
+		return nil, errors.Wrap(err, "convert sources")
+	}
+
+	inputs := make(map[string]interface{}, len(result.Inputs))
+	for path, node := range result.Inputs {
+		if inputs[path], err = nodeValue(node); nil != err {
+			return nil, err
+		}
+	}
+	if out.Inputs, err = structpb.NewStruct(inputs); nil != err {
+		return nil, errors.Wrap(err, "convert inputs")
+	}
+
+	for _, mount := range mounts {
+		out.Mounts = append(out.Mounts, &pb.DryRunMount{
+			Runtime:      mount.Runtime,
+			Tree:         mount.Tree,
+			Path:         mount.Path,
+			WildcardPath: mount.WildcardPath,
+			Target:       mount.Target,
+			Delivery:     mount.Delivery,
+		})
+	}
+	return out, nil
+}
+
+// nodeValue decode node into value, nil if undefined.
+func nodeValue(node tdtl.Node) (interface{}, error) {
+	if nil == node || node.Type() == tdtl.Undefined || node.Type() == tdtl.Null {
+		return nil, nil
+	}
+
+	var val interface{}
+	err := json.Unmarshal(node.Raw(), &val)
+	return val, errors.Wrap(err, "decode node")
+}
+
+func convDryRunError(err error) error {
+	switch {
+	case errors.Is(err, xerrors.ErrExpressionInvalid),
+		errors.Is(err, xerrors.ErrInvalidRequest):
+		return terrors.New(int(codes.InvalidArgument), xerrors.ErrExpressionInvalid.Error(), err.Error())
+	}
+	return err
+}
@@ -0,0 +1,41 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	pb "github.com/tkeel-io/core/api/core/v1"
+	"github.com/tkeel-io/core/pkg/placement"
+	"github.com/tkeel-io/core/pkg/runtime"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestDryRunService(t *testing.T) {
+	placement.Initialize()
+	placement.Global().Append(placement.Info{ID: "core/1234", Flag: true})
+
+	ctx := context.Background()
+	srv := NewDryRunService()
+	_, err := srv.DryRunExpression(ctx, &pb.DryRunRequest{EntityId: "device123"})
+	assert.NotNil(t, err)
+
+	srv.Init(apiManager)
+	states, err := structpb.NewStruct(map[string]interface{}{"device234": map[string]interface{}{"temp": 20}})
+	assert.Nil(t, err)
+	out, err := srv.DryRunExpression(ctx, &pb.DryRunRequest{
+		EntityId:    "device123",
+		Expressions: []*pb.DryRunExpression{{Path: "temp_f", Expression: "device234.temp * 1.8 + 32"}},
+		States:      states,
+	})
+	assert.Nil(t, err)
+	assert.Len(t, out.Results, 1)
+
+	result := out.Results[0]
+	assert.Equal(t, "properties.temp_f", result.Path)
+	assert.Equal(t, float64(68), result.Result.AsInterface())
+	assert.Equal(t, float64(20), result.Inputs.AsMap()["device234.properties.temp"])
+	assert.True(t, result.Written)
+	assert.Len(t, result.Mounts, 2)
+	assert.Equal(t, runtime.TreeEval, result.Mounts[0].Tree)
+}
@@ -18,8 +18,8 @@ package service
 
 import (
 	"context"
-	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -27,13 +27,14 @@ import (
 	xerrors "github.com/tkeel-io/core/pkg/errors"
 	logf "github.com/tkeel-io/core/pkg/logfield"
 	apim "github.com/tkeel-io/core/pkg/manager"
-	"github.com/tkeel-io/core/pkg/mapper"
 	"github.com/tkeel-io/core/pkg/scheme"
 	xjson "github.com/tkeel-io/core/pkg/util/json"
+	terrors "github.com/tkeel-io/kit/errors"
 	"github.com/tkeel-io/kit/log"
 	"github.com/tkeel-io/tdtl"
 	"go.uber.org/atomic"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
 
 	"google.golang.org/protobuf/types/known/structpb"
 )
@@ -62,6 +63,10 @@ func propKey(key string) string {
 
 type EntityService struct {
 	pb.UnimplementedEntityServer
+	pb.UnimplementedTransactionServer
+	pb.UnimplementedBulkServer
+	pb.UnimplementedExpressionGraphServer
+	pb.UnimplementedExpressionPolicyServer
 
 	inited       *atomic.Bool
 	ctx          context.Context
@@ -115,15 +120,24 @@ func (s *EntityService) CreateEntity(ctx context.Context, req *pb.CreateEntityRe
 		return out, xerrors.ErrInvalidEntityParams
 	}
 
+	ttl, err := parseTTLFrom(ctx)
+	if nil != err {
+		log.L().Error("create entity", logf.Eid(req.Id), logf.Error(err))
+		return out, err
+	}
+
+	reverts, err := s.expire(ctx, entity, ttl, nil)
+	if nil != err {
+		return out, errors.Wrap(err, "create entity failed")
+	}
+
 	var baseRet *apim.BaseRet
 	if baseRet, err = s.apiManager.CreateEntity(ctx, entity); nil != err {
 		log.L().Error("create entity failed", logf.Eid(req.Id), logf.Error(err))
+		s.revertExpire(ctx, entity, reverts)
 		return out, errors.Wrap(err, "create entity failed")
 	}
 
-	// ignore error.
-	s.onTemplateChanged(ctx, entity)
-
 	out, err = s.makeResponse(baseRet)
 	return out, errors.Wrap(err, "create entity failed")
 }
@@ -218,8 +232,6 @@ func (s *EntityService) UpdateEntity(ctx context.Context, req *pb.UpdateEntityRe
 		return out, errors.Wrap(err, "update entity failed")
 	}
 
-	// ignore error.
-	s.onTemplateChanged(ctx, entity)
 	out, err = s.makeResponse(baseRet)
 	return out, errors.Wrap(err, "update entity failed")
 }
@@ -259,11 +271,16 @@ func (s *EntityService) DeleteEntity(ctx context.Context, req *pb.DeleteEntityRe
 	entity.Owner = req.Owner
 	entity.Source = req.Source
 	parseHeaderFrom(ctx, entity)
+	opts, err := parseOptionsFrom(ctx)
+	if nil != err {
+		log.L().Error("delete entity", logf.Error(err), logf.ID(req.Id))
+		return nil, err
+	}
 
 	// delete entity.
-	if err = s.apiManager.DeleteEntity(ctx, entity); nil != err {
+	if err = s.apiManager.DeleteEntity(ctx, entity, opts...); nil != err {
 		log.L().Error("delete entity", logf.Error(err), logf.ID(req.Id))
-		return nil, errors.Wrap(err, "delete entity")
+		return nil, errors.Wrap(convError(err), "delete entity")
 	}
 
 	return &pb.DeleteEntityResponse{Id: req.Id, Status: "ok"}, nil
@@ -305,10 +322,16 @@ func (s *EntityService) UpdateEntityProps(ctx context.Context, req *pb.UpdateEnt
 		Value:    entity.Properties,
 	}}
 
+	opts, err := parseOptionsFrom(ctx)
+	if nil != err {
+		log.L().Error("update entity properties.", logf.Eid(req.Id), logf.Error(err))
+		return nil, err
+	}
+
 	var baseRet *apim.BaseRet
-	if baseRet, _, err = s.apiManager.PatchEntity(ctx, entity, patches); nil != err {
+	if baseRet, _, err = s.apiManager.PatchEntity(ctx, entity, patches, opts...); nil != err {
 		log.L().Error("update entity properties.", logf.Eid(req.Id), logf.Error(err))
-		return out, errors.Wrap(err, "update entity properties")
+		return out, errors.Wrap(convError(err), "update entity properties")
 	}
 
 	out, err = s.makeResponse(baseRet)
@@ -328,45 +351,41 @@ func (s *EntityService) PatchEntityProps(ctx context.Context, req *pb.PatchEntit
 	entity.Source = req.Source
 	parseHeaderFrom(ctx, entity)
 
-	patches := []*pb.PatchData{}
-	params := req.Properties.AsInterface()
-	switch params.(type) {
-	case []interface{}:
-		var data []byte
-		patchData := make([]PatchData, 0)
-		if data, err = json.Marshal(params); nil != err {
-			log.L().Error("patch entity properties.", logf.Eid(req.Id), logf.Error(err))
-			return nil, errors.Wrap(err, "json marshal patch data")
-		} else if err = json.Unmarshal(data, &patchData); nil != err {
-			log.L().Error("patch entity properties.", logf.Eid(req.Id), logf.Error(err))
-			return nil, errors.Wrap(err, "json unmarshal patch data")
-		}
+	patches, err := parsePropsPatches(req.Properties.AsInterface())
+	if nil != err {
+		log.L().Error("patch entity properties.", logf.Eid(req.Id), logf.Error(err))
+		return nil, errors.Wrap(err, "patch entity properties")
+	}
 
-		for index := range patchData {
-			var bytes []byte
-			if err = checkPatchData(patchData[index]); nil != err {
-				log.L().Error("patch entity properties.", logf.Eid(req.Id), logf.Error(err))
-				return nil, errors.Wrap(err, "patch entity properties")
-			} else if bytes, err = json.Marshal(patchData[index].Value); nil != err {
-				return nil, errors.Wrap(err, "encode property")
-			}
-			// encode value.
-			patches = append(patches, &pb.PatchData{
-				Path:     propKey(patchData[index].Path),
-				Operator: patchData[index].Operator,
-				Value:    bytes,
-			})
-		}
-	default:
-		log.L().Error("patch entity properties.", logf.Eid(req.Id), logf.Error(xerrors.ErrInvalidRequest))
-		return nil, xerrors.ErrInvalidRequest
+	opts, err := parseOptionsFrom(ctx)
+	if nil != err {
+		log.L().Error("patch entity properties.", logf.Eid(req.Id), logf.Error(err))
+		return nil, err
+	}
+
+	ttl, err := parseTTLFrom(ctx)
+	if nil != err {
+		log.L().Error("patch entity properties.", logf.Eid(req.Id), logf.Error(err))
+		return nil, err
+	}
+
+	propTTLs, err := parsePropsTTL(req.Properties.AsInterface())
+	if nil != err {
+		log.L().Error("patch entity properties.", logf.Eid(req.Id), logf.Error(err))
+		return nil, errors.Wrap(err, "patch entity properties")
+	}
+
+	reverts, err := s.expire(ctx, entity, ttl, propTTLs)
+	if nil != err {
+		return nil, errors.Wrap(err, "patch entity properties")
 	}
 
 	var rawEntity []byte
 	var baseRet *apim.BaseRet
-	if baseRet, rawEntity, err = s.apiManager.PatchEntity(ctx, entity, patches); nil != err {
+	if baseRet, rawEntity, err = s.apiManager.PatchEntity(ctx, entity, patches, opts...); nil != err {
 		log.L().Error("patch entity properties.", logf.Eid(req.Id), logf.Error(err))
-		return nil, errors.Wrap(err, "patch entity properties")
+		s.revertExpire(ctx, entity, reverts)
+		return nil, errors.Wrap(convError(err), "patch entity properties")
 	}
 
 	// clip copy properties.
@@ -393,6 +412,71 @@ func checkPatchData(patchData PatchData) error {
 	return nil
 }
 
+// parsePropsPatches parse json patches of properties.
+func parsePropsPatches(params interface{}) ([]*pb.PatchData, error) {
+	patchData := make([]PatchData, 0)
+	switch params.(type) {
+	case []interface{}:
+		data, err := json.Marshal(params)
+		if nil != err {
+			return nil, errors.Wrap(err, "json marshal patch data")
+		} else if err = json.Unmarshal(data, &patchData); nil != err {
+			return nil, errors.Wrap(err, "json unmarshal patch data")
+		}
+	default:
+		return nil, xerrors.ErrInvalidRequest
+	}
+
+	patches := make([]*pb.PatchData, 0, len(patchData))
+	for index := range patchData {
+		if err := checkPropsPatchData(patchData[index]); nil != err {
+			return nil, errors.Wrap(err, "check patch data")
+		}
+
+		bytes, err := json.Marshal(patchData[index].Value)
+		if nil != err {
+			return nil, errors.Wrap(err, "encode property")
+		}
+
+		var from string
+		if patchData[index].From != "" {
+			from = propKey(patchData[index].From)
+		}
+
+		// encode value.
+		patches = append(patches, &pb.PatchData{
+			Path:     propKey(patchData[index].Path),
+			Operator: patchData[index].Operator,
+			Value:    bytes,
+			From:     from,
+		})
+	}
+	return patches, nil
+}
+
+// checkPropsPatchData check property patch, test and move operations and
+// copy operation with from path are accepted besides checkPatchData.
+func checkPropsPatchData(patchData PatchData) error {
+	switch patchData.Operator {
+	case xjson.OpTest.String():
+	case xjson.OpMove.String():
+		if !xjson.IsValidPath(patchData.From) {
+			return xerrors.ErrPatchPathInvalid
+		}
+	case xjson.OpCopy.String():
+		if patchData.From != "" && !xjson.IsValidPath(patchData.From) {
+			return xerrors.ErrPatchPathInvalid
+		}
+	default:
+		return checkPatchData(patchData)
+	}
+
+	if !xjson.IsValidPath(patchData.Path) {
+		return xerrors.ErrPatchPathInvalid
+	}
+	return nil
+}
+
 func (s *EntityService) GetEntityProps(ctx context.Context, in *pb.GetEntityPropsRequest) (out *pb.EntityResponse, err error) {
 	if !s.inited.Load() {
 		log.L().Warn("service not ready", logf.Eid(in.Id))
@@ -508,11 +592,17 @@ func (s *EntityService) UpdateEntityConfigs(ctx context.Context, in *pb.UpdateEn
 		Value:    entity.Scheme,
 	}}
 
+	opts, err := parseOptionsFrom(ctx)
+	if nil != err {
+		log.L().Error("update entity scheme", logf.Eid(in.Id), logf.Error(err))
+		return nil, err
+	}
+
 	// set entity configs.
 	var baseRet *apim.BaseRet
-	if baseRet, _, err = s.apiManager.PatchEntity(ctx, entity, patches); nil != err {
+	if baseRet, _, err = s.apiManager.PatchEntity(ctx, entity, patches, opts...); nil != err {
 		log.L().Error("update entity scheme", logf.Eid(in.Id), logf.Error(err))
-		return out, errors.Wrap(err, "update entity scheme")
+		return out, errors.Wrap(convError(err), "update entity scheme")
 	}
 
 	out, err = s.makeResponse(baseRet)
@@ -808,6 +898,53 @@ func parseHeaderFrom(ctx context.Context, en *apim.Base) {
 	}
 }
 
+// parseOptionsFrom parse request options from headers, entity version precondition
+// from If-Match header, both `"12"` and `W/"12"` are accepted, and idempotency key
+// from Idempotency-Key header.
+func parseOptionsFrom(ctx context.Context) ([]apim.Option, error) {
+	header, ok := ctx.Value(struct{}{}).(http.Header)
+	if !ok {
+		return nil, nil
+	}
+
+	var opts []apim.Option
+	if key := strings.TrimSpace(header.Get(HeaderIdempotency)); key != "" {
+		opts = append(opts, apim.NewIdempotencyKeyOption(key))
+	}
+
+	if header.Get(HeaderIfMatch) == "" {
+		return opts, nil
+	}
+
+	etag := strings.TrimPrefix(strings.TrimSpace(header.Get(HeaderIfMatch)), "W/")
+	if etag == pb.IfVersionAny {
+		return append(opts, apim.NewIfExistsOption()), nil
+	}
+
+	version, err := strconv.ParseInt(strings.Trim(etag, `"`), 10, 64)
+	if nil != err {
+		return nil, errors.Wrap(xerrors.ErrInvalidRequest, "parse If-Match header")
+	}
+
+	return append(opts, apim.NewIfVersionOption(version)), nil
+}
+
+// convError convert errors which need a distinct status code.
+func convError(err error) error {
+	if errors.Is(err, xerrors.ErrEntityVersionConflict) {
+		return terrors.New(int(codes.FailedPrecondition), xerrors.ErrEntityVersionConflict.Error(), err.Error())
+	} else if errors.Is(err, xerrors.ErrPatchTestFailed) {
+		return terrors.New(int(codes.FailedPrecondition), xerrors.ErrPatchTestFailed.Error(), err.Error())
+	} else if errors.Is(err, xerrors.ErrTransactionAborted) {
+		return terrors.New(int(codes.Aborted), xerrors.ErrTransactionAborted.Error(), err.Error())
+	} else if errors.Is(err, xerrors.ErrExpressionCycle) {
+		return terrors.New(int(codes.FailedPrecondition), xerrors.ErrExpressionCycle.Error(), err.Error())
+	} else if errors.Is(err, xerrors.ErrExpressionInvalid) {
+		return terrors.New(int(codes.InvalidArgument), xerrors.ErrExpressionInvalid.Error(), err.Error())
+	}
+	return err
+}
+
 func (s *EntityService) makeResponse(base *apim.BaseRet) (out *pb.EntityResponse, err error) {
 	if base == nil {
 		return
@@ -851,6 +988,11 @@ func CopyFrom(raw []byte, patches ...*pb.PatchData) (map[string]interface{}, boo
 	for _, patch := range patches {
 		switch patch.Operator {
 		case xjson.OpCopy.String():
+			// copy with from path is structural edit, not clip.
+			if patch.From != "" {
+				continue
+			}
+
 			cpFlag = true
 			var val interface{}
 			if ret := cc.Get(patch.Path); ret.Error() != nil {
@@ -878,29 +1020,3 @@ func CopyFrom2(raw []byte, paths ...string) (map[string]interface{}, bool, error
 	result, flag, err := CopyFrom(raw, patches...)
 	return result, flag, errors.Wrap(err, "copy result")
 }
-
-func (s *EntityService) onTemplateChanged(ctx context.Context, en *Entity) error {
-	if en.TemplateID == "" {
-		return nil
-	}
-
-	// create a mapper for sync scheme.
-	// insert into eid select template.scheme as scheme
-	mp := &mapper.Mapper{
-		ID:          "SyncScheme",
-		TQL:         fmt.Sprintf("insert into %s select %s.scheme as scheme", en.ID, en.TemplateID),
-		Name:        "SyncScheme",
-		Owner:       en.Owner,
-		EntityID:    en.ID,
-		Description: "mapper instance to sync scheme",
-	}
-
-	log.L().Info("onTemplateChanged", logf.Eid(en.ID), logf.ID(mp.ID), logf.TQL(mp.TQL))
-
-	if err := s.apiManager.AppendMapperZ(ctx, mp); nil != err {
-		log.L().Error("create template mapper", logf.Error(err), logf.Eid(en.ID))
-		return errors.Wrap(err, "create template mapper")
-	}
-
-	return nil
-}
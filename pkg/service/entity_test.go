@@ -2,13 +2,17 @@ package service
 
 import (
 	"context"
+	"net/http"
 	"os"
 	"testing"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	pb "github.com/tkeel-io/core/api/core/v1"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
 	apim "github.com/tkeel-io/core/pkg/manager"
 	"github.com/tkeel-io/core/pkg/service/mock"
+	terrors "github.com/tkeel-io/kit/errors"
 	"github.com/tkeel-io/kit/log"
 	"google.golang.org/protobuf/types/known/structpb"
 )
@@ -86,6 +90,85 @@ func Test_DeleteEntity(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func Test_parseOptionsFrom(t *testing.T) {
+	opts, err := parseOptionsFrom(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, opts, 0)
+
+	for _, etag := range []string{`12`, `"12"`, `W/"12"`} {
+		header := http.Header{}
+		header.Set(HeaderIfMatch, etag)
+		opts, err = parseOptionsFrom(context.WithValue(context.Background(), struct{}{}, header))
+		assert.Nil(t, err)
+		assert.Len(t, opts, 1)
+
+		meta := apim.Metadata{}
+		opts[0](meta)
+		assert.Equal(t, "12", meta[pb.MetaIfVersion])
+	}
+
+	// entity must exist.
+	header := http.Header{}
+	header.Set(HeaderIfMatch, "*")
+	opts, err = parseOptionsFrom(context.WithValue(context.Background(), struct{}{}, header))
+	assert.Nil(t, err)
+	meta := apim.Metadata{}
+	opts[0](meta)
+	assert.Equal(t, pb.IfVersionAny, meta[pb.MetaIfVersion])
+
+	header = http.Header{}
+	header.Set(HeaderIfMatch, "abc")
+	_, err = parseOptionsFrom(context.WithValue(context.Background(), struct{}{}, header))
+	assert.ErrorIs(t, err, xerrors.ErrInvalidRequest)
+
+	header = http.Header{}
+	header.Set(HeaderIdempotency, "order-1")
+	header.Set(HeaderIfMatch, "3")
+	opts, err = parseOptionsFrom(context.WithValue(context.Background(), struct{}{}, header))
+	assert.Nil(t, err)
+	meta = apim.Metadata{}
+	for _, opt := range opts {
+		opt(meta)
+	}
+	assert.Equal(t, "order-1", meta[pb.MetaIdempotencyKey])
+	assert.Equal(t, "3", meta[pb.MetaIfVersion])
+}
+
+func Test_convError(t *testing.T) {
+	err := convError(errors.Wrap(xerrors.ErrEntityVersionConflict, "patch entity"))
+	assert.Equal(t, http.StatusPreconditionFailed, terrors.FromError(errors.Wrap(err, "patch entity properties")).ToHTTPStatusCode())
+	assert.Equal(t, xerrors.ErrInternal, convError(xerrors.ErrInternal))
+
+	err = convError(errors.Wrap(xerrors.ErrPatchTestFailed, "patch entity"))
+	assert.Equal(t, http.StatusPreconditionFailed, terrors.FromError(err).ToHTTPStatusCode())
+}
+
+func Test_checkPropsPatchData(t *testing.T) {
+	assert.Nil(t, checkPropsPatchData(PatchData{Path: "temp", Operator: "test", Value: 20}))
+	assert.Nil(t, checkPropsPatchData(PatchData{Path: "temp2", Operator: "move", From: "temp"}))
+	assert.Nil(t, checkPropsPatchData(PatchData{Path: "temp2", Operator: "copy", From: "temp"}))
+	assert.Nil(t, checkPropsPatchData(PatchData{Path: "temp", Operator: "copy"}))
+	assert.ErrorIs(t, checkPropsPatchData(PatchData{Path: "temp2", Operator: "move"}), xerrors.ErrPatchPathInvalid)
+	assert.ErrorIs(t, checkPropsPatchData(PatchData{Path: ".temp", Operator: "test"}), xerrors.ErrPatchPathInvalid)
+	assert.ErrorIs(t, checkPropsPatchData(PatchData{Path: "temp", Operator: "merge"}), xerrors.ErrJSONPatchReservedOp)
+}
+
+func Test_parsePropsPatches(t *testing.T) {
+	patches, err := parsePropsPatches([]interface{}{
+		map[string]interface{}{"path": "temp2", "operator": "move", "from": "temp"},
+		map[string]interface{}{"path": "temp", "operator": "copy", "value": "metrics.cpu"},
+		map[string]interface{}{"path": "temp", "operator": "test", "value": 20},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "properties.temp", patches[0].From)
+	assert.Equal(t, `null`, string(patches[0].Value))
+
+	// value of copy kept, not taken as from path.
+	assert.Equal(t, "", patches[1].From)
+	assert.Equal(t, `"metrics.cpu"`, string(patches[1].Value))
+	assert.Equal(t, `20`, string(patches[2].Value))
+}
+
 func Test_GetEntityProps(t *testing.T) {
 	_, err := entityService.GetEntityProps(context.Background(), &pb.GetEntityPropsRequest{
 		Id:           "device123",
@@ -364,3 +447,27 @@ func TestCopyFrom(t *testing.T) {
 	assert.Nil(t, err)
 	t.Log("\nResult: ", result)
 }
+
+func TestCopyFrom_withFrom(t *testing.T) {
+	raw := []byte(`{"properties": {"temp": 20, "temp2": 20}}`)
+	result, cpflag, err := CopyFrom(raw, &pb.PatchData{
+		Path:     "properties.temp2",
+		Operator: "copy",
+		Value:    []byte(`null`),
+		From:     "properties.temp",
+	})
+	assert.Nil(t, err)
+	assert.False(t, cpflag)
+	assert.Empty(t, result)
+
+	// value copy of string clipped.
+	raw = []byte(`{"properties": {"name": "device123"}}`)
+	result, cpflag, err = CopyFrom(raw, &pb.PatchData{
+		Path:     "properties.name",
+		Operator: "copy",
+		Value:    []byte(`"device123"`),
+	})
+	assert.Nil(t, err)
+	assert.True(t, cpflag)
+	assert.Equal(t, "device123", result["name"])
+}
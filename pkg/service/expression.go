@@ -2,7 +2,10 @@ package service
 
 import (
 	"context"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	pb "github.com/tkeel-io/core/api/core/v1"
@@ -10,7 +13,10 @@ import (
 	logf "github.com/tkeel-io/core/pkg/logfield"
 	apim "github.com/tkeel-io/core/pkg/manager"
 	"github.com/tkeel-io/core/pkg/repository"
+	terrors "github.com/tkeel-io/kit/errors"
 	"github.com/tkeel-io/kit/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 func (s *EntityService) AppendExpression(ctx context.Context, req *pb.AppendExpressionReq) (out *pb.AppendExpressionResp, err error) {
@@ -39,6 +45,7 @@ func (s *EntityService) AppendExpression(ctx context.Context, req *pb.AppendExpr
 	if err = s.apiManager.AppendExpression(ctx, expressions); nil != err {
 		log.L().Error("append expressions",
 			logf.Eid(req.EntityId), logf.Owner(req.Owner), logf.Error(err))
+		return nil, convError(errors.Wrap(err, "append expressions"))
 	}
 
 	return &pb.AppendExpressionResp{
@@ -159,6 +166,172 @@ func (s *EntityService) ListExpression(ctx context.Context, in *pb.ListExpressio
 	return out, nil
 }
 
+// ExpressionGraph returns the dependency graph of expressions, vertices are property paths of entities.
+func (s *EntityService) ExpressionGraph(ctx context.Context, in *pb.ExpressionGraphRequest) (*pb.ExpressionGraphResponse, error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready", logf.Eid(in.EntityId))
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	deps, err := s.apiManager.ExpressionGraph(ctx, in.EntityId)
+	if nil != err {
+		log.L().Error("expression graph", logf.Eid(in.EntityId), logf.Error(err))
+		return nil, errors.Wrap(err, "expression graph")
+	}
+
+	nodes := make(map[string]struct{})
+	out := &pb.ExpressionGraphResponse{
+		Nodes:        []string{},
+		Dependencies: make([]*pb.ExpressionDependency, 0, len(deps)),
+	}
+	for _, dep := range deps {
+		for _, node := range []string{dep.Source, dep.Target} {
+			if _, has := nodes[node]; !has {
+				nodes[node] = struct{}{}
+				out.Nodes = append(out.Nodes, node)
+			}
+		}
+		out.Dependencies = append(out.Dependencies, &pb.ExpressionDependency{
+			ExpressionId: dep.ExpressionID,
+			EntityId:     dep.EntityID,
+			Source:       dep.Source,
+			Target:       dep.Target,
+		})
+	}
+	sort.Strings(out.Nodes)
+	return out, nil
+}
+
+// GetExpressionPolicy returns policy of the expression, nil if dispatched on each change.
+func (s *EntityService) GetExpressionPolicy(ctx context.Context, in *pb.ExpressionPolicyRequest) (*pb.ExpressionPolicyResponse, error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready", logf.Eid(in.EntityId))
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	en := Entity{
+		ID:     in.EntityId,
+		Owner:  in.Owner,
+		Source: in.Source}
+	parseHeaderFrom(ctx, &en)
+
+	expr, err := s.apiManager.GetExpression(ctx, repository.Expression{
+		Path:     propKey(in.Path),
+		Owner:    en.Owner,
+		EntityID: en.ID,
+	})
+	if nil != err {
+		log.L().Error("get expression policy", logf.Error(err),
+			logf.Eid(en.ID), logf.Owner(en.Owner), logf.Path(in.Path))
+		return nil, convPolicyError(errors.Wrap(err, "get expression policy"))
+	}
+
+	return &pb.ExpressionPolicyResponse{
+		EntityId: en.ID,
+		Owner:    en.Owner,
+		Path:     expr.Path,
+		Policy:   dao2pbPolicy(expr.Policy),
+	}, nil
+}
+
+// SetExpressionPolicy update policy of the expression, results held by runtime dispatched when windows end.
+func (s *EntityService) SetExpressionPolicy(ctx context.Context, in *pb.ExpressionPolicyRequest) (*pb.ExpressionPolicyResponse, error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready", logf.Eid(in.EntityId))
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	en := Entity{
+		ID:     in.EntityId,
+		Owner:  in.Owner,
+		Source: in.Source}
+	parseHeaderFrom(ctx, &en)
+
+	policy, err := pb2daoPolicy(in.Policy)
+	if nil != err {
+		return nil, convPolicyError(err)
+	}
+
+	log.L().Debug("set expression policy", logf.Owner(en.Owner),
+		logf.Eid(en.ID), logf.Path(in.Path), logf.Value(policy))
+	expr, err := s.apiManager.SetExpressionPolicy(ctx, repository.Expression{
+		Path:     propKey(in.Path),
+		Owner:    en.Owner,
+		EntityID: en.ID,
+	}, policy)
+	if nil != err {
+		log.L().Error("set expression policy", logf.Error(err),
+			logf.Eid(en.ID), logf.Owner(en.Owner), logf.Path(in.Path))
+		return nil, convPolicyError(errors.Wrap(err, "set expression policy"))
+	}
+
+	return &pb.ExpressionPolicyResponse{
+		EntityId: en.ID,
+		Owner:    en.Owner,
+		Path:     expr.Path,
+		Policy:   dao2pbPolicy(expr.Policy),
+	}, nil
+}
+
+func pb2daoPolicy(in *pb.ExpressionPolicyObject) (*repository.ExpressionPolicy, error) {
+	if nil == in {
+		return nil, nil
+	}
+
+	var err error
+	policy := &repository.ExpressionPolicy{Deadband: in.Deadband}
+	if policy.Debounce, err = parseInterval(in.Debounce.AsInterface()); nil != err {
+		return nil, errors.Wrap(err, "parse debounce")
+	} else if policy.MinInterval, err = parseInterval(in.MinInterval.AsInterface()); nil != err {
+		return nil, errors.Wrap(err, "parse min_interval")
+	}
+	return policy, nil
+}
+
+// parseInterval parse interval in seconds or duration like "500ms", zero if unset.
+func parseInterval(value interface{}) (time.Duration, error) {
+	switch value := value.(type) {
+	case nil:
+		return 0, nil
+	case float64:
+		if value == 0 {
+			return 0, nil
+		}
+		return parseTTL(strconv.FormatFloat(value, 'f', -1, 64))
+	case string:
+		if value == "" {
+			return 0, nil
+		}
+		return parseTTL(value)
+	}
+	return 0, errors.Wrapf(xerrors.ErrInvalidRequest, "interval %v", value)
+}
+
+func dao2pbPolicy(policy *repository.ExpressionPolicy) *pb.ExpressionPolicyObject {
+	if nil == policy {
+		return nil
+	}
+
+	out := &pb.ExpressionPolicyObject{Deadband: policy.Deadband}
+	if policy.Debounce > 0 {
+		out.Debounce = structpb.NewStringValue(policy.Debounce.String())
+	}
+	if policy.MinInterval > 0 {
+		out.MinInterval = structpb.NewStringValue(policy.MinInterval.String())
+	}
+	return out
+}
+
+func convPolicyError(err error) error {
+	switch {
+	case errors.Is(err, xerrors.ErrInvalidRequest):
+		return terrors.New(int(codes.InvalidArgument), xerrors.ErrInvalidRequest.Error(), err.Error())
+	case errors.Is(err, xerrors.ErrResourceNotFound):
+		return terrors.New(int(codes.NotFound), xerrors.ErrExpressionNotFound.Error(), err.Error())
+	}
+	return err
+}
+
 func dao2pbExpression(expr *repository.Expression) *pb.Expression {
 	var path string
 	if expr.Type == repository.ExprTypeEval {
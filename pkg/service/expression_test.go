@@ -0,0 +1,47 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	pb "github.com/tkeel-io/core/api/core/v1"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func Test_ExpressionGraph(t *testing.T) {
+	out, err := entityService.ExpressionGraph(context.Background(), &pb.ExpressionGraphRequest{EntityId: "device123"})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"device123.properties.temp_f", "device234.properties.temp"}, out.Nodes)
+	assert.Len(t, out.Dependencies, 1)
+	assert.Equal(t, "expr1", out.Dependencies[0].ExpressionId)
+}
+
+func Test_SetExpressionPolicy(t *testing.T) {
+	out, err := entityService.SetExpressionPolicy(context.Background(), &pb.ExpressionPolicyRequest{
+		EntityId: "device123",
+		Path:     "temp_f",
+		Policy:   &pb.ExpressionPolicyObject{Debounce: structpb.NewStringValue("500ms"), Deadband: 0.5},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "properties.temp_f", out.Path)
+	assert.Equal(t, "500ms", out.Policy.Debounce.AsInterface())
+	assert.Nil(t, out.Policy.MinInterval)
+	assert.Equal(t, 0.5, out.Policy.Deadband)
+
+	// intervals in seconds.
+	out, err = entityService.SetExpressionPolicy(context.Background(), &pb.ExpressionPolicyRequest{
+		EntityId: "device123",
+		Path:     "temp_f",
+		Policy:   &pb.ExpressionPolicyObject{MinInterval: structpb.NewNumberValue(2)},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "2s", out.Policy.MinInterval.AsInterface())
+
+	_, err = entityService.SetExpressionPolicy(context.Background(), &pb.ExpressionPolicyRequest{
+		EntityId: "device123",
+		Path:     "temp_f",
+		Policy:   &pb.ExpressionPolicyObject{Debounce: structpb.NewStringValue("abc")},
+	})
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,170 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	pb "github.com/tkeel-io/core/api/core/v1"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"github.com/tkeel-io/core/pkg/function"
+	logf "github.com/tkeel-io/core/pkg/logfield"
+	apim "github.com/tkeel-io/core/pkg/manager"
+	"github.com/tkeel-io/core/pkg/repository"
+	terrors "github.com/tkeel-io/kit/errors"
+	"github.com/tkeel-io/kit/log"
+	"go.uber.org/atomic"
+	"google.golang.org/grpc/codes"
+)
+
+type FunctionService struct {
+	pb.UnimplementedFunctionServer
+
+	inited   *atomic.Bool
+	repo     repository.IRepository
+	registry *function.Registry
+}
+
+func NewFunctionService() *FunctionService {
+	return &FunctionService{
+		inited: atomic.NewBool(false),
+	}
+}
+
+func (s *FunctionService) Init(repo repository.IRepository, registry *function.Registry) {
+	s.repo = repo
+	s.registry = registry
+	s.inited.Store(true)
+}
+
+// PutFunction upload module and register functions declared, module replaced if exists.
+// modules are loaded by all nodes, expressions created after loaded can call the functions.
+func (s *FunctionService) PutFunction(ctx context.Context, req *pb.PutFunctionRequest) (*pb.FunctionObject, error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready", logf.ID(req.Id))
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	base := &apim.Base{Owner: req.Owner}
+	parseHeaderFrom(ctx, base)
+	fn := &repository.Function{
+		ID:          req.Id,
+		Owner:       base.Owner,
+		Description: req.Description,
+		Module:      req.Module,
+		UpdatedAt:   time.Now().UnixMilli(),
+	}
+	for _, spec := range req.Functions {
+		fn.Functions = append(fn.Functions, &repository.FunctionSpec{
+			Name:   spec.Name,
+			Export: spec.Export,
+			Params: spec.Params,
+			Result: spec.Result,
+		})
+	}
+
+	if err := s.registry.Validate(ctx, fn); nil != err {
+		log.L().Warn("put function", logf.ID(req.Id), logf.Reason(err.Error()))
+		return nil, convFunctionError(errors.Wrap(err, "put function"))
+	}
+
+	fn.CreatedAt = fn.UpdatedAt
+	if prev, err := s.repo.GetFunction(ctx, &repository.Function{ID: req.Id}); nil == err {
+		fn.CreatedAt = prev.CreatedAt
+	} else if !errors.Is(err, xerrors.ErrResourceNotFound) {
+		log.L().Error("put function", logf.ID(req.Id), logf.Error(err))
+		return nil, errors.Wrap(err, "put function")
+	}
+
+	if err := s.repo.PutFunction(ctx, fn); nil != err {
+		log.L().Error("put function", logf.ID(req.Id), logf.Error(err))
+		return nil, errors.Wrap(err, "put function")
+	}
+
+	// loaded locally, functions can be called once responded, other nodes load by watching.
+	if err := s.registry.Load(ctx, fn); nil != err {
+		log.L().Error("load function", logf.ID(req.Id), logf.Error(err))
+	}
+	return makeFunction(fn), nil
+}
+
+func (s *FunctionService) ListFunctions(ctx context.Context, req *pb.ListFunctionsRequest) (*pb.ListFunctionsResponse, error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready")
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	fns, err := s.repo.ListFunction(ctx, s.repo.GetLastRevision(ctx))
+	if nil != err && !errors.Is(err, xerrors.ErrResourceNotFound) {
+		log.L().Error("list functions", logf.Error(err))
+		return nil, errors.Wrap(err, "list functions")
+	}
+
+	out := &pb.ListFunctionsResponse{
+		Total:     int64(len(fns)),
+		Functions: make([]*pb.FunctionObject, 0, len(fns)),
+	}
+	for _, fn := range fns {
+		out.Functions = append(out.Functions, makeFunction(fn))
+	}
+	return out, nil
+}
+
+func (s *FunctionService) GetFunction(ctx context.Context, req *pb.FunctionRequest) (*pb.FunctionObject, error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready", logf.ID(req.Id))
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	fn, err := s.repo.GetFunction(ctx, &repository.Function{ID: req.Id})
+	if nil != err {
+		log.L().Error("get function", logf.ID(req.Id), logf.Error(err))
+		return nil, convFunctionError(errors.Wrap(err, "get function"))
+	}
+	return makeFunction(fn), nil
+}
+
+// DeleteFunction remove module, functions of the module evaluated as undefined afterwards.
+func (s *FunctionService) DeleteFunction(ctx context.Context, req *pb.FunctionRequest) (*pb.FunctionObject, error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready", logf.ID(req.Id))
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	if err := s.repo.DelFunction(ctx, &repository.Function{ID: req.Id}); nil != err {
+		log.L().Error("delete function", logf.ID(req.Id), logf.Error(err))
+		return nil, convFunctionError(errors.Wrap(err, "delete function"))
+	}
+	s.registry.Unload(ctx, req.Id)
+	return &pb.FunctionObject{Id: req.Id}, nil
+}
+
+func makeFunction(fn *repository.Function) *pb.FunctionObject {
+	out := &pb.FunctionObject{
+		Id:          fn.ID,
+		Owner:       fn.Owner,
+		Description: fn.Description,
+		Size:        int64(len(fn.Module)),
+		CreatedAt:   fn.CreatedAt,
+		UpdatedAt:   fn.UpdatedAt,
+	}
+	for _, spec := range fn.Functions {
+		out.Functions = append(out.Functions, &pb.FunctionSpec{
+			Name:   spec.Name,
+			Export: spec.Export,
+			Params: spec.Params,
+			Result: spec.Result,
+		})
+	}
+	return out
+}
+
+func convFunctionError(err error) error {
+	switch {
+	case errors.Is(err, xerrors.ErrResourceNotFound):
+		return terrors.New(int(codes.NotFound), xerrors.ErrResourceNotFound.Error(), err.Error())
+	case errors.Is(err, xerrors.ErrFunctionInvalid):
+		return terrors.New(int(codes.InvalidArgument), xerrors.ErrFunctionInvalid.Error(), err.Error())
+	}
+	return err
+}
@@ -0,0 +1,128 @@
+package service
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	pb "github.com/tkeel-io/core/api/core/v1"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"github.com/tkeel-io/core/pkg/history"
+	logf "github.com/tkeel-io/core/pkg/logfield"
+	terrors "github.com/tkeel-io/kit/errors"
+	"github.com/tkeel-io/kit/log"
+	"go.uber.org/atomic"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const defaultHistoryLimit = 20
+
+type HistoryService struct {
+	pb.UnimplementedHistoryServer
+
+	inited  *atomic.Bool
+	history history.History
+}
+
+func NewHistoryService() *HistoryService {
+	return &HistoryService{
+		inited: atomic.NewBool(false),
+	}
+}
+
+func (s *HistoryService) Init(h history.History) {
+	s.history = h
+	s.inited.Store(true)
+}
+
+// ListHistory list change records of entity, newest first.
+func (s *HistoryService) ListHistory(ctx context.Context, req *pb.ListHistoryRequest) (out *pb.ListHistoryResponse, err error) {
+	if err = s.ready(req.Id); nil != err {
+		return nil, err
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultHistoryLimit
+	}
+
+	records, total, err := s.history.List(ctx, req.Id, int(req.Offset), int(limit))
+	if nil != err {
+		log.L().Error("list history", logf.Eid(req.Id), logf.Error(err))
+		return nil, errors.Wrap(err, "list history")
+	}
+
+	out = &pb.ListHistoryResponse{Total: total, Records: make([]*pb.HistoryRecord, 0, len(records))}
+	for _, record := range records {
+		out.Records = append(out.Records, makeHistoryRecord(record))
+	}
+	return out, nil
+}
+
+// GetHistoryState get state of entity as of version or timestamp.
+func (s *HistoryService) GetHistoryState(ctx context.Context, req *pb.GetHistoryStateRequest) (out *pb.GetHistoryStateResponse, err error) {
+	if err = s.ready(req.Id); nil != err {
+		return nil, err
+	} else if req.Version <= 0 && req.Timestamp <= 0 {
+		return nil, errors.Wrap(xerrors.ErrInvalidRequest, "get history state, version or timestamp required")
+	}
+
+	state, record, err := s.history.Get(ctx, req.Id, history.AsOf{Version: req.Version, Timestamp: req.Timestamp})
+	if nil != err {
+		log.L().Error("get history state", logf.Eid(req.Id), logf.Error(err))
+		return nil, convHistoryError(errors.Wrap(err, "get history state"))
+	}
+
+	var val interface{}
+	out = &pb.GetHistoryStateResponse{Record: makeHistoryRecord(record)}
+	if err = json.Unmarshal(state, &val); nil != err {
+		log.L().Error("get history state, decode state", logf.Eid(req.Id), logf.Error(err))
+		return nil, errors.Wrap(err, "get history state")
+	} else if out.State, err = structpb.NewValue(val); nil != err {
+		log.L().Error("get history state, convert state", logf.Eid(req.Id), logf.Error(err))
+		return nil, errors.Wrap(err, "get history state")
+	}
+	return out, nil
+}
+
+func (s *HistoryService) ready(entityID string) error {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready", logf.Eid(entityID))
+		return errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	} else if nil == s.history {
+		return terrors.New(int(codes.Unimplemented), xerrors.ErrHistoryDisabled.Error(), "entity history disabled")
+	}
+	return nil
+}
+
+func makeHistoryRecord(record *history.Record) *pb.HistoryRecord {
+	patches := make([]*pb.HistoryPatch, 0, len(record.Patches))
+	for _, patch := range record.Patches {
+		item := &pb.HistoryPatch{Op: patch.Op, Path: patch.Path}
+		if len(patch.Value) > 0 {
+			var val interface{}
+			if err := json.Unmarshal(patch.Value, &val); nil == err {
+				item.Value, _ = structpb.NewValue(val)
+			}
+		}
+		patches = append(patches, item)
+	}
+
+	return &pb.HistoryRecord{
+		Seq:       record.Seq,
+		Version:   record.Version,
+		Timestamp: record.Timestamp,
+		EventId:   record.EventID,
+		Born:      record.Born,
+		Owner:     record.Owner,
+		Source:    record.Source,
+		Patches:   patches,
+	}
+}
+
+func convHistoryError(err error) error {
+	if errors.Is(err, xerrors.ErrHistoryNotFound) {
+		return terrors.New(int(codes.NotFound), xerrors.ErrHistoryNotFound.Error(), err.Error())
+	}
+	return err
+}
@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	pb "github.com/tkeel-io/core/api/core/v1"
+	"github.com/tkeel-io/core/pkg/history"
+	"github.com/tkeel-io/core/pkg/resource"
+	"github.com/tkeel-io/core/pkg/resource/store"
+	"github.com/tkeel-io/kit/errors"
+)
+
+func TestHistoryService(t *testing.T) {
+	ctx := context.Background()
+	srv := NewHistoryService()
+	_, err := srv.ListHistory(ctx, &pb.ListHistoryRequest{Id: "device123"})
+	assert.NotNil(t, err)
+
+	// history disabled.
+	srv.Init(nil)
+	_, err = srv.ListHistory(ctx, &pb.ListHistoryRequest{Id: "device123"})
+	assert.NotNil(t, err)
+
+	h := history.New(store.NewStore(resource.Metadata{Name: "memory"}), 2, 10)
+	for version, temp := range []string{"20", "30"} {
+		err = h.Append(ctx, &history.Record{
+			EntityID:  "device123",
+			Version:   int64(version + 1),
+			Timestamp: int64(version+1) * 1000,
+			Patches:   []history.Patch{{Op: "replace", Path: "properties.temp", Value: []byte(temp)}},
+		}, []byte(`{"id":"device123","properties":{"temp":`+temp+`}}`), false)
+		assert.Nil(t, err)
+	}
+
+	srv.Init(h)
+	out, err := srv.ListHistory(ctx, &pb.ListHistoryRequest{Id: "device123"})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), out.Total)
+	assert.Equal(t, int64(2), out.Records[0].Version)
+	assert.Equal(t, float64(30), out.Records[0].Patches[0].Value.AsInterface())
+
+	state, err := srv.GetHistoryState(ctx, &pb.GetHistoryStateRequest{Id: "device123", Timestamp: 2500})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), state.Record.Version)
+	assert.Equal(t, float64(30), state.State.AsInterface().(map[string]interface{})["properties"].(map[string]interface{})["temp"])
+
+	_, err = srv.GetHistoryState(ctx, &pb.GetHistoryStateRequest{Id: "device123", Version: 0})
+	assert.NotNil(t, err)
+
+	_, err = srv.GetHistoryState(ctx, &pb.GetHistoryStateRequest{Id: "device123", Timestamp: 10})
+	assert.Equal(t, 404, errors.GRPCToHTTPStatusCode(errors.FromError(err).GRPCStatus().Code()))
+}
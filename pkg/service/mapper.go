@@ -36,7 +36,7 @@ func (s *EntityService) AppendMapper(ctx context.Context, req *pb.AppendMapperRe
 	// append mapper.
 	if err = s.apiManager.AppendMapper(ctx, &mp); nil != err {
 		log.L().Error("append mapper", logf.Eid(req.EntityId), logf.Error(err))
-		return
+		return nil, convError(err)
 	}
 
 	return &pb.AppendMapperResponse{
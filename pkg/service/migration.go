@@ -0,0 +1,122 @@
+package service
+
+import (
+	"github.com/pkg/errors"
+	pb "github.com/tkeel-io/core/api/core/v1"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	logf "github.com/tkeel-io/core/pkg/logfield"
+	"github.com/tkeel-io/core/pkg/migration"
+	terrors "github.com/tkeel-io/kit/errors"
+	"github.com/tkeel-io/kit/log"
+	"go.uber.org/atomic"
+	"google.golang.org/grpc/codes"
+)
+
+type MigrationService struct {
+	pb.UnimplementedMigrationServer
+
+	inited   *atomic.Bool
+	migrator *migration.Migrator
+}
+
+func NewMigrationService() *MigrationService {
+	return &MigrationService{
+		inited: atomic.NewBool(false),
+	}
+}
+
+func (s *MigrationService) Init(migrator *migration.Migrator) {
+	s.migrator = migrator
+	s.inited.Store(true)
+}
+
+// ExportEntities send entities of owner matched by query, with expressions, subscriptions
+// and relationships of the entities, as records in NDJSON.
+func (s *MigrationService) ExportEntities(req *pb.ExportEntitiesRequest, stream pb.Migration_ExportEntitiesServer) error {
+	ctx := stream.Context()
+	if !s.inited.Load() {
+		log.L().Warn("service not ready", logf.Owner(req.Owner))
+		return errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	entity := &Entity{Owner: req.Owner, Source: req.Source}
+	parseHeaderFrom(ctx, entity)
+	if entity.Owner == "" && req.Query == "" && len(req.Condition) == 0 {
+		return terrors.New(int(codes.InvalidArgument), xerrors.ErrInvalidRequest.Error(),
+			"export entities, owner or query required")
+	}
+
+	_, err := s.migrator.Export(ctx, &migration.Filter{
+		Owner:     entity.Owner,
+		Source:    entity.Source,
+		Query:     req.Query,
+		Condition: req.Condition,
+	}, &exportWriter{stream: stream})
+	if nil != err {
+		log.L().Error("export entities", logf.Owner(entity.Owner), logf.Error(err))
+		return errors.Wrap(err, "export entities")
+	}
+	return nil
+}
+
+// ImportEntities recreate resources of records in NDJSON, resources already exist are skipped.
+func (s *MigrationService) ImportEntities(stream pb.Migration_ImportEntitiesServer) error {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready")
+		return errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	stats, err := s.migrator.Import(stream.Context(), &importReader{stream: stream})
+	out := &pb.ImportEntitiesResponse{
+		Entities:      int32(stats.Entities),
+		Expressions:   int32(stats.Expressions),
+		Subscriptions: int32(stats.Subscriptions),
+		Relationships: int32(stats.Relationships),
+		Skipped:       int32(stats.Skipped),
+	}
+	if nil != err {
+		log.L().Error("import entities", logf.Error(err))
+		// records imported before failure responded.
+		stream.SendAndClose(out) //nolint
+		if errors.Is(err, xerrors.ErrInvalidRequest) {
+			return terrors.New(int(codes.InvalidArgument), xerrors.ErrInvalidRequest.Error(), err.Error())
+		}
+		return errors.Wrap(err, "import entities")
+	}
+	return errors.Wrap(stream.SendAndClose(out), "import entities")
+}
+
+// exportWriter send records written by migrator.
+type exportWriter struct {
+	stream pb.Migration_ExportEntitiesServer
+}
+
+func (w *exportWriter) Write(p []byte) (int, error) {
+	record := make([]byte, len(p))
+	copy(record, p)
+	if err := w.stream.Send(&pb.ExportEntitiesResponse{Record: record}); nil != err {
+		return 0, errors.Wrap(err, "send record")
+	}
+	return len(p), nil
+}
+
+// importReader read records received in chunks.
+type importReader struct {
+	stream pb.Migration_ImportEntitiesServer
+	buf    []byte
+}
+
+func (r *importReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		req, err := r.stream.Recv()
+		if nil != err {
+			// io.EOF returned as is at the end of records.
+			return 0, err //nolint
+		}
+		r.buf = req.Records
+	}
+
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
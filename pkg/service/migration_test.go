@@ -0,0 +1,72 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	pb "github.com/tkeel-io/core/api/core/v1"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"github.com/tkeel-io/core/pkg/migration"
+	"google.golang.org/grpc"
+)
+
+type exportStream struct {
+	grpc.ServerStream
+	buf bytes.Buffer
+}
+
+func (x *exportStream) Context() context.Context { return context.Background() }
+
+func (x *exportStream) Send(m *pb.ExportEntitiesResponse) error {
+	x.buf.Write(m.Record)
+	return nil
+}
+
+type importStream struct {
+	grpc.ServerStream
+	chunks []string
+	out    *pb.ImportEntitiesResponse
+}
+
+func (x *importStream) Context() context.Context { return context.Background() }
+
+func (x *importStream) Recv() (*pb.ImportEntitiesRequest, error) {
+	if len(x.chunks) == 0 {
+		return nil, io.EOF
+	}
+	chunk := x.chunks[0]
+	x.chunks = x.chunks[1:]
+	return &pb.ImportEntitiesRequest{Records: []byte(chunk)}, nil
+}
+
+func (x *importStream) SendAndClose(m *pb.ImportEntitiesResponse) error {
+	x.out = m
+	return nil
+}
+
+func TestMigrationService(t *testing.T) {
+	srv := NewMigrationService()
+	err := srv.ImportEntities(&importStream{})
+	assert.ErrorIs(t, err, xerrors.ErrServerNotReady)
+
+	srv.Init(migration.New(nil, nil))
+	export := &exportStream{}
+	err = srv.ExportEntities(&pb.ExportEntitiesRequest{}, export)
+	assert.Contains(t, err.Error(), xerrors.ErrInvalidRequest.Error())
+	assert.Zero(t, export.buf.Len())
+
+	stream := &importStream{chunks: []string{`{"ki`, `nd":`}}
+	err = srv.ImportEntities(stream)
+	assert.Contains(t, err.Error(), xerrors.ErrInvalidRequest.Error())
+	assert.Equal(t, int32(0), stream.out.Entities)
+}
+
+func Test_importReader(t *testing.T) {
+	reader := &importReader{stream: &importStream{chunks: []string{"ab", "", "cde"}}}
+	records, err := io.ReadAll(reader)
+	assert.Nil(t, err)
+	assert.Equal(t, "abcde", string(records))
+}
@@ -2,12 +2,15 @@ package mock
 
 import (
 	"context"
+	"time"
 
 	v1 "github.com/tkeel-io/core/api/core/v1"
 	apim "github.com/tkeel-io/core/pkg/manager"
 	"github.com/tkeel-io/core/pkg/manager/holder"
 	"github.com/tkeel-io/core/pkg/mapper"
 	"github.com/tkeel-io/core/pkg/repository"
+	"github.com/tkeel-io/core/pkg/runtime"
+	"github.com/tkeel-io/tdtl"
 )
 
 type APIManagerMock struct {
@@ -34,6 +37,17 @@ func (m *APIManagerMock) CreateEntity(_ context.Context, in *apim.Base) (*apim.B
 	}, nil
 }
 
+func (m *APIManagerMock) CreateEntities(_ context.Context, ens []*apim.Base) ([]*apim.CreateResult, error) {
+	results := make([]*apim.CreateResult, 0, len(ens))
+	for _, en := range ens {
+		results = append(results, &apim.CreateResult{
+			ID:   en.ID,
+			Base: &apim.BaseRet{ID: en.ID, Type: en.Type, Owner: en.Owner, TemplateID: en.TemplateID},
+		})
+	}
+	return results, nil
+}
+
 // UpdateEntity update entity.
 func (m *APIManagerMock) PatchEntity(_ context.Context, in *apim.Base, _ []*v1.PatchData, _ ...apim.Option) (*apim.BaseRet, []byte, error) {
 	return &apim.BaseRet{
@@ -44,8 +58,19 @@ func (m *APIManagerMock) PatchEntity(_ context.Context, in *apim.Base, _ []*v1.P
 	}, nil, nil
 }
 
+func (m *APIManagerMock) PatchEntities(_ context.Context, items []*apim.PatchItem) ([]*apim.PatchResult, error) {
+	results := make([]*apim.PatchResult, 0, len(items))
+	for _, item := range items {
+		results = append(results, &apim.PatchResult{
+			ID:   item.Base.ID,
+			Base: &apim.BaseRet{ID: item.Base.ID, Type: item.Base.Type},
+		})
+	}
+	return results, nil
+}
+
 // DeleteEntity delete entity.
-func (m *APIManagerMock) DeleteEntity(context.Context, *apim.Base) error {
+func (m *APIManagerMock) DeleteEntity(context.Context, *apim.Base, ...apim.Option) error {
 	return nil
 }
 
@@ -81,10 +106,37 @@ func (m *APIManagerMock) GetExpression(context.Context, repository.Expression) (
 	return nil, nil
 }
 
+func (m *APIManagerMock) SetExpressionPolicy(ctx context.Context, expr repository.Expression, policy *repository.ExpressionPolicy) (*repository.Expression, error) {
+	expr.Policy = policy
+	return &expr, nil
+}
+
 func (m *APIManagerMock) ListExpression(context.Context, *apim.Base) ([]*repository.Expression, error) {
 	return nil, nil
 }
 
+func (m *APIManagerMock) DryRunExpression(ctx context.Context, req *apim.DryRunReq) ([]*apim.DryRunResult, error) {
+	results := make([]*apim.DryRunResult, 0, len(req.Expressions))
+	for _, expr := range req.Expressions {
+		expr.EntityID, expr.Type = req.EntityID, repository.ExprTypeEval
+		results = append(results, &apim.DryRunResult{
+			Expression: expr,
+			Sources:    map[string][]string{"device234": {"device234.properties.temp"}},
+			Inputs:     map[string]tdtl.Node{"device234.properties.temp": tdtl.IntNode(20)},
+			Result:     tdtl.FloatNode(68),
+			Written:    true,
+		})
+	}
+	return results, nil
+}
+
+func (m *APIManagerMock) ExpressionGraph(ctx context.Context, entityID string) ([]runtime.Dependency, error) {
+	return []runtime.Dependency{
+		{ExpressionID: "expr1", EntityID: "device123",
+			Source: "device234.properties.temp", Target: "device123.properties.temp_f"},
+	}, nil
+}
+
 func (m *APIManagerMock) CreateSubscription(context.Context, *repository.Subscription) error {
 	return nil
 }
@@ -96,3 +148,72 @@ func (m *APIManagerMock) DeleteSubscription(context.Context, *repository.Subscri
 func (m *APIManagerMock) GetSubscription(context.Context, *repository.Subscription) (*repository.Subscription, error) {
 	return nil, nil
 }
+
+func (m *APIManagerMock) ListDeadLetter(ctx context.Context, entityID string) ([]*repository.DeadLetter, error) {
+	return []*repository.DeadLetter{{ID: "dl-1", EntityID: "device123", Handler: "handlePersistent"}}, nil
+}
+
+func (m *APIManagerMock) GetDeadLetter(ctx context.Context, id string) (*repository.DeadLetter, error) {
+	return &repository.DeadLetter{ID: id, EntityID: "device123", Handler: "handlePersistent"}, nil
+}
+
+func (m *APIManagerMock) ReplayDeadLetter(ctx context.Context, id string) error {
+	return nil
+}
+
+func (m *APIManagerMock) RemoveDeadLetter(ctx context.Context, id string) error {
+	return nil
+}
+
+func (m *APIManagerMock) PurgeDeadLetter(ctx context.Context, entityID string) (int, error) {
+	return 1, nil
+}
+
+func (m *APIManagerMock) CreateSchedule(ctx context.Context, schedule *repository.Schedule) (*repository.Schedule, error) {
+	if schedule.ID == "" {
+		schedule.ID = "sch-1"
+	}
+	return schedule, nil
+}
+
+func (m *APIManagerMock) ListSchedule(ctx context.Context, entityID string) ([]*repository.Schedule, error) {
+	return []*repository.Schedule{{ID: "sch-1", EntityID: entityID, NextTime: 1650000000000}}, nil
+}
+
+func (m *APIManagerMock) GetSchedule(ctx context.Context, entityID, id string) (*repository.Schedule, error) {
+	return &repository.Schedule{ID: id, EntityID: entityID, NextTime: 1650000000000}, nil
+}
+
+func (m *APIManagerMock) CancelSchedule(ctx context.Context, entityID, id string) error {
+	return nil
+}
+
+func (m *APIManagerMock) ExpireEntity(ctx context.Context, en *apim.Base, ttl time.Duration) (apim.Revert, error) {
+	return func(context.Context) error { return nil }, nil
+}
+
+func (m *APIManagerMock) ExpireProperty(ctx context.Context, en *apim.Base, path string, ttl time.Duration) (apim.Revert, error) {
+	return func(context.Context) error { return nil }, nil
+}
+
+func (m *APIManagerMock) CreateRelationship(ctx context.Context, rel *repository.Relationship) (*repository.Relationship, error) {
+	return rel, nil
+}
+
+func (m *APIManagerMock) DeleteRelationship(ctx context.Context, rel *repository.Relationship) error {
+	return nil
+}
+
+func (m *APIManagerMock) ListRelationship(ctx context.Context, req *repository.ListRelationshipReq) ([]*repository.Relationship, error) {
+	if req.Reverse {
+		return []*repository.Relationship{{Type: "contains", From: "site123", To: req.EntityID}}, nil
+	}
+	return []*repository.Relationship{{Type: "contains", From: req.EntityID, To: "device234"}}, nil
+}
+
+func (m *APIManagerMock) TraverseRelationship(ctx context.Context, req *apim.TraverseReq) ([]*apim.Hop, error) {
+	return []*apim.Hop{
+		{Depth: 1, Relationship: &repository.Relationship{Type: "contains", From: req.EntityID, To: "gateway123"}},
+		{Depth: 2, Relationship: &repository.Relationship{Type: "contains", From: "gateway123", To: "device234"}},
+	}, nil
+}
@@ -0,0 +1,179 @@
+package service
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	pb "github.com/tkeel-io/core/api/core/v1"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	logf "github.com/tkeel-io/core/pkg/logfield"
+	apim "github.com/tkeel-io/core/pkg/manager"
+	"github.com/tkeel-io/core/pkg/repository"
+	terrors "github.com/tkeel-io/kit/errors"
+	"github.com/tkeel-io/kit/log"
+	"go.uber.org/atomic"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	DirectionOut = "out"
+	DirectionIn  = "in"
+)
+
+type RelationshipService struct {
+	pb.UnimplementedRelationshipServer
+
+	inited     *atomic.Bool
+	apiManager apim.APIManager
+}
+
+func NewRelationshipService() *RelationshipService {
+	return &RelationshipService{
+		inited: atomic.NewBool(false),
+	}
+}
+
+func (s *RelationshipService) Init(apiManager apim.APIManager) {
+	s.apiManager = apiManager
+	s.inited.Store(true)
+}
+
+// CreateRelationship create edge from entity to target entity, replaced if exists.
+func (s *RelationshipService) CreateRelationship(ctx context.Context, req *pb.CreateRelationshipRequest) (*pb.RelationshipObject, error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready", logf.Eid(req.EntityId))
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	base := &apim.Base{ID: req.EntityId, Owner: req.Owner, Source: req.Source}
+	parseHeaderFrom(ctx, base)
+	rel, err := s.apiManager.CreateRelationship(ctx, &repository.Relationship{
+		Type:        req.Type,
+		From:        req.EntityId,
+		To:          req.To,
+		Owner:       base.Owner,
+		Description: req.Description,
+		Cascade:     req.Cascade,
+	})
+	if nil != err {
+		log.L().Error("create relationship", logf.Eid(req.EntityId), logf.Target(req.To), logf.Error(err))
+		return nil, convRelationshipError(errors.Wrap(err, "create relationship"))
+	}
+	return makeRelationship(rel, 0), nil
+}
+
+func (s *RelationshipService) DeleteRelationship(ctx context.Context, req *pb.RelationshipRequest) (*pb.RelationshipObject, error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready", logf.Eid(req.EntityId))
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	base := &apim.Base{ID: req.EntityId, Owner: req.Owner}
+	parseHeaderFrom(ctx, base)
+	rel := &repository.Relationship{Type: req.Type, From: req.EntityId, To: req.To, Owner: base.Owner}
+	if err := s.apiManager.DeleteRelationship(ctx, rel); nil != err {
+		log.L().Error("delete relationship", logf.Eid(req.EntityId), logf.Target(req.To), logf.Error(err))
+		return nil, convRelationshipError(errors.Wrap(err, "delete relationship"))
+	}
+	return makeRelationship(rel, 0), nil
+}
+
+// ListRelationships list edges sourced from entity, or targeting entity if direction is in.
+func (s *RelationshipService) ListRelationships(ctx context.Context, req *pb.ListRelationshipsRequest) (*pb.ListRelationshipsResponse, error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready", logf.Eid(req.EntityId))
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	var reverse bool
+	switch req.Direction {
+	case "", DirectionOut:
+	case DirectionIn:
+		reverse = true
+	default:
+		return nil, convRelationshipError(errors.Wrapf(xerrors.ErrRelationshipInvalid, "direction %s", req.Direction))
+	}
+
+	base := &apim.Base{ID: req.EntityId, Owner: req.Owner}
+	parseHeaderFrom(ctx, base)
+	rels, err := s.apiManager.ListRelationship(ctx, &repository.ListRelationshipReq{
+		EntityID: req.EntityId, Owner: base.Owner, Type: req.Type, Reverse: reverse})
+	if nil != err {
+		log.L().Error("list relationships", logf.Eid(req.EntityId), logf.Error(err))
+		return nil, errors.Wrap(err, "list relationships")
+	}
+
+	out := &pb.ListRelationshipsResponse{
+		Total:         int64(len(rels)),
+		Relationships: make([]*pb.RelationshipObject, 0, len(rels)),
+	}
+	for _, rel := range rels {
+		out.Relationships = append(out.Relationships, makeRelationship(rel, 0))
+	}
+	return out, nil
+}
+
+// ListChildren returns edges reached from entity along edges within depth hops.
+func (s *RelationshipService) ListChildren(ctx context.Context, req *pb.TraverseRelationshipsRequest) (*pb.ListRelationshipsResponse, error) {
+	return s.traverse(ctx, req, false)
+}
+
+// ListParents returns edges reached from entity against edges within depth hops.
+func (s *RelationshipService) ListParents(ctx context.Context, req *pb.TraverseRelationshipsRequest) (*pb.ListRelationshipsResponse, error) {
+	return s.traverse(ctx, req, true)
+}
+
+func (s *RelationshipService) traverse(ctx context.Context, req *pb.TraverseRelationshipsRequest, reverse bool) (*pb.ListRelationshipsResponse, error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready", logf.Eid(req.EntityId))
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	base := &apim.Base{ID: req.EntityId, Owner: req.Owner}
+	parseHeaderFrom(ctx, base)
+	hops, err := s.apiManager.TraverseRelationship(ctx, &apim.TraverseReq{
+		EntityID: req.EntityId,
+		Owner:    base.Owner,
+		Type:     req.Type,
+		Reverse:  reverse,
+		Depth:    int(req.Depth),
+	})
+	if nil != err {
+		log.L().Error("traverse relationships", logf.Eid(req.EntityId), logf.Error(err))
+		return nil, errors.Wrap(err, "traverse relationships")
+	}
+
+	out := &pb.ListRelationshipsResponse{
+		Total:         int64(len(hops)),
+		Relationships: make([]*pb.RelationshipObject, 0, len(hops)),
+	}
+	for _, hop := range hops {
+		out.Relationships = append(out.Relationships, makeRelationship(hop.Relationship, hop.Depth))
+	}
+	return out, nil
+}
+
+func makeRelationship(rel *repository.Relationship, depth int) *pb.RelationshipObject {
+	return &pb.RelationshipObject{
+		Type:        rel.Type,
+		From:        rel.From,
+		To:          rel.To,
+		Owner:       rel.Owner,
+		Description: rel.Description,
+		Cascade:     rel.Cascade,
+		CreatedAt:   rel.CreatedAt,
+		Depth:       int32(depth),
+	}
+}
+
+func convRelationshipError(err error) error {
+	switch {
+	case errors.Is(err, xerrors.ErrResourceNotFound):
+		return terrors.New(int(codes.NotFound), xerrors.ErrResourceNotFound.Error(), err.Error())
+	case errors.Is(err, xerrors.ErrEntityNotFound):
+		return terrors.New(int(codes.NotFound), xerrors.ErrEntityNotFound.Error(), err.Error())
+	case errors.Is(err, xerrors.ErrRelationshipInvalid):
+		return terrors.New(int(codes.InvalidArgument), xerrors.ErrRelationshipInvalid.Error(), err.Error())
+	}
+	return err
+}
@@ -0,0 +1,38 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	pb "github.com/tkeel-io/core/api/core/v1"
+	terrors "github.com/tkeel-io/kit/errors"
+)
+
+func TestRelationshipService(t *testing.T) {
+	ctx := context.Background()
+	srv := NewRelationshipService()
+	_, err := srv.ListRelationships(ctx, &pb.ListRelationshipsRequest{EntityId: "device123"})
+	assert.NotNil(t, err)
+
+	srv.Init(apiManager)
+	rel, err := srv.CreateRelationship(ctx, &pb.CreateRelationshipRequest{
+		EntityId: "gateway123", Type: "contains", To: "device123", Owner: "admin"})
+	assert.Nil(t, err)
+	assert.Equal(t, "gateway123", rel.From)
+	assert.Equal(t, "admin", rel.Owner)
+
+	list, err := srv.ListRelationships(ctx, &pb.ListRelationshipsRequest{EntityId: "device123", Direction: DirectionIn})
+	assert.Nil(t, err)
+	assert.Equal(t, "device123", list.Relationships[0].To)
+	_, err = srv.ListRelationships(ctx, &pb.ListRelationshipsRequest{EntityId: "device123", Direction: "up"})
+	assert.Equal(t, 400, terrors.GRPCToHTTPStatusCode(terrors.FromError(err).GRPCStatus().Code()))
+
+	list, err = srv.ListChildren(ctx, &pb.TraverseRelationshipsRequest{EntityId: "site123", Depth: 2})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(2), list.Total)
+	assert.Equal(t, int32(2), list.Relationships[1].Depth)
+
+	_, err = srv.DeleteRelationship(ctx, &pb.RelationshipRequest{EntityId: "gateway123", Type: "contains", To: "device123"})
+	assert.Nil(t, err)
+}
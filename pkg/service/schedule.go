@@ -0,0 +1,187 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	pb "github.com/tkeel-io/core/api/core/v1"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	logf "github.com/tkeel-io/core/pkg/logfield"
+	apim "github.com/tkeel-io/core/pkg/manager"
+	"github.com/tkeel-io/core/pkg/repository"
+	terrors "github.com/tkeel-io/kit/errors"
+	"github.com/tkeel-io/kit/log"
+	"go.uber.org/atomic"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+type ScheduleService struct {
+	pb.UnimplementedScheduleServer
+
+	inited     *atomic.Bool
+	apiManager apim.APIManager
+}
+
+func NewScheduleService() *ScheduleService {
+	return &ScheduleService{
+		inited: atomic.NewBool(false),
+	}
+}
+
+func (s *ScheduleService) Init(apiManager apim.APIManager) {
+	s.apiManager = apiManager
+	s.inited.Store(true)
+}
+
+// CreateSchedule schedule property patches of entity, applied once at due time or
+// after delay, or applied every time cron matched.
+func (s *ScheduleService) CreateSchedule(ctx context.Context, req *pb.CreateScheduleRequest) (*pb.ScheduleObject, error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready", logf.Eid(req.EntityId))
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	base := &apim.Base{ID: req.EntityId, Owner: req.Owner, Source: req.Source}
+	parseHeaderFrom(ctx, base)
+	schedule := &repository.Schedule{
+		ID:          req.Id,
+		EntityID:    req.EntityId,
+		Owner:       base.Owner,
+		Source:      base.Source,
+		Description: req.Description,
+		Cron:        strings.TrimSpace(req.Cron),
+		Timezone:    req.Timezone,
+	}
+
+	switch {
+	case schedule.Cron != "" && (req.DueTime > 0 || req.Delay > 0):
+		return nil, convScheduleError(errors.Wrap(xerrors.ErrScheduleInvalid, "cron conflicts with due time"))
+	case schedule.Cron != "":
+	case req.DueTime > 0:
+		schedule.NextTime = req.DueTime
+	case req.Delay > 0:
+		schedule.NextTime = time.Now().Add(time.Duration(req.Delay) * time.Second).UnixMilli()
+	default:
+		return nil, convScheduleError(errors.Wrap(xerrors.ErrScheduleInvalid, "due time lack"))
+	}
+
+	patches, err := parsePropsPatches(req.Properties.AsInterface())
+	if nil != err {
+		log.L().Error("create schedule", logf.Eid(req.EntityId), logf.Error(err))
+		return nil, convScheduleError(errors.Wrap(err, "create schedule"))
+	}
+	for _, patch := range patches {
+		schedule.Patches = append(schedule.Patches, &repository.SchedulePatch{
+			Path:     patch.Path,
+			Operator: patch.Operator,
+			Value:    patch.Value,
+			From:     patch.From,
+		})
+	}
+
+	if schedule, err = s.apiManager.CreateSchedule(ctx, schedule); nil != err {
+		log.L().Error("create schedule", logf.Eid(req.EntityId), logf.Error(err))
+		return nil, convScheduleError(errors.Wrap(err, "create schedule"))
+	}
+	return makeSchedule(schedule), nil
+}
+
+func (s *ScheduleService) ListSchedules(ctx context.Context, req *pb.ListSchedulesRequest) (*pb.ListSchedulesResponse, error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready", logf.Eid(req.EntityId))
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	schedules, err := s.apiManager.ListSchedule(ctx, req.EntityId)
+	if nil != err {
+		log.L().Error("list schedules", logf.Eid(req.EntityId), logf.Error(err))
+		return nil, errors.Wrap(err, "list schedules")
+	}
+
+	out := &pb.ListSchedulesResponse{
+		Total:     int64(len(schedules)),
+		Schedules: make([]*pb.ScheduleObject, 0, len(schedules)),
+	}
+	for _, schedule := range schedules {
+		out.Schedules = append(out.Schedules, makeSchedule(schedule))
+	}
+	return out, nil
+}
+
+func (s *ScheduleService) GetSchedule(ctx context.Context, req *pb.ScheduleRequest) (*pb.ScheduleObject, error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready", logf.Eid(req.EntityId))
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	schedule, err := s.apiManager.GetSchedule(ctx, req.EntityId, req.Id)
+	if nil != err {
+		log.L().Error("get schedule", logf.Eid(req.EntityId), logf.ID(req.Id), logf.Error(err))
+		return nil, convScheduleError(errors.Wrap(err, "get schedule"))
+	}
+	return makeSchedule(schedule), nil
+}
+
+// CancelSchedule remove schedule, patches of the schedule never applied.
+func (s *ScheduleService) CancelSchedule(ctx context.Context, req *pb.ScheduleRequest) (*pb.ScheduleObject, error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready", logf.Eid(req.EntityId))
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	}
+
+	if err := s.apiManager.CancelSchedule(ctx, req.EntityId, req.Id); nil != err {
+		log.L().Error("cancel schedule", logf.Eid(req.EntityId), logf.ID(req.Id), logf.Error(err))
+		return nil, convScheduleError(errors.Wrap(err, "cancel schedule"))
+	}
+	return &pb.ScheduleObject{Id: req.Id, EntityId: req.EntityId}, nil
+}
+
+func makeSchedule(schedule *repository.Schedule) *pb.ScheduleObject {
+	out := &pb.ScheduleObject{
+		Id:          schedule.ID,
+		EntityId:    schedule.EntityID,
+		Owner:       schedule.Owner,
+		Source:      schedule.Source,
+		Description: schedule.Description,
+		Action:      schedule.Action,
+		Cron:        schedule.Cron,
+		Timezone:    schedule.Timezone,
+		NextTime:    schedule.NextTime,
+		CreatedAt:   schedule.CreatedAt,
+	}
+
+	for _, patch := range schedule.Patches {
+		var value interface{}
+		if err := json.Unmarshal(patch.Value, &value); nil != err {
+			value = string(patch.Value)
+		}
+		// values decoded from json always convertible.
+		val, _ := structpb.NewValue(value)
+		property := &pb.SchedulePatch{
+			Path:     strings.TrimPrefix(patch.Path, propKey("")),
+			Operator: patch.Operator,
+			Value:    val,
+		}
+		if patch.From != "" {
+			property.From = strings.TrimPrefix(patch.From, propKey(""))
+		}
+		out.Properties = append(out.Properties, property)
+	}
+	return out
+}
+
+func convScheduleError(err error) error {
+	switch {
+	case errors.Is(err, xerrors.ErrResourceNotFound):
+		return terrors.New(int(codes.NotFound), xerrors.ErrResourceNotFound.Error(), err.Error())
+	case errors.Is(err, xerrors.ErrScheduleInvalid),
+		errors.Is(err, xerrors.ErrInvalidRequest),
+		errors.Is(err, xerrors.ErrPatchPathInvalid),
+		errors.Is(err, xerrors.ErrJSONPatchReservedOp):
+		return terrors.New(int(codes.InvalidArgument), xerrors.ErrScheduleInvalid.Error(), err.Error())
+	}
+	return err
+}
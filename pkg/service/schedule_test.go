@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	pb "github.com/tkeel-io/core/api/core/v1"
+	"github.com/tkeel-io/core/pkg/repository"
+	terrors "github.com/tkeel-io/kit/errors"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestScheduleService(t *testing.T) {
+	ctx := context.Background()
+	srv := NewScheduleService()
+	_, err := srv.ListSchedules(ctx, &pb.ListSchedulesRequest{EntityId: "device123"})
+	assert.NotNil(t, err)
+
+	srv.Init(apiManager)
+	properties, err := structpb.NewValue([]interface{}{map[string]interface{}{"path": "mode", "operator": "replace", "value": "eco"}})
+	assert.Nil(t, err)
+	invalidPatches, err := structpb.NewValue([]interface{}{map[string]interface{}{"path": "", "operator": "replace"}})
+	assert.Nil(t, err)
+	schedule, err := srv.CreateSchedule(ctx, &pb.CreateScheduleRequest{
+		EntityId: "device123", Delay: 600, Properties: properties})
+	assert.Nil(t, err)
+	assert.Equal(t, "sch-1", schedule.Id)
+	assert.InDelta(t, time.Now().Add(10*time.Minute).UnixMilli(), schedule.NextTime, 1000)
+	assert.Equal(t, "mode", schedule.Properties[0].Path)
+	assert.Equal(t, "eco", schedule.Properties[0].Value.AsInterface())
+
+	// due time lack, cron conflicts with due time, invalid patches.
+	invalids := []*pb.CreateScheduleRequest{
+		{EntityId: "device123", Properties: properties},
+		{EntityId: "device123", Cron: "0 22 * * *", DueTime: 1650000000000, Properties: properties},
+		{EntityId: "device123", Delay: 600, Properties: invalidPatches},
+	}
+	for _, req := range invalids {
+		_, err = srv.CreateSchedule(ctx, req)
+		assert.Equal(t, 400, terrors.GRPCToHTTPStatusCode(terrors.FromError(err).GRPCStatus().Code()))
+	}
+
+	list, err := srv.ListSchedules(ctx, &pb.ListSchedulesRequest{EntityId: "device123"})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(1), list.Total)
+
+	_, err = srv.GetSchedule(ctx, &pb.ScheduleRequest{EntityId: "device123", Id: "sch-1"})
+	assert.Nil(t, err)
+	_, err = srv.CancelSchedule(ctx, &pb.ScheduleRequest{EntityId: "device123", Id: "sch-1"})
+	assert.Nil(t, err)
+}
+
+func Test_makeSchedule(t *testing.T) {
+	out := makeSchedule(&repository.Schedule{
+		ID:       "sch-1",
+		EntityID: "device123",
+		Cron:     "0 22 * * *",
+		Patches: []*repository.SchedulePatch{
+			{Path: "properties.temp", Operator: "replace", Value: []byte(`21.5`)},
+			{Path: "properties.raw", Operator: "replace", Value: []byte(`invalid`)},
+		},
+	})
+	assert.Equal(t, "0 22 * * *", out.Cron)
+	assert.Equal(t, 21.5, out.Properties[0].Value.AsInterface())
+	assert.Equal(t, "invalid", out.Properties[1].Value.AsInterface())
+}
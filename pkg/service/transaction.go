@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	pb "github.com/tkeel-io/core/api/core/v1"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	logf "github.com/tkeel-io/core/pkg/logfield"
+	apim "github.com/tkeel-io/core/pkg/manager"
+	"github.com/tkeel-io/kit/log"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const (
+	TxStatusCommitted = "committed"
+	TxStatusAborted   = "aborted"
+	TxStatusFailed    = "failed"
+)
+
+// PatchEntities patch properties of entities in a transaction.
+func (s *EntityService) PatchEntities(ctx context.Context, req *pb.PatchEntitiesRequest) (out *pb.PatchEntitiesResponse, err error) {
+	if !s.inited.Load() {
+		log.L().Warn("service not ready")
+		return nil, errors.Wrap(xerrors.ErrServerNotReady, "service not ready")
+	} else if len(req.Entities) == 0 {
+		return nil, errors.Wrap(xerrors.ErrInvalidRequest, "patch entities, empty entities")
+	}
+
+	items := make([]*apim.PatchItem, 0, len(req.Entities))
+	for _, item := range req.Entities {
+		entity := new(Entity)
+		entity.ID = item.Id
+		entity.Type = item.Type
+		entity.Owner = item.Owner
+		entity.Source = item.Source
+		parseHeaderFrom(ctx, entity)
+
+		patches, err := parsePropsPatches(item.Properties.AsInterface())
+		if nil != err {
+			log.L().Error("patch entities", logf.Eid(item.Id), logf.Error(err))
+			return nil, errors.Wrap(err, "patch entities")
+		}
+
+		var opts []apim.Option
+		if nil != item.IfVersion {
+			opts = append(opts, apim.NewIfVersionOption(*item.IfVersion))
+		}
+
+		items = append(items, &apim.PatchItem{Base: entity, Patches: patches, Options: opts})
+	}
+
+	results, err := s.apiManager.PatchEntities(ctx, items)
+	if nil == results {
+		log.L().Error("patch entities", logf.Error(err))
+		return nil, errors.Wrap(err, "patch entities")
+	}
+
+	out = &pb.PatchEntitiesResponse{Committed: nil == err}
+	for _, ret := range results {
+		item := &pb.PatchEntitiesResult{Id: ret.ID, Status: TxStatusCommitted}
+		switch {
+		case errors.Is(ret.Err, xerrors.ErrTransactionAborted):
+			item.Status = TxStatusAborted
+			item.Error = ret.Err.Error()
+		case nil != ret.Err:
+			item.Status = TxStatusFailed
+			item.Error = ret.Err.Error()
+		case nil != ret.Base:
+			item.Version = ret.Base.Version
+			props, perr := structpb.NewValue(ret.Base.Properties)
+			if nil != perr {
+				log.L().Error("patch entities", logf.Eid(ret.ID), logf.Error(perr))
+				return nil, errors.Wrap(perr, "patch entities, convert properties")
+			}
+			item.Properties = props
+		}
+		out.Entities = append(out.Entities, item)
+	}
+
+	return out, errors.Wrap(convError(err), "patch entities")
+}
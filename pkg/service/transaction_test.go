@@ -0,0 +1,51 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	pb "github.com/tkeel-io/core/api/core/v1"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func Test_PatchEntities(t *testing.T) {
+	version := int64(3)
+	patches1, err := structpb.NewValue([]interface{}{
+		map[string]interface{}{"path": "devices.device123", "operator": "remove"},
+	})
+	assert.Nil(t, err)
+	patches2, err := structpb.NewValue([]interface{}{
+		map[string]interface{}{"path": "devices.device123", "operator": "replace", "value": true},
+	})
+	assert.Nil(t, err)
+	out, err := entityService.PatchEntities(context.Background(), &pb.PatchEntitiesRequest{
+		Entities: []*pb.PatchEntitiesItem{{
+			Id:         "group-1",
+			Owner:      "admin",
+			IfVersion:  &version,
+			Properties: patches1,
+		}, {
+			Id:         "group-2",
+			Owner:      "admin",
+			Properties: patches2,
+		}},
+	})
+	assert.Nil(t, err)
+	assert.True(t, out.Committed)
+	assert.Len(t, out.Entities, 2)
+	assert.Equal(t, TxStatusCommitted, out.Entities[0].Status)
+
+	merge, err := structpb.NewValue([]interface{}{
+		map[string]interface{}{"path": "devices", "operator": "merge"},
+	})
+	assert.Nil(t, err)
+	_, err = entityService.PatchEntities(context.Background(), &pb.PatchEntitiesRequest{
+		Entities: []*pb.PatchEntitiesItem{{
+			Id:         "group-1",
+			Properties: merge,
+		}},
+	})
+	assert.ErrorIs(t, err, xerrors.ErrJSONPatchReservedOp)
+}
@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+	logf "github.com/tkeel-io/core/pkg/logfield"
+	apim "github.com/tkeel-io/core/pkg/manager"
+	"github.com/tkeel-io/kit/log"
+)
+
+// parseTTLFrom parse entity ttl from TTL header, zero if absent.
+func parseTTLFrom(ctx context.Context) (time.Duration, error) {
+	header, ok := ctx.Value(struct{}{}).(http.Header)
+	if !ok || header.Get(HeaderTTL) == "" {
+		return 0, nil
+	}
+
+	ttl, err := parseTTL(header.Get(HeaderTTL))
+	return ttl, errors.Wrap(err, "parse TTL header")
+}
+
+// parsePropsTTL parse ttl of property patches, keyed by property path.
+func parsePropsTTL(params interface{}) (map[string]time.Duration, error) {
+	patchData := make([]PatchData, 0)
+	data, err := json.Marshal(params)
+	if nil != err {
+		return nil, errors.Wrap(err, "json marshal patch data")
+	} else if err = json.Unmarshal(data, &patchData); nil != err {
+		return nil, errors.Wrap(err, "json unmarshal patch data")
+	}
+
+	ttls := make(map[string]time.Duration)
+	for index := range patchData {
+		var ttl time.Duration
+		switch value := patchData[index].TTL.(type) {
+		case nil:
+			continue
+		case float64:
+			ttl, err = parseTTL(strconv.FormatFloat(value, 'f', -1, 64))
+		case string:
+			ttl, err = parseTTL(value)
+		default:
+			err = xerrors.ErrInvalidRequest
+		}
+
+		if nil != err {
+			return nil, errors.Wrapf(err, "parse ttl of %s", patchData[index].Path)
+		}
+		ttls[propKey(patchData[index].Path)] = ttl
+	}
+	return ttls, nil
+}
+
+// parseTTL parse ttl in seconds or duration like "10m".
+func parseTTL(value string) (time.Duration, error) {
+	value = strings.TrimSpace(value)
+	ttl, err := time.ParseDuration(value)
+	if nil != err {
+		seconds, innerErr := strconv.ParseFloat(value, 64)
+		if nil != innerErr {
+			return 0, errors.Wrap(xerrors.ErrInvalidRequest, err.Error())
+		}
+		ttl = time.Duration(seconds * float64(time.Second))
+	}
+
+	if ttl <= 0 {
+		return 0, errors.Wrap(xerrors.ErrInvalidRequest, "ttl must be positive")
+	}
+	return ttl, nil
+}
+
+// expire schedule expiry of entity and property paths before the request dispatched, so that
+// the request never applied without its ttl, reverts undo the expiry if the request failed.
+func (s *EntityService) expire(ctx context.Context, entity *Entity, ttl time.Duration, props map[string]time.Duration) ([]apim.Revert, error) {
+	var reverts []apim.Revert
+	if ttl > 0 {
+		revert, err := s.apiManager.ExpireEntity(ctx, entity, ttl)
+		if nil != err {
+			log.L().Error("expire entity", logf.Eid(entity.ID), logf.Error(err))
+			return nil, errors.Wrap(err, "expire entity")
+		}
+		reverts = append(reverts, revert)
+	}
+
+	for path, ttl := range props {
+		revert, err := s.apiManager.ExpireProperty(ctx, entity, path, ttl)
+		if nil != err {
+			log.L().Error("expire entity property", logf.Eid(entity.ID), logf.Path(path), logf.Error(err))
+			s.revertExpire(ctx, entity, reverts)
+			return nil, errors.Wrap(err, "expire entity property")
+		}
+		reverts = append(reverts, revert)
+	}
+	return reverts, nil
+}
+
+// revertExpire restore ttl replaced by the request failed, failures logged only.
+func (s *EntityService) revertExpire(ctx context.Context, entity *Entity, reverts []apim.Revert) {
+	for _, revert := range reverts {
+		if err := revert(ctx); nil != err {
+			log.L().Error("revert entity ttl", logf.Eid(entity.ID), logf.Error(err))
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	xerrors "github.com/tkeel-io/core/pkg/errors"
+)
+
+func Test_parseTTL(t *testing.T) {
+	tests := []struct {
+		value string
+		ttl   time.Duration
+		err   error
+	}{
+		{"60", time.Minute, nil},
+		{"1.5", 1500 * time.Millisecond, nil},
+		{"10m", 10 * time.Minute, nil},
+		{"0", 0, xerrors.ErrInvalidRequest},
+		{"-1", 0, xerrors.ErrInvalidRequest},
+		{"forever", 0, xerrors.ErrInvalidRequest},
+	}
+
+	for _, test := range tests {
+		t.Run(test.value, func(t *testing.T) {
+			ttl, err := parseTTL(test.value)
+			assert.Equal(t, test.ttl, ttl)
+			assert.ErrorIs(t, err, test.err)
+		})
+	}
+}
+
+func Test_parseTTLFrom(t *testing.T) {
+	ttl, err := parseTTLFrom(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, time.Duration(0), ttl)
+
+	header := http.Header{}
+	header.Set(HeaderTTL, "30s")
+	ttl, err = parseTTLFrom(context.WithValue(context.Background(), struct{}{}, header))
+	assert.Nil(t, err)
+	assert.Equal(t, 30*time.Second, ttl)
+}
+
+func Test_parsePropsTTL(t *testing.T) {
+	ttls, err := parsePropsTTL([]interface{}{
+		map[string]interface{}{"path": "temp", "operator": "replace", "value": 20},
+		map[string]interface{}{"path": "alarm", "operator": "replace", "value": true, "ttl": 60},
+		map[string]interface{}{"path": "session", "operator": "replace", "value": "s1", "ttl": "1h"},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]time.Duration{
+		"properties.alarm":   time.Minute,
+		"properties.session": time.Hour,
+	}, ttls)
+
+	_, err = parsePropsTTL([]interface{}{
+		map[string]interface{}{"path": "alarm", "operator": "replace", "ttl": true},
+	})
+	assert.ErrorIs(t, err, xerrors.ErrInvalidRequest)
+}
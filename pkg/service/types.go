@@ -29,6 +29,9 @@ const (
 	HeaderType        = "Type"
 	HeaderMetadata    = "Metadata"
 	HeaderContentType = "Content-Type"
+	HeaderIfMatch     = "If-Match"
+	HeaderIdempotency = "Idempotency-Key"
+	HeaderTTL         = "TTL"
 	QueryType         = "type"
 
 	Plugin = "plugin"
@@ -56,4 +59,8 @@ type PatchData struct {
 	Path     string
 	Operator string
 	Value    interface{}
+	// From is the source path of move and copy operations.
+	From string
+	// TTL of the property path, seconds or duration like "10m".
+	TTL interface{}
 }
@@ -23,7 +23,7 @@ import (
 type PatchOp int
 
 // reference: https://datatracker.ietf.org/doc/html/rfc6902 .
-// implement [ add, remove, replace ], reversed [ copy, move, test ].
+// implement [ add, remove, replace, copy, move, test ], move and test are reversed for configs.
 const (
 	OpUndef PatchOp = iota
 	OpAdd
@@ -114,6 +114,19 @@ type KafkaReceiver interface { //nolint
 	HandleMessage(context.Context, *sarama.ConsumerMessage) error
 }
 
+// AsyncReceiver implemented by receivers which handle messages asynchronously,
+// done called after message handled, with the offset before which all messages
+// of the partition handled, messages marked only after handled.
+type AsyncReceiver interface {
+	DeliverMessage(ctx context.Context, msg *sarama.ConsumerMessage, done func(offset int64)) error
+}
+
+// OffsetProvider implemented by receivers which manage offsets themselves,
+// consuming resumes after the provided offset for each claimed partition.
+type OffsetProvider interface {
+	Offsets(topic string) map[int32]int64
+}
+
 func (k *Pubsub) Received(ctx context.Context, receiver KafkaReceiver) error {
 	c, err := sarama.NewConsumerGroupFromClient(k.kafkaMetadata.Group, k.kafkaClient)
 	if nil != err {
@@ -181,7 +194,12 @@ func (consumer *kafkaConsumer) ConsumeClaim(session sarama.ConsumerGroupSession,
 			var innerErr error
 			log.L().Debug("processing kafka message", logf.Topic(msg.Topic),
 				logf.Partition(msg.Partition), logf.Offset(msg.Offset), logf.Key(string(msg.Key)))
-			if innerErr = consumer.receiver.HandleMessage(session.Context(), msg); innerErr == nil {
+			if receiver, ok := consumer.receiver.(AsyncReceiver); ok {
+				topic, partition := msg.Topic, msg.Partition
+				innerErr = receiver.DeliverMessage(session.Context(), msg, func(offset int64) {
+					session.MarkOffset(topic, partition, offset+1, "")
+				})
+			} else if innerErr = consumer.receiver.HandleMessage(session.Context(), msg); innerErr == nil {
 				session.MarkMessage(msg, "")
 			}
 			log.L().Debug("processing kafka message", logf.Topic(msg.Topic),
@@ -207,6 +225,21 @@ func (consumer *kafkaConsumer) Cleanup(sarama.ConsumerGroupSession) error {
 	return nil
 }
 
-func (consumer *kafkaConsumer) Setup(sarama.ConsumerGroupSession) error {
+func (consumer *kafkaConsumer) Setup(session sarama.ConsumerGroupSession) error {
+	provider, ok := consumer.receiver.(OffsetProvider)
+	if !ok {
+		return nil
+	}
+
+	for topic, partitions := range session.Claims() {
+		offsets := provider.Offsets(topic)
+		for _, partition := range partitions {
+			if offset, has := offsets[partition]; has {
+				log.L().Info("reset consumer offset", logf.Topic(topic),
+					logf.Partition(partition), logf.Offset(offset+1))
+				session.ResetOffset(topic, partition, offset+1, "")
+			}
+		}
+	}
 	return nil
 }
@@ -10,6 +10,8 @@ const (
 	defaultEventPrefix        = "ev-"
 	defaultRequestPrefix      = "req-"
 	defaultSubscriptionPrefix = "sub-"
+	defaultTransactionPrefix  = "tx-"
+	defaultSchedulePrefix     = "sch-"
 )
 
 func IG() *idGenerator { //nolint
@@ -45,6 +47,16 @@ func (ig *idGenerator) SubID() string {
 	return UUID(defaultSubscriptionPrefix)
 }
 
+// returns a transaction id.
+func (ig *idGenerator) TxID() string {
+	return UUID(defaultTransactionPrefix)
+}
+
+// returns a schedule id.
+func (ig *idGenerator) SchID() string {
+	return UUID(defaultSchedulePrefix)
+}
+
 // generate id with prefix.
 func (ig *idGenerator) With(prefix string) {
 	ig.prefix = prefix